@@ -0,0 +1,381 @@
+package kibana
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Authorization schemes of the credentials sent to Kibana.
+const (
+	AuthSchemeBasic  = "Basic"
+	AuthSchemeAPIKey = "ApiKey"
+	AuthSchemeBearer = "Bearer"
+)
+
+// CredentialProvider supplies the credentials of every request. Set it with
+// Config.Credentials to rotate credentials without creating a new client.
+type CredentialProvider interface {
+	// Authenticate sets the credentials of req, usually its Authorization header.
+	Authenticate(req *http.Request) error
+	// Refresh is called when Kibana answers a request with 401 Unauthorized.
+	// It reports whether the credentials changed. The request is sent again
+	// once when they changed, or when they differ from the ones it was sent with.
+	Refresh(ctx context.Context) (bool, error)
+}
+
+// ClientCertificateProvider is implemented by the credential providers that
+// authenticate with a client certificate, see NewClientCertificateProvider.
+type ClientCertificateProvider interface {
+	ClientCertificate(info *tls.CertificateRequestInfo) (*tls.Certificate, error)
+}
+
+// staticProvider sends the same Authorization header with every request.
+type staticProvider struct {
+	scheme        string
+	authorization string
+}
+
+func (p *staticProvider) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", p.authorization)
+	return nil
+}
+
+func (p *staticProvider) Refresh(context.Context) (bool, error) {
+	return false, nil
+}
+
+// NewBasicAuthProvider returns a provider authenticating every request with
+// HTTP basic authentication.
+func NewBasicAuthProvider(username, password string) CredentialProvider {
+	return &staticProvider{scheme: AuthSchemeBasic, authorization: authorization(AuthSchemeBasic, username+":"+password)}
+}
+
+// NewAPIKeyProvider returns a provider authenticating every request with the
+// base64-encoded API key.
+func NewAPIKeyProvider(apiKey string) CredentialProvider {
+	return &staticProvider{scheme: AuthSchemeAPIKey, authorization: authorization(AuthSchemeAPIKey, apiKey)}
+}
+
+// NewBearerTokenProvider returns a provider authenticating every request with
+// a bearer token, such as an Elasticsearch service account token or an OAuth
+// access token.
+func NewBearerTokenProvider(token string) CredentialProvider {
+	return &staticProvider{scheme: AuthSchemeBearer, authorization: authorization(AuthSchemeBearer, token)}
+}
+
+// TokenSourceProvider authenticates requests with a credential fetched on
+// demand, e.g. a short-lived API key from a secrets manager. The credential
+// is fetched before the first request and fetched again when Kibana rejects
+// it. Concurrent requests share a single fetch.
+type TokenSourceProvider struct {
+	scheme string
+	fetch  func(ctx context.Context) (string, error)
+
+	mu            sync.RWMutex
+	authorization string
+	inFlight      *tokenFetch
+}
+
+// tokenFetch is a fetch of a TokenSourceProvider shared by the concurrent
+// calls of Authenticate and Refresh.
+type tokenFetch struct {
+	done    chan struct{}
+	changed bool
+	err     error
+}
+
+// NewTokenSourceProvider returns a provider sending the credential returned
+// by fetch with scheme, one of the AuthScheme* values. For AuthSchemeBasic,
+// fetch returns username:password.
+func NewTokenSourceProvider(scheme string, fetch func(ctx context.Context) (string, error)) *TokenSourceProvider {
+	return &TokenSourceProvider{scheme: scheme, fetch: fetch}
+}
+
+// Authenticate implements CredentialProvider.
+func (p *TokenSourceProvider) Authenticate(req *http.Request) error {
+	p.mu.RLock()
+	value := p.authorization
+	p.mu.RUnlock()
+
+	if value == "" {
+		if _, err := p.Refresh(req.Context()); err != nil {
+			return err
+		}
+		p.mu.RLock()
+		value = p.authorization
+		p.mu.RUnlock()
+	}
+	req.Header.Set("Authorization", value)
+	return nil
+}
+
+// Refresh implements CredentialProvider by fetching the credential again.
+// A call made while a fetch is in flight waits for its outcome instead.
+func (p *TokenSourceProvider) Refresh(ctx context.Context) (bool, error) {
+	p.mu.Lock()
+	if f := p.inFlight; f != nil {
+		p.mu.Unlock()
+		select {
+		case <-f.done:
+			return f.changed, f.err
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+	f := &tokenFetch{done: make(chan struct{})}
+	p.inFlight = f
+	p.mu.Unlock()
+
+	token, err := p.fetch(ctx)
+
+	p.mu.Lock()
+	if err != nil {
+		f.err = fmt.Errorf("cannot fetch credentials: %w", err)
+	} else {
+		value := authorization(p.scheme, token)
+		f.changed = value != p.authorization
+		p.authorization = value
+	}
+	p.inFlight = nil
+	p.mu.Unlock()
+	close(f.done)
+	return f.changed, f.err
+}
+
+// FileProvider authenticates requests with a credential read from a file,
+// e.g. a secret mounted by Kubernetes or written by a Vault agent. The file
+// is read again when it is modified and when Kibana rejects the credential.
+type FileProvider struct {
+	path   string
+	scheme string
+
+	mu            sync.RWMutex
+	modTime       time.Time
+	authorization string
+}
+
+// NewFileProvider returns a provider sending the content of the file at path
+// with scheme, one of the AuthScheme* values. For AuthSchemeBasic, the file
+// holds username:password. Surrounding whitespace is ignored.
+func NewFileProvider(path, scheme string) (*FileProvider, error) {
+	p := &FileProvider{path: path, scheme: scheme}
+	if _, err := p.reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Authenticate implements CredentialProvider.
+func (p *FileProvider) Authenticate(req *http.Request) error {
+	if info, err := os.Stat(p.path); err == nil {
+		p.mu.RLock()
+		modified := !info.ModTime().Equal(p.modTime)
+		p.mu.RUnlock()
+		if modified {
+			if _, err := p.reload(); err != nil {
+				return err
+			}
+		}
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	req.Header.Set("Authorization", p.authorization)
+	return nil
+}
+
+// Refresh implements CredentialProvider by reading the file again.
+func (p *FileProvider) Refresh(context.Context) (bool, error) {
+	return p.reload()
+}
+
+func (p *FileProvider) reload() (bool, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return false, fmt.Errorf("cannot read credentials: %w", err)
+	}
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return false, fmt.Errorf("cannot read credentials: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return false, fmt.Errorf("cannot read credentials: %s is empty", p.path)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	value := authorization(p.scheme, token)
+	changed := value != p.authorization
+	p.authorization = value
+	p.modTime = info.ModTime()
+	return changed, nil
+}
+
+// CertificateProvider authenticates with a client certificate for mutual
+// TLS. The key pair is read again when the TLS handshake asks for a client
+// certificate after the files were modified, and when Kibana answers 401.
+type CertificateProvider struct {
+	certFile, keyFile string
+
+	mu      sync.RWMutex
+	modTime time.Time
+	cert    *tls.Certificate
+}
+
+// NewClientCertificateProvider returns a provider presenting the PEM-encoded
+// key pair of certFile and keyFile during TLS handshakes. It only applies
+// when Config.Transport is nil or an *http.Transport. Combine it with
+// another provider with CombineCredentials when Kibana also requires an
+// Authorization header.
+func NewClientCertificateProvider(certFile, keyFile string) (*CertificateProvider, error) {
+	p := &CertificateProvider{certFile: certFile, keyFile: keyFile}
+	if _, err := p.reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Authenticate implements CredentialProvider, the certificate is presented
+// during the TLS handshake instead.
+func (p *CertificateProvider) Authenticate(*http.Request) error {
+	return nil
+}
+
+// Refresh implements CredentialProvider by reading the key pair again.
+func (p *CertificateProvider) Refresh(context.Context) (bool, error) {
+	return p.reload()
+}
+
+// ClientCertificate implements ClientCertificateProvider.
+func (p *CertificateProvider) ClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	if modTime, err := p.lastModified(); err == nil {
+		p.mu.RLock()
+		modified := !modTime.Equal(p.modTime)
+		p.mu.RUnlock()
+		if modified {
+			if _, err := p.reload(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.cert, nil
+}
+
+// lastModified returns the latest modification time of the key pair files.
+func (p *CertificateProvider) lastModified() (time.Time, error) {
+	var latest time.Time
+	for _, path := range []string{p.certFile, p.keyFile} {
+		info, err := os.Stat(path)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+func (p *CertificateProvider) reload() (bool, error) {
+	modTime, err := p.lastModified()
+	if err != nil {
+		return false, fmt.Errorf("cannot read client certificate: %w", err)
+	}
+	cert, err := tls.LoadX509KeyPair(p.certFile, p.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("cannot read client certificate: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	changed := p.cert == nil || !bytes.Equal(p.cert.Certificate[0], cert.Certificate[0])
+	p.cert = &cert
+	p.modTime = modTime
+	return changed, nil
+}
+
+// combinedProvider applies several providers to every request.
+type combinedProvider []CredentialProvider
+
+// CombineCredentials returns a provider applying every provider in order,
+// e.g. a client certificate together with an API key.
+func CombineCredentials(providers ...CredentialProvider) CredentialProvider {
+	return combinedProvider(providers)
+}
+
+func (c combinedProvider) Authenticate(req *http.Request) error {
+	for _, p := range c {
+		if err := p.Authenticate(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c combinedProvider) Refresh(ctx context.Context) (bool, error) {
+	var refreshed bool
+	var errs []error
+	for _, p := range c {
+		changed, err := p.Refresh(ctx)
+		refreshed = refreshed || changed
+		errs = append(errs, err)
+	}
+	return refreshed, errors.Join(errs...)
+}
+
+// clientCertificates returns the provider of client certificates of p, or
+// nil when p does not authenticate with a client certificate.
+func clientCertificates(p CredentialProvider) ClientCertificateProvider {
+	switch p := p.(type) {
+	case ClientCertificateProvider:
+		return p
+	case combinedProvider:
+		for _, provider := range p {
+			if cp := clientCertificates(provider); cp != nil {
+				return cp
+			}
+		}
+	}
+	return nil
+}
+
+// authScheme returns the scheme of the built-in providers, and an empty
+// string for other providers.
+func authScheme(p CredentialProvider) string {
+	switch p := p.(type) {
+	case *staticProvider:
+		return p.scheme
+	case *TokenSourceProvider:
+		return p.scheme
+	case *FileProvider:
+		return p.scheme
+	case combinedProvider:
+		for _, provider := range p {
+			if scheme := authScheme(provider); scheme != "" {
+				return scheme
+			}
+		}
+	}
+	return ""
+}
+
+// authorization returns the Authorization header value of token.
+func authorization(scheme, token string) string {
+	if scheme == AuthSchemeBasic {
+		token = base64.StdEncoding.EncodeToString([]byte(token))
+	}
+	return scheme + " " + token
+}
@@ -0,0 +1,252 @@
+package kibana
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// authorizationOf returns the Authorization header set by p.
+func authorizationOf(t *testing.T, p CredentialProvider) string {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, "http://localhost:5601/api/status", nil)
+	require.NoError(t, err)
+	require.NoError(t, p.Authenticate(req))
+	return req.Header.Get("Authorization")
+}
+
+func TestStaticProviders(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider CredentialProvider
+		scheme   string
+		want     string
+	}{
+		{name: "basic", provider: NewBasicAuthProvider("elastic", "changeme"), scheme: AuthSchemeBasic, want: "Basic ZWxhc3RpYzpjaGFuZ2VtZQ=="},
+		{name: "api key", provider: NewAPIKeyProvider("a2V5"), scheme: AuthSchemeAPIKey, want: "ApiKey a2V5"},
+		{name: "bearer", provider: NewBearerTokenProvider("token"), scheme: AuthSchemeBearer, want: "Bearer token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, authorizationOf(t, tt.provider))
+			assert.Equal(t, tt.scheme, authScheme(tt.provider))
+
+			refreshed, err := tt.provider.Refresh(context.Background())
+			require.NoError(t, err)
+			assert.False(t, refreshed)
+		})
+	}
+}
+
+func TestTokenSourceProvider(t *testing.T) {
+	var fetches int
+	tokens := []string{"first", "first", "second"}
+	p := NewTokenSourceProvider(AuthSchemeBearer, func(context.Context) (string, error) {
+		token := tokens[fetches]
+		fetches++
+		return token, nil
+	})
+
+	// The token is fetched before the first request only
+	assert.Equal(t, "Bearer first", authorizationOf(t, p))
+	assert.Equal(t, "Bearer first", authorizationOf(t, p))
+	assert.Equal(t, 1, fetches)
+
+	refreshed, err := p.Refresh(context.Background())
+	require.NoError(t, err)
+	assert.False(t, refreshed)
+
+	refreshed, err = p.Refresh(context.Background())
+	require.NoError(t, err)
+	assert.True(t, refreshed)
+	assert.Equal(t, "Bearer second", authorizationOf(t, p))
+
+	failing := NewTokenSourceProvider(AuthSchemeAPIKey, func(context.Context) (string, error) {
+		return "", errors.New("vault sealed")
+	})
+	req, err := http.NewRequest(http.MethodGet, "http://localhost:5601/api/status", nil)
+	require.NoError(t, err)
+	assert.EqualError(t, failing.Authenticate(req), "cannot fetch credentials: vault sealed")
+}
+
+func TestTokenSourceProvider_ConcurrentFetch(t *testing.T) {
+	var fetches atomic.Int32
+	release := make(chan struct{})
+	p := NewTokenSourceProvider(AuthSchemeAPIKey, func(context.Context) (string, error) {
+		fetches.Add(1)
+		<-release
+		return "key", nil
+	})
+
+	var wg sync.WaitGroup
+	results := make([]string, 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = authorizationOf(t, p)
+		}()
+	}
+	// Let every request join the fetch in flight
+	require.Eventually(t, func() bool { return fetches.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), fetches.Load())
+	for _, result := range results {
+		assert.Equal(t, "ApiKey key", result)
+	}
+
+	// A caller that gives up does not wait for the fetch in flight
+	block := make(chan struct{})
+	defer close(block)
+	slow := NewTokenSourceProvider(AuthSchemeAPIKey, func(context.Context) (string, error) {
+		<-block
+		return "key", nil
+	})
+	go slow.Refresh(context.Background())
+	require.Eventually(t, func() bool {
+		slow.mu.RLock()
+		defer slow.mu.RUnlock()
+		return slow.inFlight != nil
+	}, time.Second, time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := slow.Refresh(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+
+	p, err := NewFileProvider(path, AuthSchemeAPIKey)
+	require.NoError(t, err)
+	assert.Equal(t, "ApiKey first", authorizationOf(t, p))
+
+	// The file is read again once it is modified
+	require.NoError(t, os.WriteFile(path, []byte("second"), 0o600))
+	modTime := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+	assert.Equal(t, "ApiKey second", authorizationOf(t, p))
+
+	refreshed, err := p.Refresh(context.Background())
+	require.NoError(t, err)
+	assert.False(t, refreshed)
+
+	require.NoError(t, os.WriteFile(path, []byte("  "), 0o600))
+	_, err = p.Refresh(context.Background())
+	assert.ErrorContains(t, err, "is empty")
+
+	_, err = NewFileProvider(filepath.Join(t.TempDir(), "missing"), AuthSchemeAPIKey)
+	assert.Error(t, err)
+}
+
+// writeKeyPair writes a self-signed certificate for name and its key to dir.
+func writeKeyPair(t *testing.T, dir, name string) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile = filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certFile, keyFile
+}
+
+func TestCertificateProvider(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeKeyPair(t, dir, "first")
+
+	p, err := NewClientCertificateProvider(certFile, keyFile)
+	require.NoError(t, err)
+	assert.Empty(t, authorizationOf(t, p))
+	assert.Same(t, p, clientCertificates(CombineCredentials(NewAPIKeyProvider("key"), p)))
+
+	commonName := func() string {
+		cert, err := p.ClientCertificate(&tls.CertificateRequestInfo{})
+		require.NoError(t, err)
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		require.NoError(t, err)
+		return leaf.Subject.CommonName
+	}
+	assert.Equal(t, "first", commonName())
+
+	refreshed, err := p.Refresh(context.Background())
+	require.NoError(t, err)
+	assert.False(t, refreshed)
+
+	// The key pair is read again once it is modified
+	writeKeyPair(t, dir, "second")
+	modTime := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	assert.Equal(t, "second", commonName())
+
+	_, err = NewClientCertificateProvider(filepath.Join(dir, "missing.crt"), keyFile)
+	assert.Error(t, err)
+}
+
+// refreshProvider is a provider with a fixed outcome of Refresh.
+type refreshProvider struct {
+	refreshed bool
+	err       error
+}
+
+func (p refreshProvider) Authenticate(req *http.Request) error {
+	return p.err
+}
+
+func (p refreshProvider) Refresh(context.Context) (bool, error) {
+	return p.refreshed, p.err
+}
+
+func TestCombineCredentials(t *testing.T) {
+	first, second := errors.New("first"), errors.New("second")
+
+	refreshed, err := CombineCredentials(refreshProvider{err: first}, refreshProvider{refreshed: true}, refreshProvider{err: second}).Refresh(context.Background())
+	assert.True(t, refreshed)
+	assert.ErrorIs(t, err, first)
+	assert.ErrorIs(t, err, second)
+
+	refreshed, err = CombineCredentials(refreshProvider{}, refreshProvider{}).Refresh(context.Background())
+	require.NoError(t, err)
+	assert.False(t, refreshed)
+
+	// Authenticate stops at the first error
+	req, err := http.NewRequest(http.MethodGet, "http://localhost:5601/api/status", nil)
+	require.NoError(t, err)
+	assert.ErrorIs(t, CombineCredentials(NewAPIKeyProvider("key"), refreshProvider{err: first}, refreshProvider{err: second}).Authenticate(req), first)
+	assert.Equal(t, "ApiKey key", req.Header.Get("Authorization"))
+
+	assert.Equal(t, AuthSchemeAPIKey, authScheme(CombineCredentials(refreshProvider{}, NewAPIKeyProvider("key"))))
+	assert.Empty(t, authScheme(CombineCredentials(refreshProvider{})))
+}
@@ -0,0 +1,793 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"golang.org/x/tools/imports"
+)
+
+// Operation is a single operation of the spec belonging to an API group.
+type Operation struct {
+	Group       ApiGroup
+	Method      string
+	Path        string
+	OperationID string
+	Summary     string
+
+	// Name is the Go name of the operation, e.g. FindSlosOp
+	Name string
+	// Type prefixes the request and response wrappers, e.g. SLOsFindSlosOp
+	Type        string
+	PathParams  []Param
+	QueryParams []Param
+	// Body is the JSON request body type generated by oapi-codegen
+	Body string
+	// RawBody is the content type of a request body that is not JSON
+	RawBody string
+	// Response is the type the response body is decoded into
+	Response string
+
+	// Binding is the kbapi function performing the operation, if any
+	Binding   string
+	Generated bool
+}
+
+// Param is a path or query parameter of an operation.
+type Param struct {
+	Name     string
+	Field    string
+	Type     string
+	Required bool
+}
+
+// Key identifies the operation independently of how the path parameters are named.
+func (o Operation) Key() string {
+	return o.Method + " " + normalizePath(o.Path)
+}
+
+// Struct is the kbapi struct the operation is wired into.
+func (o Operation) Struct() string {
+	if o.Group.Struct != "" {
+		return o.Group.Struct
+	}
+	return o.Group.Name
+}
+
+// Span is the instrumentation span name of the operation.
+func (o Operation) Span() string {
+	return o.Group.Group + "." + toSnakeCase(o.Name)
+}
+
+// DocURL links to the operation in the Kibana API documentation.
+func (o Operation) DocURL() string {
+	return "https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-" + strings.ToLower(o.OperationID)
+}
+
+// HTTPMethod is the net/http constant of the operation method.
+func (o Operation) HTTPMethod() string {
+	return "http.Method" + o.Method[:1] + strings.ToLower(o.Method[1:])
+}
+
+// HasRequest reports whether the operation takes any input.
+func (o Operation) HasRequest() bool {
+	return len(o.PathParams) > 0 || len(o.QueryParams) > 0 || o.Body != "" || o.RawBody != ""
+}
+
+// RequestBody is the request type parameter passed to kbapi's do.
+func (o Operation) RequestBody() string {
+	if o.Body == "" {
+		return "noBody"
+	}
+	return o.Body
+}
+
+// BodyArg is the request body argument passed to kbapi's do.
+func (o Operation) BodyArg() string {
+	if o.Body == "" {
+		return "nil"
+	}
+	return "&req.Body"
+}
+
+// PathExpr is the Go expression building the request path.
+func (o Operation) PathExpr() string {
+	if len(o.PathParams) == 0 {
+		return strconv.Quote(o.Path)
+	}
+	fields := map[string]string{}
+	for _, p := range o.PathParams {
+		fields[p.Name] = p.Field
+	}
+	var args []string
+	format := pathParamRegex.ReplaceAllStringFunc(o.Path, func(m string) string {
+		args = append(args, "req."+fields[strings.Trim(m, "{}")])
+		return "%s"
+	})
+	return fmt.Sprintf("fmt.Sprintf(%q, %s)", format, strings.Join(args, ", "))
+}
+
+// QueryCode is the Go code adding the query parameters to params.
+func (o Operation) QueryCode() string {
+	var b strings.Builder
+	for _, p := range o.QueryParams {
+		value := "req.Params." + p.Field
+		if !p.Required {
+			fmt.Fprintf(&b, "if %s != nil {\n", value)
+			value = "*" + value
+		} else if p.Type == "object" {
+			b.WriteString("{\n")
+		}
+		switch p.Type {
+		case "array":
+			fmt.Fprintf(&b, "for _, v := range %s {\nparams.Add(%q, fmt.Sprint(v))\n}\n", value, p.Name)
+		case "object":
+			fmt.Fprintf(&b, "data, err := json.Marshal(%s)\nif err != nil {\nreturn nil, err\n}\nparams.Set(%q, string(data))\n", value, p.Name)
+		default:
+			fmt.Fprintf(&b, "params.Set(%q, fmt.Sprint(%s))\n", p.Name, value)
+		}
+		if !p.Required || p.Type == "object" {
+			b.WriteString("}\n")
+		}
+	}
+	return b.String()
+}
+
+var pathParamRegex = regexp.MustCompile(`\{[^}]+\}`)
+
+// spacePrefix is stripped from spec paths, spaces are handled by kbapi.WithSpace.
+const spacePrefix = "/s/{spaceId}"
+
+// collectOperations returns the operations of every API group sorted by path and method.
+func collectOperations(schema *Schema) []Operation {
+	var ops []Operation
+	for _, group := range ApiGroups {
+		paths := filterPathsByPrefixes(schema.Paths, group.PathPrefix)
+		for _, pathURL := range slices.Sorted(maps.Keys(paths)) {
+			pathInfo := paths[pathURL]
+			for _, method := range []string{"get", "post", "put", "patch", "delete"} {
+				endpoint := pathInfo.GetEndpoint(method)
+				if endpoint == nil {
+					continue
+				}
+				ops = append(ops, newOperation(schema, group, method, pathURL, pathInfo, endpoint))
+			}
+		}
+	}
+	return ops
+}
+
+func newOperation(schema *Schema, group ApiGroup, method string, pathURL string, pathInfo *Path, endpoint Map) Operation {
+	op := Operation{
+		Group:       group,
+		Method:      strings.ToUpper(method),
+		Path:        strings.TrimPrefix(pathURL, spacePrefix),
+		OperationID: stringValue(endpoint, "operationId"),
+		Summary:     stringValue(endpoint, "summary"),
+	}
+	if op.OperationID == "" {
+		op.OperationID = method + pathURL
+	}
+	typeName := toCamelCase(op.OperationID)
+	op.Name = typeName
+	op.Type = group.Name + typeName
+
+	params := slices.Clone(pathInfo.Parameters)
+	if endpointParams, ok := endpoint.GetSlice("parameters"); ok {
+		for _, p := range endpointParams {
+			if m, ok := asMap(p); ok {
+				params = append(params, m)
+			}
+		}
+	}
+	for _, p := range params {
+		p = resolveRef(schema, p)
+		param := Param{
+			Name:     stringValue(p, "name"),
+			Field:    toCamelCase(stringValue(p, "name")),
+			Required: p["required"] == true,
+		}
+		if s, ok := asMap(p["schema"]); ok {
+			param.Type = stringValue(resolveRef(schema, s), "type")
+		}
+		switch stringValue(p, "in") {
+		case "path":
+			if param.Name == "spaceId" && strings.HasPrefix(pathURL, spacePrefix) {
+				continue
+			}
+			op.PathParams = append(op.PathParams, param)
+		case "query":
+			op.QueryParams = append(op.QueryParams, param)
+		}
+	}
+
+	// Every placeholder needs a field, even when the spec does not declare it
+	for _, m := range pathParamRegex.FindAllString(op.Path, -1) {
+		name := strings.Trim(m, "{}")
+		if !slices.ContainsFunc(op.PathParams, func(p Param) bool { return p.Name == name }) {
+			op.PathParams = append(op.PathParams, Param{Name: name, Field: toCamelCase(name), Required: true})
+		}
+	}
+
+	if requestBody, ok := endpoint.GetMap("requestBody"); ok {
+		content, _ := asMap(resolveRef(schema, requestBody)["content"])
+		if _, ok := content["application/json"]; ok {
+			op.Body = typeName + "JSONRequestBody"
+		} else if len(content) > 0 {
+			op.RawBody = slices.Sorted(maps.Keys(content))[0]
+		}
+	}
+
+	op.Response = responseType(schema, typeName, endpoint)
+
+	return op
+}
+
+// responseType returns the type the successful response of endpoint decodes into.
+func responseType(schema *Schema, typeName string, endpoint Map) string {
+	responses, _ := endpoint.GetMap("responses")
+	for _, code := range []string{"200", "201", "202", "204"} {
+		response, ok := asMap(responses[code])
+		if !ok {
+			continue
+		}
+		content, ok := asMap(resolveRef(schema, response)["content"])
+		if !ok || len(content) == 0 {
+			return "noBody"
+		}
+		media, ok := asMap(content["application/json"])
+		if !ok {
+			return "[]byte"
+		}
+		body, _ := asMap(media["schema"])
+		if ref, ok := body["$ref"].(string); ok {
+			return toCamelCase(ref[strings.LastIndex(ref, "/")+1:])
+		}
+		// oapi-codegen only turns inline objects into a named response type
+		if stringValue(body, "type") == "object" {
+			return typeName + "Response"
+		}
+		return "json.RawMessage"
+	}
+	return "noBody"
+}
+
+// resolveRef returns the component m refers to, or m when it is not a reference.
+func resolveRef(schema *Schema, m Map) Map {
+	ref, ok := m["$ref"].(string)
+	if !ok {
+		return m
+	}
+	key := strings.ReplaceAll(strings.TrimPrefix(ref, "#/components/"), "/", ".")
+	if target, ok := schema.Components.GetMap(key); ok {
+		return resolveRef(schema, target)
+	}
+	return m
+}
+
+// ============================================================================
+
+// Bindings maps the operations implemented in kbapi to the function performing them.
+type Bindings struct {
+	// Paths maps "METHOD /normalized/path" to the kbapi constructor
+	Paths map[string]string
+	// Operations holds the lower case IDs of the operations linked from kbapi doc comments
+	Operations map[string]bool
+	// Types holds every type declared in kbapi
+	Types map[string]bool
+}
+
+var (
+	performsRegex = regexp.MustCompile(`performs (GET|POST|PUT|PATCH|DELETE) (/\S+) API requests`)
+	docLinkRegex  = regexp.MustCompile(`operation/operation-([\w-]+)`)
+)
+
+// findBindings parses the kbapi sources in dir to find the operations that
+// already have a Go binding.
+func findBindings(dir string) (*Bindings, error) {
+	bindings := &Bindings{
+		Paths:      map[string]string{},
+		Operations: map[string]bool{},
+		Types:      map[string]bool{},
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cg := range f.Comments {
+			for _, m := range docLinkRegex.FindAllStringSubmatch(cg.Text(), -1) {
+				bindings.Operations[m[1]] = true
+			}
+		}
+
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						bindings.Types[ts.Name.Name] = true
+					}
+				}
+			case *ast.FuncDecl:
+				if d.Recv == nil || !strings.HasPrefix(d.Name.Name, "new") {
+					continue
+				}
+				keys := operationKeys(d)
+				if len(keys) == 0 && d.Doc != nil {
+					if m := performsRegex.FindStringSubmatch(d.Doc.Text()); m != nil {
+						keys = append(keys, m[1]+" "+normalizePath(m[2]))
+					}
+				}
+				for _, key := range keys {
+					bindings.Paths[key] = d.Name.Name
+				}
+			}
+		}
+	}
+
+	return bindings, nil
+}
+
+// operationKeys returns the keys of the operation literals passed to do in fn.
+func operationKeys(fn *ast.FuncDecl) []string {
+	assigned := map[string]ast.Expr{}
+	ast.Inspect(fn, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok && len(assign.Lhs) == len(assign.Rhs) {
+			for i, lhs := range assign.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok {
+					if _, seen := assigned[ident.Name]; !seen {
+						assigned[ident.Name] = assign.Rhs[i]
+					}
+				}
+			}
+		}
+		return true
+	})
+
+	var keys []string
+	ast.Inspect(fn, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		if ident, ok := lit.Type.(*ast.Ident); !ok || ident.Name != "operation" {
+			return true
+		}
+
+		var method, path string
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			switch key := kv.Key.(*ast.Ident); {
+			case key == nil:
+			case key.Name == "method":
+				method = methodValue(kv.Value)
+			case key.Name == "path":
+				value := kv.Value
+				if ident, ok := value.(*ast.Ident); ok && assigned[ident.Name] != nil {
+					value = assigned[ident.Name]
+				}
+				path = pathValue(value)
+			}
+		}
+		if method != "" && path != "" {
+			keys = append(keys, method+" "+normalizePath(path))
+		}
+		return false
+	})
+	return keys
+}
+
+func methodValue(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.SelectorExpr:
+		return strings.ToUpper(strings.TrimPrefix(e.Sel.Name, "Method"))
+	case *ast.BasicLit:
+		s, _ := strconv.Unquote(e.Value)
+		return strings.ToUpper(s)
+	}
+	return ""
+}
+
+func pathValue(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		s, _ := strconv.Unquote(e.Value)
+		return s
+	case *ast.CallExpr:
+		// fmt.Sprintf("/api/alerting/rule/%s", req.ID)
+		if len(e.Args) > 0 {
+			return pathValue(e.Args[0])
+		}
+	}
+	return ""
+}
+
+var formatVerbRegex = regexp.MustCompile(`%[a-z]`)
+
+// normalizePath strips the space prefix, the query string and the names of
+// path parameters so that spec paths and kbapi paths can be compared.
+func normalizePath(p string) string {
+	p = strings.TrimPrefix(p, spacePrefix)
+	p, _, _ = strings.Cut(p, "?")
+	p = pathParamRegex.ReplaceAllString(p, "{}")
+	p = formatVerbRegex.ReplaceAllString(p, "{}")
+	return strings.TrimSuffix(strings.TrimSpace(p), "/")
+}
+
+// bindOperations sets the binding of every operation already implemented in kbapi.
+func bindOperations(ops []Operation, bindings *Bindings) {
+	for i := range ops {
+		if fn, ok := bindings.Paths[ops[i].Key()]; ok {
+			ops[i].Binding = fn
+		} else if bindings.Operations[strings.ToLower(ops[i].OperationID)] {
+			ops[i].Binding = "(linked from api._.go)"
+		}
+	}
+}
+
+// ============================================================================
+
+// generateEndpoints writes an endpoint file for every operation without a
+// binding and wires them into kbapi.New. Operations whose generated names
+// collide with existing kbapi types are left unbound.
+func generateEndpoints(dir string, ops []Operation, bindings *Bindings) error {
+	tmplContent, err := os.ReadFile("endpoint.tmpl")
+	if err != nil {
+		return fmt.Errorf("failed to read endpoint template: %w", err)
+	}
+	tmpl, err := template.New("endpoint").Parse(string(tmplContent))
+	if err != nil {
+		return err
+	}
+
+	var generated []Operation
+	for i := range ops {
+		op := &ops[i]
+		if op.Binding != "" {
+			continue
+		}
+		if bindings.Types[op.Type+"Request"] || bindings.Types[op.Type+"Response"] {
+			log.Printf("Skipping %s %s: %s wrappers already exist", op.Method, op.Path, op.Type)
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, op); err != nil {
+			return fmt.Errorf("failed to render %s: %w", op.OperationID, err)
+		}
+
+		filename := filepath.Join(dir, fmt.Sprintf("api.%s.%s.gen.go", op.Group.Group, toSnakeCase(op.Name)))
+		code, err := imports.Process(filename, buf.Bytes(), nil)
+		if err != nil {
+			return fmt.Errorf("failed to format %s: %w\n%s", filename, err, buf.String())
+		}
+		if err := os.WriteFile(filename, code, 0644); err != nil {
+			return err
+		}
+
+		op.Generated = true
+		op.Binding = "new" + op.Type
+		generated = append(generated, *op)
+	}
+
+	if len(generated) == 0 {
+		return nil
+	}
+	return wireEndpoints(filepath.Join(dir, "api._.go"), generated)
+}
+
+// wireEndpoints adds the generated operations to their group struct and to
+// the group literal in kbapi.New. Groups that do not exist yet are created.
+func wireEndpoints(filename string, ops []Operation) error {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	byStruct := map[string][]Operation{}
+	for _, op := range ops {
+		byStruct[op.Struct()] = append(byStruct[op.Struct()], op)
+	}
+
+	// Create the missing groups first so that every struct can be found below
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	structs := structTypes(f)
+	var missing []string
+	for name := range byStruct {
+		if _, ok := structs[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		src = addGroups(fset, f, src, missing)
+		fset = token.NewFileSet()
+		if f, err = parser.ParseFile(fset, filename, src, parser.ParseComments); err != nil {
+			return err
+		}
+		structs = structTypes(f)
+	}
+
+	literals := groupLiterals(f)
+
+	type insertion struct {
+		offset int
+		text   string
+	}
+	var insertions []insertion
+	for name, group := range byStruct {
+		st := structs[name]
+		lit, ok := literals[name]
+		if !ok {
+			return fmt.Errorf("no %s literal found in New", name)
+		}
+
+		existing := map[string]bool{}
+		for _, field := range st.Fields.List {
+			for _, n := range field.Names {
+				existing[n.Name] = true
+			}
+		}
+
+		var fields, values strings.Builder
+		for _, op := range group {
+			if existing[op.Name] {
+				log.Printf("Skipping wiring of %s: %s.%s already exists", op.OperationID, name, op.Name)
+				continue
+			}
+			fmt.Fprintf(&fields, "\t// %s performs %s %s. See %s\n", op.Name, op.Method, op.Path, op.DocURL())
+			if op.HasRequest() {
+				fmt.Fprintf(&fields, "\t%s func(ctx context.Context, req *%sRequest, opts ...RequestOption) (*%sResponse, error)\n", op.Name, op.Type, op.Type)
+			} else {
+				fmt.Fprintf(&fields, "\t%s func(ctx context.Context, opts ...RequestOption) (*%sResponse, error)\n", op.Name, op.Type)
+			}
+			fmt.Fprintf(&values, "\t%s: api.new%s(),\n", op.Name, op.Type)
+		}
+
+		insertions = append(insertions,
+			insertion{lineStart(src, fset.Position(st.Fields.Closing).Offset), fields.String()},
+			insertion{lineStart(src, fset.Position(lit.Rbrace).Offset), values.String()},
+		)
+	}
+
+	sort.Slice(insertions, func(i, j int) bool { return insertions[i].offset > insertions[j].offset })
+	for _, ins := range insertions {
+		src = slices.Concat(src[:ins.offset], []byte(ins.text), src[ins.offset:])
+	}
+
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %w", filename, err)
+	}
+	return os.WriteFile(filename, formatted, 0644)
+}
+
+// addGroups declares the named group structs, embeds them in API and
+// initializes them in New.
+func addGroups(fset *token.FileSet, f *ast.File, src []byte, names []string) []byte {
+	var apiFields *ast.FieldList
+	var newFunc *ast.FuncDecl
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == "API" {
+					apiFields = ts.Type.(*ast.StructType).Fields
+				}
+			}
+		case *ast.FuncDecl:
+			if d.Recv == nil && d.Name.Name == "New" {
+				newFunc = d
+			}
+		}
+	}
+	if apiFields == nil || newFunc == nil {
+		log.Panicf("API struct or New not found in %s", fset.File(f.Pos()).Name())
+	}
+
+	// The final statement of New is "return api"
+	ret := newFunc.Body.List[len(newFunc.Body.List)-1]
+	newDecl := fset.Position(newFunc.Pos()).Offset
+	if newFunc.Doc != nil {
+		newDecl = fset.Position(newFunc.Doc.Pos()).Offset
+	}
+
+	var fields, types, inits strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&fields, "\t%s\n", name)
+		fmt.Fprintf(&types, "type %s struct {\n}\n\n", name)
+		fmt.Fprintf(&inits, "\tapi.%s = %s{\n\t}\n\n", name, name)
+	}
+
+	apiEnd := lineStart(src, fset.Position(apiFields.Closing).Offset)
+	retStart := lineStart(src, fset.Position(ret.Pos()).Offset)
+
+	return slices.Concat(
+		src[:apiEnd], []byte(fields.String()),
+		src[apiEnd:newDecl], []byte(types.String()),
+		src[newDecl:retStart], []byte(inits.String()),
+		src[retStart:],
+	)
+}
+
+// structTypes returns the struct types declared in f.
+func structTypes(f *ast.File) map[string]*ast.StructType {
+	structs := map[string]*ast.StructType{}
+	for _, decl := range f.Decls {
+		if d, ok := decl.(*ast.GenDecl); ok {
+			for _, spec := range d.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok {
+					if st, ok := ts.Type.(*ast.StructType); ok {
+						structs[ts.Name.Name] = st
+					}
+				}
+			}
+		}
+	}
+	return structs
+}
+
+// groupLiterals returns the composite literals of the group structs built in New.
+func groupLiterals(f *ast.File) map[string]*ast.CompositeLit {
+	literals := map[string]*ast.CompositeLit{}
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == "New" {
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				if lit, ok := n.(*ast.CompositeLit); ok {
+					if ident, ok := lit.Type.(*ast.Ident); ok {
+						literals[ident.Name] = lit
+					}
+				}
+				return true
+			})
+		}
+	}
+	return literals
+}
+
+func lineStart(src []byte, offset int) int {
+	return bytes.LastIndexByte(src[:offset], '\n') + 1
+}
+
+// ============================================================================
+
+// writeDriftReport writes a markdown report of the operations in the spec
+// without a Go binding, and of the kbapi bindings that match no operation.
+func writeDriftReport(filename string, schema *Schema, ops []Operation, bindings *Bindings) error {
+	var b strings.Builder
+
+	unbound := 0
+	for _, op := range ops {
+		if op.Binding == "" || op.Generated {
+			unbound++
+		}
+	}
+
+	fmt.Fprintf(&b, "# Kibana API drift report\n\n")
+	fmt.Fprintf(&b, "Kibana OpenAPI spec version %v: %d of %d operations have no hand-written Go binding.\n", schema.Info["version"], unbound, len(ops))
+
+	var group string
+	for _, op := range ops {
+		if op.Binding != "" && !op.Generated {
+			continue
+		}
+		if op.Group.Name != group {
+			group = op.Group.Name
+			fmt.Fprintf(&b, "\n## %s\n\n| Method | Path | Operation | Status |\n|---|---|---|---|\n", group)
+		}
+		status := "missing"
+		if op.Generated {
+			status = fmt.Sprintf("generated as `%s.%s`", op.Struct(), op.Name)
+		}
+		fmt.Fprintf(&b, "| %s | `%s` | [%s](%s) | %s |\n", op.Method, op.Path, op.OperationID, op.DocURL(), status)
+	}
+
+	specKeys := map[string]bool{}
+	for _, op := range ops {
+		specKeys[op.Key()] = true
+	}
+	var stale []string
+	for key, fn := range bindings.Paths {
+		if !specKeys[key] {
+			stale = append(stale, fmt.Sprintf("| `%s` | `%s` |\n", key, fn))
+		}
+	}
+	if len(stale) > 0 {
+		sort.Strings(stale)
+		fmt.Fprintf(&b, "\n## Bindings without a spec operation\n\n| Request | Function |\n|---|---|\n%s", strings.Join(stale, ""))
+	}
+
+	return os.WriteFile(filename, []byte(b.String()), 0644)
+}
+
+// ============================================================================
+
+// toCamelCase converts names such as "find-slos_op" or "spaceId" to the Go
+// names oapi-codegen generates for them, e.g. "FindSlosOp" and "SpaceId".
+func toCamelCase(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9':
+			if upper && r >= 'a' && r <= 'z' {
+				r -= 'a' - 'A'
+			}
+			b.WriteRune(r)
+			upper = false
+		default:
+			upper = true
+		}
+	}
+	name := b.String()
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "N" + name
+	}
+	return name
+}
+
+// toSnakeCase converts a Go name to snake case, e.g. "FindSlosOp" to "find_slos_op".
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 && !(s[i-1] >= 'A' && s[i-1] <= 'Z') {
+				b.WriteByte('_')
+			}
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func asMap(v any) (Map, bool) {
+	switch t := v.(type) {
+	case Map:
+		return t, true
+	case map[string]any:
+		return t, true
+	}
+	return nil, false
+}
+
+func stringValue(m Map, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
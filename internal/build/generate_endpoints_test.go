@@ -0,0 +1,142 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Run with: go test transform_schema.go generate_endpoints.go generate_endpoints_test.go [-update]
+var update = flag.Bool("update", false, "update the golden files in testdata/golden")
+
+// TestGenerateEndpoints generates the endpoints of testdata/spec.yml missing
+// from the bindings of testdata/kbapi, and compares the result with the
+// golden files.
+func TestGenerateEndpoints(t *testing.T) {
+	data, err := os.ReadFile("testdata/spec.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schema Schema
+	if err := yaml.Unmarshal(data, &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	fixtures, err := filepath.Glob("testdata/kbapi/*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fixture := range fixtures {
+		data, err := os.ReadFile(fixture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, filepath.Base(fixture)), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	operations := collectOperations(&schema)
+	bindings, err := findBindings(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bindOperations(operations, bindings)
+	if err := generateEndpoints(dir, operations, bindings); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeDriftReport(filepath.Join(dir, "drift.md"), &schema, operations, bindings); err != nil {
+		t.Fatal(err)
+	}
+
+	outputs, err := filepath.Glob(filepath.Join(dir, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, output := range outputs {
+		got, err := os.ReadFile(output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		compareGolden(t, filepath.Base(output), got)
+	}
+
+	// Every golden file must still be generated
+	goldens, err := filepath.Glob("testdata/golden/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, golden := range goldens {
+		name := filepath.Base(golden)
+		if name == "models.gen.go" {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("%s is no longer generated", name)
+		}
+	}
+}
+
+// TestPostProcessGeneratedCode checks that the client code and the multipart
+// bodies are stripped from the oapi-codegen output of testdata/models.input.go.
+func TestPostProcessGeneratedCode(t *testing.T) {
+	data, err := os.ReadFile("testdata/models.input.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	compareGolden(t, "models.gen.go", []byte(postProcessGeneratedCode(string(data))))
+}
+
+// TestPostProcessGeneratedCode_Lists checks that kbapi/models.lists.gen.go is
+// the post-processed oapi-codegen output of the Lists group, and regenerates
+// it with -update.
+func TestPostProcessGeneratedCode_Lists(t *testing.T) {
+	data, err := os.ReadFile("testdata/models.lists.input.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []byte(postProcessGeneratedCode(string(data)))
+
+	generated := filepath.Join("..", "..", "kbapi", "models.lists.gen.go")
+	if *update {
+		if err := os.WriteFile(generated, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(generated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("%s differs from the post-processed Lists models, run the test with -update after review", generated)
+	}
+}
+
+func compareGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	golden := filepath.Join("testdata", "golden", name)
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("%s: %v, run the test with -update to create it", name, err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("%s differs from the golden file, run the test with -update after review\n--- got\n%s\n--- want\n%s", name, got, want)
+	}
+}
@@ -0,0 +1,45 @@
+package kbapi
+
+import "context"
+
+type API struct {
+	Alerting
+	Fleet
+}
+
+type Alerting struct {
+	// Get returns a rule. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-alerting-rule-id
+	Get func(ctx context.Context, req *AlertingGetRequest, opts ...RequestOption) (*AlertingGetResponse, error)
+	// Legacy lists the legacy alerts.
+	Legacy func(ctx context.Context, opts ...RequestOption) (*AlertingLegacyResponse, error)
+	// PostAlertingRuleIdEnable performs POST /api/alerting/rule/{id}/_enable. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-alerting-rule-id-enable
+	PostAlertingRuleIdEnable func(ctx context.Context, req *AlertingPostAlertingRuleIdEnableRequest, opts ...RequestOption) (*AlertingPostAlertingRuleIdEnableResponse, error)
+	// GetAlertingRulesFind performs GET /api/alerting/rules/_find. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-alerting-rules-find
+	GetAlertingRulesFind func(ctx context.Context, req *AlertingGetAlertingRulesFindRequest, opts ...RequestOption) (*AlertingGetAlertingRulesFindResponse, error)
+}
+
+type Fleet struct {
+	// PostFleetAgentsAgentidActions performs POST /api/fleet/agents/{agentId}/actions. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-fleet-agents-agentid-actions
+	PostFleetAgentsAgentidActions func(ctx context.Context, req *FleetPostFleetAgentsAgentidActionsRequest, opts ...RequestOption) (*FleetPostFleetAgentsAgentidActionsResponse, error)
+	// PostFleetPackagePoliciesUpgrade performs POST /api/fleet/package_policies/_upgrade. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-fleet-package-policies-upgrade
+	PostFleetPackagePoliciesUpgrade func(ctx context.Context, req *FleetPostFleetPackagePoliciesUpgradeRequest, opts ...RequestOption) (*FleetPostFleetPackagePoliciesUpgradeResponse, error)
+}
+
+// New creates the API.
+func New(t Transport) *API {
+	api := &API{}
+
+	api.Alerting = Alerting{
+		Get:                      api.newAlertingGet(),
+		Legacy:                   api.newAlertingLegacy(),
+		PostAlertingRuleIdEnable: api.newAlertingPostAlertingRuleIdEnable(),
+		GetAlertingRulesFind:     api.newAlertingGetAlertingRulesFind(),
+	}
+
+	api.Fleet = Fleet{
+		PostFleetAgentsAgentidActions:   api.newFleetPostFleetAgentsAgentidActions(),
+		PostFleetPackagePoliciesUpgrade: api.newFleetPostFleetPackagePoliciesUpgrade(),
+	}
+
+	return api
+}
@@ -0,0 +1,20 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+type AlertingLegacyResponse Response[[]byte]
+
+// newAlertingLegacy returns a function that performs GET /api/alerts/_find API requests
+func (api *API) newAlertingLegacy() func(context.Context, ...RequestOption) (*AlertingLegacyResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*AlertingLegacyResponse, error) {
+		res, err := do[noBody, []byte](ctx, api, operation{
+			name:   "alerting.legacy",
+			method: http.MethodGet,
+			path:   "/api/alerts/_find",
+		}, nil, opts)
+		return (*AlertingLegacyResponse)(res), err
+	}
+}
@@ -0,0 +1,26 @@
+package kbapi
+
+import "context"
+
+type API struct {
+	Alerting
+}
+
+type Alerting struct {
+	// Get returns a rule. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-alerting-rule-id
+	Get func(ctx context.Context, req *AlertingGetRequest, opts ...RequestOption) (*AlertingGetResponse, error)
+	// Legacy lists the legacy alerts.
+	Legacy func(ctx context.Context, opts ...RequestOption) (*AlertingLegacyResponse, error)
+}
+
+// New creates the API.
+func New(t Transport) *API {
+	api := &API{}
+
+	api.Alerting = Alerting{
+		Get:    api.newAlertingGet(),
+		Legacy: api.newAlertingLegacy(),
+	}
+
+	return api
+}
@@ -0,0 +1,25 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type AlertingGetRequest struct {
+	ID string
+}
+
+type AlertingGetResponse Response[AlertingRule]
+
+// newAlertingGet returns a function that performs GET /api/alerting/rule/{id} API requests
+func (api *API) newAlertingGet() func(context.Context, *AlertingGetRequest, ...RequestOption) (*AlertingGetResponse, error) {
+	return func(ctx context.Context, req *AlertingGetRequest, opts ...RequestOption) (*AlertingGetResponse, error) {
+		res, err := do[noBody, AlertingRule](ctx, api, operation{
+			name:   "alerting.get",
+			method: http.MethodGet,
+			path:   fmt.Sprintf("/api/alerting/rule/%s", req.ID),
+		}, nil, opts)
+		return (*AlertingGetResponse)(res), err
+	}
+}
@@ -34,22 +34,24 @@ type ApiGroup struct {
 	PathPrefix []string
 	Filename   string
 	Group      string
+	// Struct is the kbapi struct generated endpoints are added to, defaults to Name
+	Struct string
 }
 
 // ApiGroups defines the output groups
 var ApiGroups = []ApiGroup{
 	{Name: "Fleet", PathPrefix: []string{"/api/fleet"}, Filename: "fleet.yml", Group: "fleet"},
-	{Name: "DataViews", PathPrefix: []string{"/api/data_views"}, Filename: "data_views.yml", Group: "dataviews"},
+	{Name: "DataViews", PathPrefix: []string{"/api/data_views"}, Filename: "data_views.yml", Group: "dataviews", Struct: "Dataviews"},
 	{Name: "Alerting", PathPrefix: []string{"/api/alerting"}, Filename: "alerting.yml", Group: "alerting"},
 	{Name: "APM", PathPrefix: []string{"/api/apm"}, Filename: "apm.yml", Group: "apm"},
 	{Name: "Cases", PathPrefix: []string{"/api/cases"}, Filename: "cases.yml", Group: "cases"},
 	{Name: "Connectors", PathPrefix: []string{"/api/actions"}, Filename: "connectors.yml", Group: "connectors"},
-	{Name: "DetectionEngine", PathPrefix: []string{"/api/detection_engine", "/api/exception_list", "/api/exceptions/shared"}, Filename: "detection_engine.yml", Group: "detection"},
+	{Name: "DetectionEngine", PathPrefix: []string{"/api/detection_engine", "/api/exception_list", "/api/exceptions/shared"}, Filename: "detection_engine.yml", Group: "detection", Struct: "SecurityDetections"},
 	{Name: "Roles", PathPrefix: []string{"/api/security/role"}, Filename: "roles.yml", Group: "roles"},
 	{Name: "ML", PathPrefix: []string{"/api/ml"}, Filename: "ml.yml", Group: "ml"},
 	{Name: "SavedObjects", PathPrefix: []string{"/api/saved_objects", "/api/encrypted_saved_objects"}, Filename: "saved_objects.yml", Group: "savedobjects"},
 	{Name: "SecurityAIAssistant", PathPrefix: []string{"/api/security_ai_assistant"}, Filename: "security_ai_assistant.yml", Group: "securityaiassistant"},
-	{Name: "Endpoint", PathPrefix: []string{"/api/endpoint"}, Filename: "endpoint.yml", Group: "endpoint"},
+	{Name: "Endpoint", PathPrefix: []string{"/api/endpoint"}, Filename: "endpoint.yml", Group: "endpoint", Struct: "SecurityEndpointManagement"},
 	{Name: "OSquery", PathPrefix: []string{"/api/osquery"}, Filename: "osquery.yml", Group: "osquery"},
 	{Name: "Spaces", PathPrefix: []string{"/api/spaces"}, Filename: "spaces.yml", Group: "spaces"},
 	{Name: "Status", PathPrefix: []string{"/api/status"}, Filename: "status.yml", Group: "status"},
@@ -74,6 +76,9 @@ type ComponentUsage struct {
 func main() {
 	_inFile := flag.String("i", "", "input file")
 	_oAPICodeGenVersion := flag.String("v", "2.4.1", "Open API Code Generator Version")
+	_kbapiDir := flag.String("kbapi", "../../kbapi", "kbapi package directory")
+	_endpoints := flag.Bool("endpoints", true, "generate the endpoints missing from kbapi")
+	_driftReport := flag.String("drift", "drift.md", "drift report output file")
 	flag.Parse()
 
 	inFile := *_inFile
@@ -189,6 +194,24 @@ func main() {
 		}
 	}
 
+	// Generate the endpoints that have no binding in kbapi yet
+	operations := collectOperations(&schema)
+	bindings, err := findBindings(*_kbapiDir)
+	if err != nil {
+		log.Fatalf("Error finding kbapi bindings: %v", err)
+	}
+	bindOperations(operations, bindings)
+
+	if *_endpoints {
+		if err := generateEndpoints(*_kbapiDir, operations, bindings); err != nil {
+			log.Fatalf("Error generating endpoints: %v", err)
+		}
+	}
+
+	if err := writeDriftReport(*_driftReport, &schema, operations, bindings); err != nil {
+		log.Fatalf("Error writing drift report: %v", err)
+	}
+
 	// Create schema for all other paths and fail
 	miscSchema := Schema{
 		Version:    schema.Version,
@@ -258,6 +281,13 @@ func postProcessGeneratedCode(code string) string {
 							shouldKeep = false
 							break
 						}
+						// Multipart payloads are written by the endpoints themselves, and
+						// their File fields would pull in the oapi-codegen runtime.
+						if strings.HasSuffix(ts.Name.Name, "MultipartBody") ||
+							strings.HasSuffix(ts.Name.Name, "MultipartRequestBody") {
+							shouldKeep = false
+							break
+						}
 						if strings.HasSuffix(ts.Name.Name, "Response") {
 							if st, ok := ts.Type.(*ast.StructType); ok {
 								// Find the JSON200 field
@@ -424,18 +454,22 @@ type Path struct {
 	Get        Map   `yaml:"get,omitempty"`
 	Post       Map   `yaml:"post,omitempty"`
 	Put        Map   `yaml:"put,omitempty"`
+	Patch      Map   `yaml:"patch,omitempty"`
 	Delete     Map   `yaml:"delete,omitempty"`
 }
 
 func (p Path) Endpoints(yield func(key string, endpoint Map) bool) {
-	if p.Get != nil {
-		yield("get", p.Get)
+	if p.Get != nil && !yield("get", p.Get) {
+		return
 	}
-	if p.Post != nil {
-		yield("post", p.Post)
+	if p.Post != nil && !yield("post", p.Post) {
+		return
+	}
+	if p.Put != nil && !yield("put", p.Put) {
+		return
 	}
-	if p.Put != nil {
-		yield("put", p.Put)
+	if p.Patch != nil && !yield("patch", p.Patch) {
+		return
 	}
 	if p.Delete != nil {
 		yield("delete", p.Delete)
@@ -450,6 +484,8 @@ func (p Path) GetEndpoint(method string) Map {
 		return p.Post
 	case "put":
 		return p.Put
+	case "patch":
+		return p.Patch
 	case "delete":
 		return p.Delete
 	default:
@@ -474,6 +510,8 @@ func (p *Path) SetEndpoint(method string, endpoint Map) {
 		p.Post = endpoint
 	case "put":
 		p.Put = endpoint
+	case "patch":
+		p.Patch = endpoint
 	case "delete":
 		p.Delete = endpoint
 	default:
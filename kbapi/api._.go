@@ -6,15 +6,21 @@ import (
 
 type API struct {
 	transport Transport
+	// space is the Kibana space the API is bound to, see Space.
+	space string
 	Alerting
 	APM
 	Cases
 	Connectors
 	Dataviews
+	EntityAnalytics
 	Fleet
 	Endpoint
+	Lists
 	Logstash
+	MaintenanceWindows
 	ML
+	Osquery
 	Roles
 	SavedObjects
 	SecurityAIAssistant
@@ -22,13 +28,18 @@ type API struct {
 	SecurityEndpointManagement
 	SecurityExceptions
 	ShortURL
+	SLOs
 	Spaces
 	Status
+	Streams
+	Synthetics
 	TaskManager
+	Timeline
 	Uptime
 }
 
 type Alerting struct {
+	Backfill AlertingBackfills
 	// Create creates the specified alerting rule. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-alerting-rule-id
 	Create func(ctx context.Context, req *AlertingCreateRequest, opts ...RequestOption) (*AlertingCreateResponse, error)
 	// Delete deletes the specified alerting rule. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-delete-alerting-rule-id
@@ -49,16 +60,31 @@ type Alerting struct {
 	Mute func(ctx context.Context, req *AlertingMuteRequest, opts ...RequestOption) (*AlertingMuteResponse, error)
 	// MuteAll mutes all alerts for the specified rule. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-alerting-rule-id-mute-all
 	MuteAll func(ctx context.Context, req *AlertingMuteAllRequest, opts ...RequestOption) (*AlertingMuteAllResponse, error)
+	// Snooze adds a snooze schedule to the specified rule. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-alerting-rule-id-snooze-schedule
+	Snooze func(ctx context.Context, req *AlertingSnoozeRequest, opts ...RequestOption) (*AlertingSnoozeResponse, error)
 	// Unmute unmutes the specified alert for the rule. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-alerting-rule-rule-id-alert-alert-id-unmute
 	Unmute func(ctx context.Context, req *AlertingUnmuteRequest, opts ...RequestOption) (*AlertingUnmuteResponse, error)
 	// UnmuteAll unmutes all alerts for the specified rule. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-alerting-rule-id-unmute-all
 	UnmuteAll func(ctx context.Context, req *AlertingUnmuteAllRequest, opts ...RequestOption) (*AlertingUnmuteAllResponse, error)
+	// Unsnooze deletes a snooze schedule from the specified rule. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-delete-alerting-rule-ruleid-snooze-schedule-scheduleid
+	Unsnooze func(ctx context.Context, req *AlertingUnsnoozeRequest, opts ...RequestOption) (*AlertingUnsnoozeResponse, error)
 	// Update updates the specified alerting rule. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-put-alerting-rule-id
 	Update func(ctx context.Context, req *AlertingUpdateRequest, opts ...RequestOption) (*AlertingUpdateResponse, error)
 	// UpdateAPIkey updates the API key for the specified rule. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-alerting-rule-id-update-api-key
 	UpdateAPIkey func(ctx context.Context, req *AlertingUpdateAPIKeyRequest, opts ...RequestOption) (*AlertingUpdateAPIKeyResponse, error)
 }
 
+type AlertingBackfills struct {
+	// Delete deletes a backfill and its pending runs. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-delete-alerting-rules-backfill-id
+	Delete func(ctx context.Context, req *AlertingBackfillDeleteRequest, opts ...RequestOption) (*AlertingBackfillDeleteResponse, error)
+	// Find returns a paginated list of backfills. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-alerting-rules-backfill-find
+	Find func(ctx context.Context, req *AlertingBackfillFindRequest, opts ...RequestOption) (*AlertingBackfillFindResponse, error)
+	// Get returns a backfill. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-alerting-rules-backfill-id
+	Get func(ctx context.Context, req *AlertingBackfillGetRequest, opts ...RequestOption) (*AlertingBackfillGetResponse, error)
+	// Schedule schedules runs of rules over past time ranges. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-alerting-rules-backfill-schedule
+	Schedule func(ctx context.Context, req *AlertingBackfillScheduleRequest, opts ...RequestOption) (*AlertingBackfillScheduleResponse, error)
+}
+
 type APM struct {
 	AgentConfiguration
 	AgentKey
@@ -208,6 +234,65 @@ type Dataviews struct {
 	UpdateRuntimeField func(ctx context.Context, req *DataViewsUpdateRuntimeFieldRequest, opts ...RequestOption) (*DataViewsUpdateRuntimeFieldResponse, error)
 }
 
+type EntityAnalytics struct {
+	AssetCriticality AssetCriticality
+	EntityStore      EntityStore
+	RiskEngine       RiskEngine
+}
+
+type AssetCriticality struct {
+	// BulkUpsert creates or updates up to 1000 asset criticality records in one request. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-bulkupsertassetcriticalityrecords
+	BulkUpsert func(ctx context.Context, req *EntityAnalyticsAssetCriticalityBulkUpsertRequest, opts ...RequestOption) (*EntityAnalyticsAssetCriticalityBulkUpsertResponse, error)
+	// Delete deletes the asset criticality record of an entity. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-deleteassetcriticalityrecord
+	Delete func(ctx context.Context, req *EntityAnalyticsAssetCriticalityDeleteRequest, opts ...RequestOption) (*EntityAnalyticsAssetCriticalityDeleteResponse, error)
+	// Get returns the asset criticality record of an entity. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-getassetcriticalityrecord
+	Get func(ctx context.Context, req *EntityAnalyticsAssetCriticalityGetRequest, opts ...RequestOption) (*EntityAnalyticsAssetCriticalityGetResponse, error)
+	// List returns a paginated list of asset criticality records. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-findassetcriticalityrecords
+	List func(ctx context.Context, req *EntityAnalyticsAssetCriticalityListRequest, opts ...RequestOption) (*EntityAnalyticsAssetCriticalityListResponse, error)
+	// UploadCSV creates or updates asset criticality records from a CSV file. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-uploadassetcriticalityrecords
+	UploadCSV func(ctx context.Context, req *EntityAnalyticsAssetCriticalityUploadCSVRequest, opts ...RequestOption) (*EntityAnalyticsAssetCriticalityUploadCSVResponse, error)
+	// Upsert creates or updates the asset criticality record of an entity. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-createassetcriticalityrecord
+	Upsert func(ctx context.Context, req *EntityAnalyticsAssetCriticalityUpsertRequest, opts ...RequestOption) (*EntityAnalyticsAssetCriticalityUpsertResponse, error)
+}
+
+type EntityStore struct {
+	// ApplyDataviewIndices applies the index patterns of the security data view to all engines. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-applyentityenginedataviewindices
+	ApplyDataviewIndices func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsEntityStoreApplyDataviewIndicesResponse, error)
+	// DeleteEngine deletes the engine of an entity type. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-deleteentityengine
+	DeleteEngine func(ctx context.Context, req *EntityAnalyticsEntityStoreDeleteEngineRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreDeleteEngineResponse, error)
+	// Enable initializes the entity store and its engines. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-initentitystore
+	Enable func(ctx context.Context, req *EntityAnalyticsEntityStoreEnableRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreEnableResponse, error)
+	// GetEngine returns the engine of an entity type. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-getentityengine
+	GetEngine func(ctx context.Context, req *EntityAnalyticsEntityStoreGetEngineRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreGetEngineResponse, error)
+	// InitEngine initializes the engine of an entity type. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-initentityengine
+	InitEngine func(ctx context.Context, req *EntityAnalyticsEntityStoreInitEngineRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreInitEngineResponse, error)
+	// ListEngines returns the entity store engines. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-listentityengines
+	ListEngines func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsEntityStoreListEnginesResponse, error)
+	// ListEntities returns a paginated list of entities from the entity store. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-listentities
+	ListEntities func(ctx context.Context, req *EntityAnalyticsEntityStoreListEntitiesRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreListEntitiesResponse, error)
+	// StartEngine starts the engine of an entity type. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-startentityengine
+	StartEngine func(ctx context.Context, req *EntityAnalyticsEntityStoreStartEngineRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreStartEngineResponse, error)
+	// Status returns the status of the entity store and its engines. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-getentitystorestatus
+	Status func(ctx context.Context, req *EntityAnalyticsEntityStoreStatusRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreStatusResponse, error)
+	// StopEngine stops the engine of an entity type. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-stopentityengine
+	StopEngine func(ctx context.Context, req *EntityAnalyticsEntityStoreStopEngineRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreStopEngineResponse, error)
+}
+
+type RiskEngine struct {
+	// CleanUp deletes the risk engine configuration, tasks and data. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-cleanupriskengine
+	CleanUp func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsRiskEngineCleanUpResponse, error)
+	// Disable disables the risk engine. This is an internal Kibana API.
+	Disable func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsRiskEngineDisableResponse, error)
+	// Enable enables the risk engine. This is an internal Kibana API.
+	Enable func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsRiskEngineEnableResponse, error)
+	// Init installs the risk engine resources and enables it. This is an internal Kibana API.
+	Init func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsRiskEngineInitResponse, error)
+	// ScheduleNow schedules the risk engine to run as soon as possible. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-scheduleriskenginenow
+	ScheduleNow func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsRiskEngineScheduleNowResponse, error)
+	// Status returns the status of the risk engine. This is an internal Kibana API.
+	Status func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsRiskEngineStatusResponse, error)
+}
+
 type Fleet struct {
 	AgentActions          AgentActions
 	Agents                Agents
@@ -258,6 +343,8 @@ type AgentActions struct {
 	BulkReassign func(ctx context.Context, req *FleetBulkReassignAgentRequest, opts ...RequestOption) (*FleetBulkReassignAgentResponse, error)
 	// BulkGetDiagnostics gets diagnostics for the specified agents. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-fleet-agents-bulk-request-diagnostics
 	BulkGetDiagnostics func(ctx context.Context, req *FleetBulkGetDiagnosticsAgentRequest, opts ...RequestOption) (*FleetBulkGetDiagnosticsAgentResponse, error)
+	// BulkUnenroll unenrolls the specified agents. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-fleet-agents-bulk-unenroll
+	BulkUnenroll func(ctx context.Context, req *FleetBulkUnenrollAgentsRequest, opts ...RequestOption) (*FleetBulkUnenrollAgentsResponse, error)
 	// BulkUpdateAgentTags updates tags for the specified agents. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-fleet-agents-bulk-update-agent-tags
 	BulkUpdateAgentTags func(ctx context.Context, req *FleetBulkUpdateAgentTagsRequest, opts ...RequestOption) (*FleetBulkUpdateAgentTagsResponse, error)
 	// BulkUpgrade upgrades the specified agents. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-fleet-agents-bulk-upgrade
@@ -479,6 +566,41 @@ type Exceptions struct {
 	Update func(ctx context.Context, req *EndpointExceptionsUpdateRequest, opts ...RequestOption) (*EndpointExceptionsUpdateResponse, error)
 }
 
+type Lists struct {
+	// Create creates a value list. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-createlist
+	Create func(ctx context.Context, req *ListsCreateRequest, opts ...RequestOption) (*ListsCreateResponse, error)
+	// CreateIndex creates the value list data streams. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-createlistindex
+	CreateIndex func(ctx context.Context, opts ...RequestOption) (*ListsCreateIndexResponse, error)
+	// CreateItem creates a value list item. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-createlistitem
+	CreateItem func(ctx context.Context, req *ListsCreateItemRequest, opts ...RequestOption) (*ListsCreateItemResponse, error)
+	// Delete deletes a value list. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-deletelist
+	Delete func(ctx context.Context, req *ListsDeleteRequest, opts ...RequestOption) (*ListsDeleteResponse, error)
+	// DeleteIndex deletes the value list data streams. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-deletelistindex
+	DeleteIndex func(ctx context.Context, opts ...RequestOption) (*ListsDeleteIndexResponse, error)
+	// DeleteItem deletes value list items by ID or by list ID and value. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-deletelistitem
+	DeleteItem func(ctx context.Context, req *ListsDeleteItemRequest, opts ...RequestOption) (*ListsDeleteItemResponse, error)
+	// ExportItems streams the items of a value list to a writer. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-exportlistitems
+	ExportItems func(ctx context.Context, req *ListsExportItemsRequest, opts ...RequestOption) (*ListsExportItemsResponse, error)
+	// Find returns a paginated list of value lists. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-findlists
+	Find func(ctx context.Context, req *ListsFindRequest, opts ...RequestOption) (*ListsFindResponse, error)
+	// FindItems returns a paginated list of the items of a value list. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-findlistitems
+	FindItems func(ctx context.Context, req *ListsFindItemsRequest, opts ...RequestOption) (*ListsFindItemsResponse, error)
+	// Get returns the details of a value list. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-readlist
+	Get func(ctx context.Context, req *ListsGetRequest, opts ...RequestOption) (*ListsGetResponse, error)
+	// GetIndex reports whether the value list data streams exist. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-readlistindex
+	GetIndex func(ctx context.Context, opts ...RequestOption) (*ListsGetIndexResponse, error)
+	// GetItem returns value list items by ID or by list ID and value. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-readlistitem
+	GetItem func(ctx context.Context, req *ListsGetItemRequest, opts ...RequestOption) (*ListsGetItemResponse, error)
+	// GetPrivileges returns the value list privileges of the current user. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-readlistprivileges
+	GetPrivileges func(ctx context.Context, opts ...RequestOption) (*ListsGetPrivilegesResponse, error)
+	// ImportItems streams value list items from a reader into a new or existing list. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-importlistitems
+	ImportItems func(ctx context.Context, req *ListsImportItemsRequest, opts ...RequestOption) (*ListsImportItemsResponse, error)
+	// Update updates a value list. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-updatelist
+	Update func(ctx context.Context, req *ListsUpdateRequest, opts ...RequestOption) (*ListsUpdateResponse, error)
+	// UpdateItem updates a value list item. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-updatelistitem
+	UpdateItem func(ctx context.Context, req *ListsUpdateItemRequest, opts ...RequestOption) (*ListsUpdateItemResponse, error)
+}
+
 type Logstash struct {
 	// Delete deletes the specified Logstash pipeline. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-delete-logstash-pipeline
 	Delete func(ctx context.Context, req *LogstashDeletePipelineRequest, opts ...RequestOption) (*LogstashDeletePipelineResponse, error)
@@ -490,11 +612,75 @@ type Logstash struct {
 	Put func(ctx context.Context, req *LogstashPutPipelineRequest, opts ...RequestOption) (*LogstashPutPipelineResponse, error)
 }
 
+type MaintenanceWindows struct {
+	// Archive archives a maintenance window, ending it and any future occurrences. This is an internal Kibana API.
+	Archive func(ctx context.Context, req *MaintenanceWindowsArchiveRequest, opts ...RequestOption) (*MaintenanceWindowsArchiveResponse, error)
+	// Create creates a maintenance window. This is an internal Kibana API.
+	Create func(ctx context.Context, req *MaintenanceWindowsCreateRequest, opts ...RequestOption) (*MaintenanceWindowsCreateResponse, error)
+	// Delete deletes a maintenance window. This is an internal Kibana API.
+	Delete func(ctx context.Context, req *MaintenanceWindowsDeleteRequest, opts ...RequestOption) (*MaintenanceWindowsDeleteResponse, error)
+	// Find returns a paginated list of maintenance windows. This is an internal Kibana API.
+	Find func(ctx context.Context, req *MaintenanceWindowsFindRequest, opts ...RequestOption) (*MaintenanceWindowsFindResponse, error)
+	// Finish ends the running occurrence of a maintenance window, later occurrences are kept. This is an internal Kibana API.
+	Finish func(ctx context.Context, req *MaintenanceWindowsFinishRequest, opts ...RequestOption) (*MaintenanceWindowsFinishResponse, error)
+	// Get returns a maintenance window. This is an internal Kibana API.
+	Get func(ctx context.Context, req *MaintenanceWindowsGetRequest, opts ...RequestOption) (*MaintenanceWindowsGetResponse, error)
+	// GetActive returns the maintenance windows that are currently running. This is an internal Kibana API.
+	GetActive func(ctx context.Context, opts ...RequestOption) (*MaintenanceWindowsGetActiveResponse, error)
+	// Unarchive restores an archived maintenance window. This is an internal Kibana API.
+	Unarchive func(ctx context.Context, req *MaintenanceWindowsUnarchiveRequest, opts ...RequestOption) (*MaintenanceWindowsUnarchiveResponse, error)
+	// Update updates a maintenance window. This is an internal Kibana API.
+	Update func(ctx context.Context, req *MaintenanceWindowsUpdateRequest, opts ...RequestOption) (*MaintenanceWindowsUpdateResponse, error)
+}
+
 type ML struct {
 	// SyncSavedObjects synchronizes Kibana saved objects for machine learning jobs and trained models in the default space. See https://www.elastic.co/docs/api/doc/kibana/operation/operation-mlsync
 	SyncSavedObjects func(ctx context.Context, req *MLSyncSavedObjectsRequest, opts ...RequestOption) (*MLSyncSavedObjectsResponse, error)
 }
 
+type Osquery struct {
+	LiveQueries  LiveQueries
+	Packs        Packs
+	SavedQueries SavedQueries
+}
+
+type LiveQueries struct {
+	// Create creates and runs a live query. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-osquerycreatelivequery
+	Create func(ctx context.Context, req *OsqueryLiveQueriesCreateRequest, opts ...RequestOption) (*OsqueryLiveQueriesCreateResponse, error)
+	// Get returns the details of a live query, including the status of each query action. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-osquerygetlivequerydetails
+	Get func(ctx context.Context, req *OsqueryLiveQueriesGetRequest, opts ...RequestOption) (*OsqueryLiveQueriesGetResponse, error)
+	// GetResults returns the results of a live query action. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-osquerygetlivequeryresults
+	GetResults func(ctx context.Context, req *OsqueryLiveQueriesGetResultsRequest, opts ...RequestOption) (*OsqueryLiveQueriesGetResultsResponse, error)
+	// List returns a list of all live queries. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-osqueryfindlivequeries
+	List func(ctx context.Context, req *OsqueryLiveQueriesListRequest, opts ...RequestOption) (*OsqueryLiveQueriesListResponse, error)
+}
+
+type Packs struct {
+	// Create creates a query pack. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-osquerycreatepacks
+	Create func(ctx context.Context, req *OsqueryPacksCreateRequest, opts ...RequestOption) (*OsqueryPacksCreateResponse, error)
+	// Delete deletes the specified query pack. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-osquerydeletepacks
+	Delete func(ctx context.Context, req *OsqueryPacksDeleteRequest, opts ...RequestOption) (*OsqueryPacksDeleteResponse, error)
+	// Get returns the details of a query pack. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-osquerygetpacksdetails
+	Get func(ctx context.Context, req *OsqueryPacksGetRequest, opts ...RequestOption) (*OsqueryPacksGetResponse, error)
+	// List returns a list of all query packs. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-osqueryfindpacks
+	List func(ctx context.Context, req *OsqueryPacksListRequest, opts ...RequestOption) (*OsqueryPacksListResponse, error)
+	// Update updates the specified query pack. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-osqueryupdatepacks
+	Update func(ctx context.Context, req *OsqueryPacksUpdateRequest, opts ...RequestOption) (*OsqueryPacksUpdateResponse, error)
+}
+
+type SavedQueries struct {
+	// Create creates a saved query. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-osquerycreatesavedquery
+	Create func(ctx context.Context, req *OsquerySavedQueriesCreateRequest, opts ...RequestOption) (*OsquerySavedQueriesCreateResponse, error)
+	// Delete deletes the specified saved query. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-osquerydeletesavedquery
+	Delete func(ctx context.Context, req *OsquerySavedQueriesDeleteRequest, opts ...RequestOption) (*OsquerySavedQueriesDeleteResponse, error)
+	// Get returns the details of a saved query. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-osquerygetsavedquerydetails
+	Get func(ctx context.Context, req *OsquerySavedQueriesGetRequest, opts ...RequestOption) (*OsquerySavedQueriesGetResponse, error)
+	// List returns a list of all saved queries. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-osqueryfindsavedqueries
+	List func(ctx context.Context, req *OsquerySavedQueriesListRequest, opts ...RequestOption) (*OsquerySavedQueriesListResponse, error)
+	// Update updates the specified saved query. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-osqueryupdatesavedquery
+	Update func(ctx context.Context, req *OsquerySavedQueriesUpdateRequest, opts ...RequestOption) (*OsquerySavedQueriesUpdateResponse, error)
+}
+
 type Roles struct {
 	// CreateOrUpdateMulti creates or updates multiple roles. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-security-roles
 	CreateOrUpdateMulti func(ctx context.Context, req *RolesCreateOrUpdateMultiRequest, opts ...RequestOption) (*RolesCreateOrUpdateMultiResponse, error)
@@ -509,14 +695,32 @@ type Roles struct {
 }
 
 type SavedObjects struct {
+	// BulkCreate creates multiple saved objects. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-bulkcreatesavedobjects
+	BulkCreate func(ctx context.Context, req *SavedObjectBulkCreateRequest, opts ...RequestOption) (*SavedObjectBulkCreateResponse, error)
+	// BulkGet returns multiple saved objects. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-bulkgetsavedobjects
+	BulkGet func(ctx context.Context, req *SavedObjectBulkGetRequest, opts ...RequestOption) (*SavedObjectBulkGetResponse, error)
+	// Create creates a saved object. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-createsavedobject
+	Create func(ctx context.Context, req *SavedObjectCreateRequest, opts ...RequestOption) (*SavedObjectCreateResponse, error)
+	// Delete deletes a saved object. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-deletesavedobject
+	Delete func(ctx context.Context, req *SavedObjectDeleteRequest, opts ...RequestOption) (*SavedObjectDeleteResponse, error)
 	// Export exports the specified objects. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-exportsavedobjectsdefault
 	Export func(ctx context.Context, req *SavedObjectExportRequest, opts ...RequestOption) (*SavedObjectExportResponse, error)
+	// ExportStream exports the specified objects and streams them instead of buffering the export. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-exportsavedobjectsdefault
+	ExportStream func(ctx context.Context, req *SavedObjectExportRequest, opts ...RequestOption) (*SavedObjectExportStreamResponse, error)
+	// ExportTo exports the specified objects and writes the NDJSON export to an io.Writer. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-exportsavedobjectsdefault
+	ExportTo func(ctx context.Context, req *SavedObjectExportToRequest, opts ...RequestOption) (*SavedObjectExportToResponse, error)
+	// Find returns a paginated list of saved objects. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-findsavedobjects
+	Find func(ctx context.Context, req *SavedObjectFindRequest, opts ...RequestOption) (*SavedObjectFindResponse, error)
+	// Get returns a saved object. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-getsavedobject
+	Get func(ctx context.Context, req *SavedObjectGetRequest, opts ...RequestOption) (*SavedObjectGetResponse, error)
 	// Import imports the specified objects. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-importsavedobjectsdefault
 	Import func(ctx context.Context, req *SavedObjectImportRequest, opts ...RequestOption) (*SavedObjectImportResponse, error)
 	// ResolveImport resolves import errors. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-resolveimporterrors
 	ResolveImport func(ctx context.Context, req *SavedObjectResolveImportsRequest, opts ...RequestOption) (*SavedObjectResolveImportsResponse, error)
 	// RotateKey rorates a key for encrypted saved objects. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-rotateencryptionkey
 	RotateKey func(ctx context.Context, req *SavedObjectRotateKeyRequest, opts ...RequestOption) (*SavedObjectRotateKeyResponse, error)
+	// Update updates the attributes of a saved object. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-updatesavedobject
+	Update func(ctx context.Context, req *SavedObjectUpdateRequest, opts ...RequestOption) (*SavedObjectUpdateResponse, error)
 }
 
 type SecurityAIAssistant struct {
@@ -571,6 +775,10 @@ type SecurityDetections struct {
 	DeleteRule func(ctx context.Context, req *SecurityDetectionsDeleteRuleRequest, opts ...RequestOption) (*SecurityDetectionsDeleteRuleResponse, error)
 	// ExportRules exports the specified rules. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-exportrules
 	ExportRules func(ctx context.Context, req *SecurityDetectionsExportRulesRequest, opts ...RequestOption) (*SecurityDetectionsExportRulesResponse, error)
+	// ExportRulesStream exports the specified rules and streams them instead of buffering the export. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-exportrules
+	ExportRulesStream func(ctx context.Context, req *SecurityDetectionsExportRulesRequest, opts ...RequestOption) (*SecurityDetectionsExportRulesStreamResponse, error)
+	// ExportRulesTo exports the specified rules and writes the NDJSON export to an io.Writer. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-exportrules
+	ExportRulesTo func(ctx context.Context, req *SecurityDetectionsExportRulesToRequest, opts ...RequestOption) (*SecurityDetectionsExportRulesToResponse, error)
 	// GetIndex returns the alert index name if it exists. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-readalertsindex
 	GetIndex func(ctx context.Context, opts ...RequestOption) (*SecurityDetectionsGetIndexResponse, error)
 	// GetRule returns the specified rule. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-readrule
@@ -625,6 +833,10 @@ type SecurityExceptions struct {
 	DuplicateList func(ctx context.Context, req *SecurityExceptionsDuplicateListRequest, opts ...RequestOption) (*SecurityExceptionsDuplicateListResponse, error)
 	// ExportList exports an exception list and its associated items to an NDJSON file. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-exportexceptionlist
 	ExportList func(ctx context.Context, req *SecurityExceptionsExportListRequest, opts ...RequestOption) (*SecurityExceptionsExportListResponse, error)
+	// ExportListStream exports an exception list and its items and streams them instead of buffering the export. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-exportexceptionlist
+	ExportListStream func(ctx context.Context, req *SecurityExceptionsExportListRequest, opts ...RequestOption) (*SecurityExceptionsExportListStreamResponse, error)
+	// ExportListTo exports an exception list and its items and writes the NDJSON export to an io.Writer. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-exportexceptionlist
+	ExportListTo func(ctx context.Context, req *SecurityExceptionsExportListToRequest, opts ...RequestOption) (*SecurityExceptionsExportListToResponse, error)
 	// GetList Get the details of an exception list using the id or list_id field. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-readexceptionlist
 	GetList func(ctx context.Context, req *SecurityExceptionsGetListRequest, opts ...RequestOption) (*SecurityExceptionsGetListResponse, error)
 	// GetItem returns the details of an exception list item using the id or item_id field. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-readexceptionlistitem
@@ -654,6 +866,29 @@ type ShortURL struct {
 	Resolve func(ctx context.Context, req *ShortURLResolveRequest, opts ...RequestOption) (*ShortURLResolveResponse, error)
 }
 
+type SLOs struct {
+	// Create creates an SLO. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-createsloop
+	Create func(ctx context.Context, req *SLOsCreateRequest, opts ...RequestOption) (*SLOsCreateResponse, error)
+	// Delete deletes the specified SLO. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-deletesloop
+	Delete func(ctx context.Context, req *SLOsDeleteRequest, opts ...RequestOption) (*SLOsDeleteResponse, error)
+	// DeleteInstances deletes the rollup and summary data of SLO instances. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-deletesloinstancesop
+	DeleteInstances func(ctx context.Context, req *SLOsDeleteInstancesRequest, opts ...RequestOption) (*SLOsDeleteInstancesResponse, error)
+	// Disable disables the specified SLO. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-disablesloop
+	Disable func(ctx context.Context, req *SLOsDisableRequest, opts ...RequestOption) (*SLOsDisableResponse, error)
+	// Enable enables the specified SLO. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-enablesloop
+	Enable func(ctx context.Context, req *SLOsEnableRequest, opts ...RequestOption) (*SLOsEnableResponse, error)
+	// Find returns a paginated list of SLOs. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-findslosop
+	Find func(ctx context.Context, req *SLOsFindRequest, opts ...RequestOption) (*SLOsFindResponse, error)
+	// FindDefinitions returns a paginated list of SLO definitions. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-getdefinitionsop
+	FindDefinitions func(ctx context.Context, req *SLOsFindDefinitionsRequest, opts ...RequestOption) (*SLOsFindDefinitionsResponse, error)
+	// Get returns the specified SLO with its summary. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-getsloop
+	Get func(ctx context.Context, req *SLOsGetRequest, opts ...RequestOption) (*SLOsGetResponse, error)
+	// Reset resets the specified SLO, recreating its rollup and summary data. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-resetsloop
+	Reset func(ctx context.Context, req *SLOsResetRequest, opts ...RequestOption) (*SLOsResetResponse, error)
+	// Update updates the specified SLO. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-updatesloop
+	Update func(ctx context.Context, req *SLOsUpdateRequest, opts ...RequestOption) (*SLOsUpdateResponse, error)
+}
+
 type Spaces struct {
 	// CopyObjects copies saved objects between spaces. See https://www.elastic.co/docs/api/doc/kibana/operation/operation-post-spaces-copy-saved-objects
 	CopyObjects func(ctx context.Context, req *SpacesCopyObjectsRequest, opts ...RequestOption) (*SpacesCopyObjectsResponse, error)
@@ -671,6 +906,8 @@ type Spaces struct {
 	UpdateObjects func(ctx context.Context, req *SpacesUpdateObjectsRequest, opts ...RequestOption) (*SpacesUpdateObjectsResponse, error)
 	// GetShareableReferences  collects references and space contexts for saved objects. See https://www.elastic.co/docs/api/doc/kibana/operation/operation-post-spaces-get-shareable-references
 	GetShareableReferences func(ctx context.Context, req *SpacesShareableReferencesRequest, opts ...RequestOption) (*SpacesShareableReferencesResponse, error)
+	// ResolveCopyErrors overwrites or copies as new the saved objects that could not be copied between spaces. See https://www.elastic.co/docs/api/doc/kibana/operation/operation-post-spaces-resolve-copy-saved-objects-errors
+	ResolveCopyErrors func(ctx context.Context, req *SpacesResolveCopyErrorsRequest, opts ...RequestOption) (*SpacesResolveCopyErrorsResponse, error)
 	// SpacesDisableLegacyURLAliases  leaves the alias intact but the legacy URL for the alias will no longer function. See https://www.elastic.co/docs/api/doc/kibana/operation/operation-post-spaces-disable-legacy-url-aliases
 	SpacesDisableLegacyURLAliases func(ctx context.Context, req *SpacesDisableLegacyURLRequest, opts ...RequestOption) (*SpacesDisableLegacyURLResponse, error)
 }
@@ -682,11 +919,134 @@ type Status struct {
 	GetRedacted func(ctx context.Context, req *GetStatusRequest, opts ...RequestOption) (*StatusRedactedResponse, error)
 }
 
+type Streams struct {
+	// BulkDashboards links and unlinks dashboards of a stream in one request. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-streams-name-dashboards-bulk
+	BulkDashboards func(ctx context.Context, req *StreamsBulkDashboardsRequest, opts ...RequestOption) (*StreamsBulkDashboardsResponse, error)
+	// BulkQueries creates, updates and deletes queries of a stream in one request. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-streams-name-queries-bulk
+	BulkQueries func(ctx context.Context, req *StreamsBulkQueriesRequest, opts ...RequestOption) (*StreamsBulkQueriesResponse, error)
+	// Delete deletes a stream and its child streams. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-delete-streams-name
+	Delete func(ctx context.Context, req *StreamsDeleteRequest, opts ...RequestOption) (*StreamsDeleteResponse, error)
+	// DeleteQuery deletes a query of a stream. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-delete-streams-name-queries-queryid
+	DeleteQuery func(ctx context.Context, req *StreamsDeleteQueryRequest, opts ...RequestOption) (*StreamsDeleteQueryResponse, error)
+	// Disable disables wired streams and deletes their data. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-streams-disable
+	Disable func(ctx context.Context, opts ...RequestOption) (*StreamsDisableResponse, error)
+	// Enable enables wired streams. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-streams-enable
+	Enable func(ctx context.Context, opts ...RequestOption) (*StreamsEnableResponse, error)
+	// Fork creates a child stream that receives the documents of a stream matching a condition. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-streams-name-fork
+	Fork func(ctx context.Context, req *StreamsForkRequest, opts ...RequestOption) (*StreamsForkResponse, error)
+	// Get returns the definition of a stream with its linked dashboards and queries. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-streams-name
+	Get func(ctx context.Context, req *StreamsGetRequest, opts ...RequestOption) (*StreamsGetResponse, error)
+	// GetIngest returns the ingest settings of a stream. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-streams-name-ingest
+	GetIngest func(ctx context.Context, req *StreamsGetIngestRequest, opts ...RequestOption) (*StreamsGetIngestResponse, error)
+	// LinkDashboard links a dashboard to a stream. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-put-streams-name-dashboards-dashboardid
+	LinkDashboard func(ctx context.Context, req *StreamsLinkDashboardRequest, opts ...RequestOption) (*StreamsLinkDashboardResponse, error)
+	// List returns the definitions of all streams. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-streams
+	List func(ctx context.Context, opts ...RequestOption) (*StreamsListResponse, error)
+	// ListDashboards returns the dashboards linked to a stream. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-streams-name-dashboards
+	ListDashboards func(ctx context.Context, req *StreamsListDashboardsRequest, opts ...RequestOption) (*StreamsListDashboardsResponse, error)
+	// ListQueries returns the queries linked to a stream. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-streams-name-queries
+	ListQueries func(ctx context.Context, req *StreamsListQueriesRequest, opts ...RequestOption) (*StreamsListQueriesResponse, error)
+	// Resync resyncs the Elasticsearch resources of all streams. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-streams-resync
+	Resync func(ctx context.Context, opts ...RequestOption) (*StreamsResyncResponse, error)
+	// UnlinkDashboard unlinks a dashboard from a stream. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-delete-streams-name-dashboards-dashboardid
+	UnlinkDashboard func(ctx context.Context, req *StreamsUnlinkDashboardRequest, opts ...RequestOption) (*StreamsUnlinkDashboardResponse, error)
+	// Upsert creates or updates a wired or classic stream. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-put-streams-name
+	Upsert func(ctx context.Context, req *StreamsUpsertRequest, opts ...RequestOption) (*StreamsUpsertResponse, error)
+	// UpsertIngest creates or updates the ingest settings of a stream. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-put-streams-name-ingest
+	UpsertIngest func(ctx context.Context, req *StreamsUpsertIngestRequest, opts ...RequestOption) (*StreamsUpsertIngestResponse, error)
+	// UpsertQuery creates or updates a query of a stream. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-put-streams-name-queries-queryid
+	UpsertQuery func(ctx context.Context, req *StreamsUpsertQueryRequest, opts ...RequestOption) (*StreamsUpsertQueryResponse, error)
+}
+
+type Synthetics struct {
+	Monitors         SyntheticsMonitors
+	Params           SyntheticsParams
+	PrivateLocations SyntheticsPrivateLocations
+}
+
+type SyntheticsMonitors struct {
+	// BulkDelete deletes several monitors. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-delete-synthetic-monitors
+	BulkDelete func(ctx context.Context, req *SyntheticsMonitorsBulkDeleteRequest, opts ...RequestOption) (*SyntheticsMonitorsBulkDeleteResponse, error)
+	// Create creates a monitor. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-synthetic-monitors
+	Create func(ctx context.Context, req *SyntheticsMonitorsCreateRequest, opts ...RequestOption) (*SyntheticsMonitorsCreateResponse, error)
+	// Delete deletes a monitor. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-delete-synthetic-monitor
+	Delete func(ctx context.Context, req *SyntheticsMonitorsDeleteRequest, opts ...RequestOption) (*SyntheticsMonitorsDeleteResponse, error)
+	// Get returns a monitor. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-synthetic-monitor
+	Get func(ctx context.Context, req *SyntheticsMonitorsGetRequest, opts ...RequestOption) (*SyntheticsMonitorsGetResponse, error)
+	// List returns a paginated list of monitors. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-synthetic-monitors
+	List func(ctx context.Context, req *SyntheticsMonitorsListRequest, opts ...RequestOption) (*SyntheticsMonitorsListResponse, error)
+	// Update updates a monitor. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-put-synthetic-monitor
+	Update func(ctx context.Context, req *SyntheticsMonitorsUpdateRequest, opts ...RequestOption) (*SyntheticsMonitorsUpdateResponse, error)
+}
+
+type SyntheticsParams struct {
+	// BulkDelete deletes several global parameters. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-delete-parameters
+	BulkDelete func(ctx context.Context, req *SyntheticsParamsBulkDeleteRequest, opts ...RequestOption) (*SyntheticsParamsBulkDeleteResponse, error)
+	// Create creates a global parameter. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-parameters
+	Create func(ctx context.Context, req *SyntheticsParamsCreateRequest, opts ...RequestOption) (*SyntheticsParamsCreateResponse, error)
+	// Delete deletes a global parameter. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-delete-parameter
+	Delete func(ctx context.Context, req *SyntheticsParamsDeleteRequest, opts ...RequestOption) (*SyntheticsParamsDeleteResponse, error)
+	// Get returns a global parameter. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-parameter
+	Get func(ctx context.Context, req *SyntheticsParamsGetRequest, opts ...RequestOption) (*SyntheticsParamsGetResponse, error)
+	// List returns all global parameters. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-parameters
+	List func(ctx context.Context, opts ...RequestOption) (*SyntheticsParamsListResponse, error)
+	// Update updates a global parameter. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-put-parameter
+	Update func(ctx context.Context, req *SyntheticsParamsUpdateRequest, opts ...RequestOption) (*SyntheticsParamsUpdateResponse, error)
+}
+
+type SyntheticsPrivateLocations struct {
+	// Create creates a private location running monitors on a Fleet agent policy. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-post-private-location
+	Create func(ctx context.Context, req *SyntheticsPrivateLocationsCreateRequest, opts ...RequestOption) (*SyntheticsPrivateLocationsCreateResponse, error)
+	// Delete deletes a private location. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-delete-private-location
+	Delete func(ctx context.Context, req *SyntheticsPrivateLocationsDeleteRequest, opts ...RequestOption) (*SyntheticsPrivateLocationsDeleteResponse, error)
+	// Get returns a private location. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-private-location
+	Get func(ctx context.Context, req *SyntheticsPrivateLocationsGetRequest, opts ...RequestOption) (*SyntheticsPrivateLocationsGetResponse, error)
+	// List returns all private locations. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-private-locations
+	List func(ctx context.Context, opts ...RequestOption) (*SyntheticsPrivateLocationsListResponse, error)
+	// Update updates the label of a private location. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-put-private-location
+	Update func(ctx context.Context, req *SyntheticsPrivateLocationsUpdateRequest, opts ...RequestOption) (*SyntheticsPrivateLocationsUpdateResponse, error)
+}
+
 type TaskManager struct {
 	// Health gets the task manager health. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-task-manager-health
 	Health func(ctx context.Context, opts ...RequestOption) (*TaskManagerHealthResponse, error)
 }
 
+type Timeline struct {
+	// CleanDraft cleans the draft timeline or timeline template of the current user. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-cleandrafttimelines
+	CleanDraft func(ctx context.Context, req *TimelineCleanDraftRequest, opts ...RequestOption) (*TimelineCleanDraftResponse, error)
+	// Copy copies a timeline or timeline template. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-copytimeline
+	Copy func(ctx context.Context, req *TimelineCopyRequest, opts ...RequestOption) (*TimelineCopyResponse, error)
+	// Create creates a new timeline or timeline template. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-createtimelines
+	Create func(ctx context.Context, req *TimelineCreateRequest, opts ...RequestOption) (*TimelineCreateResponse, error)
+	// Delete deletes one or more timelines or timeline templates. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-deletetimelines
+	Delete func(ctx context.Context, req *TimelineDeleteRequest, opts ...RequestOption) (*TimelineDeleteResponse, error)
+	// DeleteNotes deletes one or more notes. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-deletenote
+	DeleteNotes func(ctx context.Context, req *TimelineDeleteNotesRequest, opts ...RequestOption) (*TimelineDeleteNotesResponse, error)
+	// Export exports timelines as NDJSON. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-exporttimelines
+	Export func(ctx context.Context, req *TimelineExportRequest, opts ...RequestOption) (*TimelineExportResponse, error)
+	// Get returns the details of a timeline or timeline template. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-gettimeline
+	Get func(ctx context.Context, req *TimelineGetRequest, opts ...RequestOption) (*TimelineGetResponse, error)
+	// GetDraft returns the draft timeline or timeline template of the current user. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-getdrafttimelines
+	GetDraft func(ctx context.Context, req *TimelineGetDraftRequest, opts ...RequestOption) (*TimelineGetDraftResponse, error)
+	// GetNotes returns the notes of documents or saved objects. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-getnotes
+	GetNotes func(ctx context.Context, req *TimelineGetNotesRequest, opts ...RequestOption) (*TimelineGetNotesResponse, error)
+	// Import imports timelines from an NDJSON file. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-importtimelines
+	Import func(ctx context.Context, req *TimelineImportRequest, opts ...RequestOption) (*TimelineImportResponse, error)
+	// InstallPrepackaged installs or updates the prepackaged timeline templates. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-installprepackedtimelines
+	InstallPrepackaged func(ctx context.Context, req *TimelineInstallPrepackagedRequest, opts ...RequestOption) (*TimelineInstallPrepackagedResponse, error)
+	// List returns a list of timelines or timeline templates. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-gettimelines
+	List func(ctx context.Context, req *TimelineListRequest, opts ...RequestOption) (*TimelineListResponse, error)
+	// PersistNote creates or updates a note. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-persistnoteroute
+	PersistNote func(ctx context.Context, req *TimelinePersistNoteRequest, opts ...RequestOption) (*TimelinePersistNoteResponse, error)
+	// PersistPinnedEvent pins or unpins an event in a timeline. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-persistpinnedeventroute
+	PersistPinnedEvent func(ctx context.Context, req *TimelinePersistPinnedEventRequest, opts ...RequestOption) (*TimelinePersistPinnedEventResponse, error)
+	// Resolve resolves a timeline or timeline template ID, following legacy URL aliases. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-resolvetimeline
+	Resolve func(ctx context.Context, req *TimelineResolveRequest, opts ...RequestOption) (*TimelineResolveResponse, error)
+	// Update updates a timeline or timeline template. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-patchtimeline
+	Update func(ctx context.Context, req *TimelineUpdateRequest, opts ...RequestOption) (*TimelineUpdateResponse, error)
+}
+
 type Uptime struct {
 	// GetSettings returns uptime settings. See https://www.elastic.co/docs/api/doc/kibana/v9/operation/operation-get-uptime-settings
 	GetSettings func(ctx context.Context, opts ...RequestOption) (*UptimeGetSettingsResponse, error)
@@ -701,6 +1061,12 @@ func New(t Transport) *API {
 	}
 
 	api.Alerting = Alerting{
+		Backfill: AlertingBackfills{
+			Delete:   api.newAlertingBackfillDelete(),
+			Find:     api.newAlertingBackfillFind(),
+			Get:      api.newAlertingBackfillGet(),
+			Schedule: api.newAlertingBackfillSchedule(),
+		},
 		Create:       api.newAlertingCreate(),
 		Delete:       api.newAlertingDelete(),
 		Disable:      api.newAlertingDisable(),
@@ -711,8 +1077,10 @@ func New(t Transport) *API {
 		List:         api.newAlertingList(),
 		Mute:         api.newAlertingMute(),
 		MuteAll:      api.newAlertingMuteAll(),
+		Snooze:       api.newAlertingSnooze(),
 		Unmute:       api.newAlertingUnmute(),
 		UnmuteAll:    api.newAlertingUnmuteAll(),
+		Unsnooze:     api.newAlertingUnsnooze(),
 		Update:       api.newAlertingUpdate(),
 		UpdateAPIkey: api.newAlertingUpdateAPIKey(),
 	}
@@ -796,6 +1164,37 @@ func New(t Transport) *API {
 		UpdateRuntimeField:       api.newDataViewsUpdateRuntimeField(),
 	}
 
+	api.EntityAnalytics = EntityAnalytics{
+		AssetCriticality: AssetCriticality{
+			BulkUpsert: api.newEntityAnalyticsAssetCriticalityBulkUpsert(),
+			Delete:     api.newEntityAnalyticsAssetCriticalityDelete(),
+			Get:        api.newEntityAnalyticsAssetCriticalityGet(),
+			List:       api.newEntityAnalyticsAssetCriticalityList(),
+			UploadCSV:  api.newEntityAnalyticsAssetCriticalityUploadCSV(),
+			Upsert:     api.newEntityAnalyticsAssetCriticalityUpsert(),
+		},
+		EntityStore: EntityStore{
+			ApplyDataviewIndices: api.newEntityAnalyticsEntityStoreApplyDataviewIndices(),
+			DeleteEngine:         api.newEntityAnalyticsEntityStoreDeleteEngine(),
+			Enable:               api.newEntityAnalyticsEntityStoreEnable(),
+			GetEngine:            api.newEntityAnalyticsEntityStoreGetEngine(),
+			InitEngine:           api.newEntityAnalyticsEntityStoreInitEngine(),
+			ListEngines:          api.newEntityAnalyticsEntityStoreListEngines(),
+			ListEntities:         api.newEntityAnalyticsEntityStoreListEntities(),
+			StartEngine:          api.newEntityAnalyticsEntityStoreStartEngine(),
+			Status:               api.newEntityAnalyticsEntityStoreStatus(),
+			StopEngine:           api.newEntityAnalyticsEntityStoreStopEngine(),
+		},
+		RiskEngine: RiskEngine{
+			CleanUp:     api.newEntityAnalyticsRiskEngineCleanUp(),
+			Disable:     api.newEntityAnalyticsRiskEngineDisable(),
+			Enable:      api.newEntityAnalyticsRiskEngineEnable(),
+			Init:        api.newEntityAnalyticsRiskEngineInit(),
+			ScheduleNow: api.newEntityAnalyticsRiskEngineScheduleNow(),
+			Status:      api.newEntityAnalyticsRiskEngineStatus(),
+		},
+	}
+
 	api.Fleet = Fleet{
 		Agents: Agents{
 			DeleteFile:     api.newFleetDeleteFile(),
@@ -825,6 +1224,7 @@ func New(t Transport) *API {
 		AgentActions: AgentActions{
 			BulkGetDiagnostics:  api.newFleetBulkGetDiagnosticsAgents(),
 			BulkReassign:        api.newFleetBulkReassignAgents(),
+			BulkUnenroll:        api.newFleetBulkUnenrollAgents(),
 			BulkUpdateAgentTags: api.newFleetBulkUpdateAgentTags(),
 			BulkUpgrade:         api.newFleetBulkUpgradeAgents(),
 			Cancel:              api.newFleetAgentActionsCancel(),
@@ -934,6 +1334,25 @@ func New(t Transport) *API {
 		},
 	}
 
+	api.Lists = Lists{
+		Create:        api.newListsCreate(),
+		CreateIndex:   api.newListsCreateIndex(),
+		CreateItem:    api.newListsCreateItem(),
+		Delete:        api.newListsDelete(),
+		DeleteIndex:   api.newListsDeleteIndex(),
+		DeleteItem:    api.newListsDeleteItem(),
+		ExportItems:   api.newListsExportItems(),
+		Find:          api.newListsFind(),
+		FindItems:     api.newListsFindItems(),
+		Get:           api.newListsGet(),
+		GetIndex:      api.newListsGetIndex(),
+		GetItem:       api.newListsGetItem(),
+		GetPrivileges: api.newListsGetPrivileges(),
+		ImportItems:   api.newListsImportItems(),
+		Update:        api.newListsUpdate(),
+		UpdateItem:    api.newListsUpdateItem(),
+	}
+
 	api.Logstash = Logstash{
 		Delete: api.newLogstashDeletePipeline(),
 		Get:    api.newLogstashGetPipeline(),
@@ -941,10 +1360,45 @@ func New(t Transport) *API {
 		Put:    api.newLogstashPutPipeline(),
 	}
 
+	api.MaintenanceWindows = MaintenanceWindows{
+		Archive:   api.newMaintenanceWindowsArchive(),
+		Create:    api.newMaintenanceWindowsCreate(),
+		Delete:    api.newMaintenanceWindowsDelete(),
+		Find:      api.newMaintenanceWindowsFind(),
+		Finish:    api.newMaintenanceWindowsFinish(),
+		Get:       api.newMaintenanceWindowsGet(),
+		GetActive: api.newMaintenanceWindowsGetActive(),
+		Unarchive: api.newMaintenanceWindowsUnarchive(),
+		Update:    api.newMaintenanceWindowsUpdate(),
+	}
+
 	api.ML = ML{
 		SyncSavedObjects: api.newMLSyncSavedObjects(),
 	}
 
+	api.Osquery = Osquery{
+		LiveQueries: LiveQueries{
+			Create:     api.newOsqueryLiveQueriesCreate(),
+			Get:        api.newOsqueryLiveQueriesGet(),
+			GetResults: api.newOsqueryLiveQueriesGetResults(),
+			List:       api.newOsqueryLiveQueriesList(),
+		},
+		Packs: Packs{
+			Create: api.newOsqueryPacksCreate(),
+			Delete: api.newOsqueryPacksDelete(),
+			Get:    api.newOsqueryPacksGet(),
+			List:   api.newOsqueryPacksList(),
+			Update: api.newOsqueryPacksUpdate(),
+		},
+		SavedQueries: SavedQueries{
+			Create: api.newOsquerySavedQueriesCreate(),
+			Delete: api.newOsquerySavedQueriesDelete(),
+			Get:    api.newOsquerySavedQueriesGet(),
+			List:   api.newOsquerySavedQueriesList(),
+			Update: api.newOsquerySavedQueriesUpdate(),
+		},
+	}
+
 	api.Roles = Roles{
 		CreateOrUpdateMulti:  api.newRolesCreateOrUpdateMulti(),
 		CreateOrUpdateSingle: api.newRolesCreateUpdateSingleRole(),
@@ -954,10 +1408,19 @@ func New(t Transport) *API {
 	}
 
 	api.SavedObjects = SavedObjects{
+		BulkCreate:    api.newSavedObjectBulkCreate(),
+		BulkGet:       api.newSavedObjectBulkGet(),
+		Create:        api.newSavedObjectCreate(),
+		Delete:        api.newSavedObjectDelete(),
 		Export:        api.newSavedObjectExport(),
+		ExportStream:  api.newSavedObjectExportStream(),
+		ExportTo:      api.newSavedObjectExportTo(),
+		Find:          api.newSavedObjectFind(),
+		Get:           api.newSavedObjectGet(),
 		Import:        api.newSavedObjectImport(),
 		ResolveImport: api.newSavedObjectResolveImports(),
 		RotateKey:     api.newSavedObjectRotateKey(),
+		Update:        api.newSavedObjectUpdate(),
 	}
 
 	api.SecurityAIAssistant = SecurityAIAssistant{
@@ -986,6 +1449,8 @@ func New(t Transport) *API {
 		CreateIndex:        api.newSecurityDetectionsCreateIndex(),
 		CreateRule:         api.newSecurityDetectionsCreateRule(),
 		ExportRules:        api.newSecurityDetectionsExportRules(),
+		ExportRulesStream:  api.newSecurityDetectionsExportRulesStream(),
+		ExportRulesTo:      api.newSecurityDetectionsExportRulesTo(),
 		DeleteIndex:        api.newSecurityDetectionsDeleteIndex(),
 		DeleteRule:         api.newSecurityDetectionsDeleteRule(),
 		GetIndex:           api.newSecurityDetectionsGetIndex(),
@@ -1018,6 +1483,8 @@ func New(t Transport) *API {
 		DeleteList:       api.newSecurityExceptionsDeleteList(),
 		DuplicateList:    api.newSecurityExceptionsDuplicateList(),
 		ExportList:       api.newSecurityExceptionsExportList(),
+		ExportListStream: api.newSecurityExceptionsExportListStream(),
+		ExportListTo:     api.newSecurityExceptionsExportListTo(),
 		GetList:          api.newSecurityExceptionsGetList(),
 		GetItem:          api.newSecurityExceptionsGetItem(),
 		GetSummary:       api.newSecurityExceptionsGetSummary(),
@@ -1035,15 +1502,30 @@ func New(t Transport) *API {
 		Resolve: api.newShortURLResolve(),
 	}
 
+	api.SLOs = SLOs{
+		Create:          api.newSLOsCreate(),
+		Delete:          api.newSLOsDelete(),
+		DeleteInstances: api.newSLOsDeleteInstances(),
+		Disable:         api.newSLOsDisable(),
+		Enable:          api.newSLOsEnable(),
+		Find:            api.newSLOsFind(),
+		FindDefinitions: api.newSLOsFindDefinitions(),
+		Get:             api.newSLOsGet(),
+		Reset:           api.newSLOsReset(),
+		Update:          api.newSLOsUpdate(),
+	}
+
 	api.Spaces = Spaces{
-		CopyObjects:            api.newSpacesCopyObjects(),
-		Create:                 api.newSpacesCreate(),
-		Delete:                 api.newSpacesDelete(),
-		Get:                    api.newSpacesGet(),
-		GetAll:                 api.newSpacesGetAll(),
-		GetShareableReferences: api.newSpacesShareableReferences(),
-		Update:                 api.newSpacesUpdate(),
-		UpdateObjects:          api.newSpacesUpdateObjects(),
+		CopyObjects:                   api.newSpacesCopyObjects(),
+		Create:                        api.newSpacesCreate(),
+		Delete:                        api.newSpacesDelete(),
+		Get:                           api.newSpacesGet(),
+		GetAll:                        api.newSpacesGetAll(),
+		GetShareableReferences:        api.newSpacesShareableReferences(),
+		ResolveCopyErrors:             api.newSpacesResolveCopyErrors(),
+		SpacesDisableLegacyURLAliases: api.newSpacesDisableLegacyURL(),
+		Update:                        api.newSpacesUpdate(),
+		UpdateObjects:                 api.newSpacesUpdateObjects(),
 	}
 
 	api.Status = Status{
@@ -1051,10 +1533,76 @@ func New(t Transport) *API {
 		GetRedacted: api.newStatusRedactedFunc(),
 	}
 
+	api.Streams = Streams{
+		BulkDashboards:  api.newStreamsBulkDashboards(),
+		BulkQueries:     api.newStreamsBulkQueries(),
+		Delete:          api.newStreamsDelete(),
+		DeleteQuery:     api.newStreamsDeleteQuery(),
+		Disable:         api.newStreamsDisable(),
+		Enable:          api.newStreamsEnable(),
+		Fork:            api.newStreamsFork(),
+		Get:             api.newStreamsGet(),
+		GetIngest:       api.newStreamsGetIngest(),
+		LinkDashboard:   api.newStreamsLinkDashboard(),
+		List:            api.newStreamsList(),
+		ListDashboards:  api.newStreamsListDashboards(),
+		ListQueries:     api.newStreamsListQueries(),
+		Resync:          api.newStreamsResync(),
+		UnlinkDashboard: api.newStreamsUnlinkDashboard(),
+		Upsert:          api.newStreamsUpsert(),
+		UpsertIngest:    api.newStreamsUpsertIngest(),
+		UpsertQuery:     api.newStreamsUpsertQuery(),
+	}
+
+	api.Synthetics = Synthetics{
+		Monitors: SyntheticsMonitors{
+			BulkDelete: api.newSyntheticsMonitorsBulkDelete(),
+			Create:     api.newSyntheticsMonitorsCreate(),
+			Delete:     api.newSyntheticsMonitorsDelete(),
+			Get:        api.newSyntheticsMonitorsGet(),
+			List:       api.newSyntheticsMonitorsList(),
+			Update:     api.newSyntheticsMonitorsUpdate(),
+		},
+		Params: SyntheticsParams{
+			BulkDelete: api.newSyntheticsParamsBulkDelete(),
+			Create:     api.newSyntheticsParamsCreate(),
+			Delete:     api.newSyntheticsParamsDelete(),
+			Get:        api.newSyntheticsParamsGet(),
+			List:       api.newSyntheticsParamsList(),
+			Update:     api.newSyntheticsParamsUpdate(),
+		},
+		PrivateLocations: SyntheticsPrivateLocations{
+			Create: api.newSyntheticsPrivateLocationsCreate(),
+			Delete: api.newSyntheticsPrivateLocationsDelete(),
+			Get:    api.newSyntheticsPrivateLocationsGet(),
+			List:   api.newSyntheticsPrivateLocationsList(),
+			Update: api.newSyntheticsPrivateLocationsUpdate(),
+		},
+	}
+
 	api.TaskManager = TaskManager{
 		Health: api.newTaskManagerHealth(),
 	}
 
+	api.Timeline = Timeline{
+		CleanDraft:         api.newTimelineCleanDraft(),
+		Copy:               api.newTimelineCopy(),
+		Create:             api.newTimelineCreate(),
+		Delete:             api.newTimelineDelete(),
+		DeleteNotes:        api.newTimelineDeleteNotes(),
+		Export:             api.newTimelineExport(),
+		Get:                api.newTimelineGet(),
+		GetDraft:           api.newTimelineGetDraft(),
+		GetNotes:           api.newTimelineGetNotes(),
+		Import:             api.newTimelineImport(),
+		InstallPrepackaged: api.newTimelineInstallPrepackaged(),
+		List:               api.newTimelineList(),
+		PersistNote:        api.newTimelinePersistNote(),
+		PersistPinnedEvent: api.newTimelinePersistPinnedEvent(),
+		Resolve:            api.newTimelineResolve(),
+		Update:             api.newTimelineUpdate(),
+	}
+
 	api.Uptime = Uptime{
 		GetSettings:    api.newUptimeGetSettings(),
 		UpdateSettings: api.newUptimeUpdateSettings(),
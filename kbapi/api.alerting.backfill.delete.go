@@ -0,0 +1,44 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// AlertingBackfillDeleteResponse wraps the response from a Alerting.Backfill.Delete call
+type AlertingBackfillDeleteResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type AlertingBackfillDeleteRequest struct {
+	ID string
+}
+
+// newAlertingBackfillDelete returns a function that performs DELETE /api/alerting/rules/backfill/{id} API requests
+func (api *API) newAlertingBackfillDelete() func(context.Context, *AlertingBackfillDeleteRequest, ...RequestOption) (*AlertingBackfillDeleteResponse, error) {
+	return func(ctx context.Context, req *AlertingBackfillDeleteRequest, opts ...RequestOption) (*AlertingBackfillDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:    "alerting.backfill.delete",
+			feature: FeatureAlertingBackfill,
+			method:  http.MethodDelete,
+			path:    fmt.Sprintf("/api/alerting/rules/backfill/%s", req.ID),
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &AlertingBackfillDeleteResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
@@ -0,0 +1,105 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// AlertingBackfillFindResponse wraps the response from a Alerting.Backfill.Find call
+type AlertingBackfillFindResponse Response[AlertingBackfillFindResponseBody]
+
+type AlertingBackfillFindResponseBody struct {
+	Data    []AlertingBackfill `json:"data"`
+	Page    int                `json:"page"`
+	PerPage int                `json:"per_page"`
+	Total   int                `json:"total"`
+}
+
+type AlertingBackfillFindRequestParams struct {
+	RuleIDs []string
+	// Start Only backfills starting at or after this date are returned.
+	Start *string
+	// End Only backfills ending at or before this date are returned.
+	End     *string
+	Page    *int
+	PerPage *int
+	// SortField The field to sort by, createdAt or start.
+	SortField *string
+	SortOrder *string
+}
+
+type AlertingBackfillFindRequest struct {
+	Params AlertingBackfillFindRequestParams
+}
+
+// newAlertingBackfillFind returns a function that performs POST /api/alerting/rules/backfill/_find API requests
+func (api *API) newAlertingBackfillFind() func(context.Context, *AlertingBackfillFindRequest, ...RequestOption) (*AlertingBackfillFindResponse, error) {
+	return func(ctx context.Context, req *AlertingBackfillFindRequest, opts ...RequestOption) (*AlertingBackfillFindResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if len(req.Params.RuleIDs) > 0 {
+			params.Set("rule_ids", strings.Join(req.Params.RuleIDs, ","))
+		}
+		if req.Params.Start != nil {
+			params.Set("start", *req.Params.Start)
+		}
+		if req.Params.End != nil {
+			params.Set("end", *req.Params.End)
+		}
+		if req.Params.Page != nil {
+			params.Set("page", strconv.Itoa(*req.Params.Page))
+		}
+		if req.Params.PerPage != nil {
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
+		}
+		if req.Params.SortField != nil {
+			params.Set("sort_field", *req.Params.SortField)
+		}
+		if req.Params.SortOrder != nil {
+			params.Set("sort_order", *req.Params.SortOrder)
+		}
+
+		res, err := do[noBody, AlertingBackfillFindResponseBody](ctx, api, operation{
+			name:    "alerting.backfill.find",
+			feature: FeatureAlertingBackfill,
+			method:  http.MethodPost,
+			path:    "/api/alerting/rules/backfill/_find",
+			query:   params,
+		}, nil, opts)
+		return (*AlertingBackfillFindResponse)(res), err
+	}
+}
+
+// All returns an iterator over all backfills matching req. Pages of
+// req.Params.PerPage items, or DefaultPerPage when unset, are fetched on demand.
+func (b AlertingBackfills) All(ctx context.Context, req *AlertingBackfillFindRequest, opts ...RequestOption) iter.Seq2[AlertingBackfill, error] {
+	return items(b.Pages(ctx, req, opts...))
+}
+
+// Pages returns an iterator over the pages of backfills matching req.
+func (b AlertingBackfills) Pages(ctx context.Context, req *AlertingBackfillFindRequest, opts ...RequestOption) iter.Seq2[*Page[AlertingBackfill], error] {
+	var params AlertingBackfillFindRequestParams
+	if req != nil {
+		params = req.Params
+	}
+
+	return pages(ctx, intValue(params.Page, 1), intValue(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[AlertingBackfill], error) {
+		params.Page = IntPtr(page)
+		params.PerPage = IntPtr(perPage)
+		resp, err := b.Find(ctx, &AlertingBackfillFindRequest{Params: params}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &Page[AlertingBackfill]{Items: resp.Body.Data, Page: resp.Body.Page, PerPage: resp.Body.PerPage, Total: resp.Body.Total}, nil
+	})
+}
@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// AlertingBackfillGetResponse wraps the response from a Alerting.Backfill.Get call
+type AlertingBackfillGetResponse Response[AlertingBackfill]
+
+type AlertingBackfillGetRequest struct {
+	ID string
+}
+
+// newAlertingBackfillGet returns a function that performs GET /api/alerting/rules/backfill/{id} API requests
+func (api *API) newAlertingBackfillGet() func(context.Context, *AlertingBackfillGetRequest, ...RequestOption) (*AlertingBackfillGetResponse, error) {
+	return func(ctx context.Context, req *AlertingBackfillGetRequest, opts ...RequestOption) (*AlertingBackfillGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, AlertingBackfill](ctx, api, operation{
+			name:    "alerting.backfill.get",
+			feature: FeatureAlertingBackfill,
+			method:  http.MethodGet,
+			path:    fmt.Sprintf("/api/alerting/rules/backfill/%s", req.ID),
+		}, nil, opts)
+		return (*AlertingBackfillGetResponse)(res), err
+	}
+}
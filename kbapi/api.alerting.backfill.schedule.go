@@ -0,0 +1,55 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// AlertingBackfillScheduleResponse wraps the response from a Alerting.Backfill.Schedule call
+type AlertingBackfillScheduleResponse Response[[]AlertingBackfillScheduleResult]
+
+// AlertingBackfillScheduleParams schedules runs of a rule over past time ranges.
+type AlertingBackfillScheduleParams struct {
+	RuleID string                  `json:"rule_id"`
+	Ranges []AlertingBackfillRange `json:"ranges"`
+	// RunActions Whether the actions of the rule run for the alerts found by the backfill.
+	RunActions *bool `json:"run_actions,omitempty"`
+}
+
+// AlertingBackfillScheduleResult is the outcome of scheduling a backfill, the
+// backfill when it was scheduled or Error when it was not.
+type AlertingBackfillScheduleResult struct {
+	AlertingBackfill
+	Error *AlertingBackfillError `json:"error,omitempty"`
+}
+
+type AlertingBackfillError struct {
+	Message string `json:"message"`
+	Status  *int   `json:"status,omitempty"`
+	Rule    struct {
+		ID   string  `json:"id"`
+		Name *string `json:"name,omitempty"`
+	} `json:"rule"`
+}
+
+type AlertingBackfillScheduleRequest struct {
+	Body []AlertingBackfillScheduleParams
+}
+
+// newAlertingBackfillSchedule returns a function that performs POST /api/alerting/rules/backfill/_schedule API requests
+func (api *API) newAlertingBackfillSchedule() func(context.Context, *AlertingBackfillScheduleRequest, ...RequestOption) (*AlertingBackfillScheduleResponse, error) {
+	return func(ctx context.Context, req *AlertingBackfillScheduleRequest, opts ...RequestOption) (*AlertingBackfillScheduleResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[[]AlertingBackfillScheduleParams, []AlertingBackfillScheduleResult](ctx, api, operation{
+			name:    "alerting.backfill.schedule",
+			feature: FeatureAlertingBackfill,
+			method:  http.MethodPost,
+			path:    "/api/alerting/rules/backfill/_schedule",
+		}, &req.Body, opts)
+		return (*AlertingBackfillScheduleResponse)(res), err
+	}
+}
@@ -1,22 +1,14 @@
 package kbapi
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 )
 
 // TODO: Update the call
 // AlertingCreateResponse wraps the response from a <todo> call
-type AlertingCreateResponse struct {
-	StatusCode int
-	Body       *AlertingCreateResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type AlertingCreateResponse Response[AlertingCreateResponseBody]
 
 type AlertingCreateResponseBody struct {
 	ID                  string           `json:"id"`
@@ -48,6 +40,7 @@ type AlertingCreateResponseBody struct {
 }
 
 type AlertingCreateRequest struct {
+	// ID The ID of the rule, generated by Kibana when empty.
 	ID   string
 	Body AlertingCreateRequestBody
 }
@@ -70,122 +63,16 @@ func (api *API) newAlertingCreate() func(context.Context, *AlertingCreateRequest
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "alerting.create")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := fmt.Sprintf("/api/alerting/rule/%s", req.ID)
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		jsonBody, err := json.Marshal(req.Body)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		httpReq.Body = io.NopCloser(bytes.NewReader(jsonBody))
-		httpReq.Header.Set("Content-Type", "application/json")
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "alerting.create")
-			if reader := instrument.RecordRequestBody(ctx, "alerting.create", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
+		path := "/api/alerting/rule"
+		if req.ID != "" {
+			path = fmt.Sprintf("/api/alerting/rule/%s", req.ID)
 		}
 
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &AlertingCreateResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result AlertingCreateResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[AlertingCreateRequestBody, AlertingCreateResponseBody](ctx, api, operation{
+			name:   "alerting.create",
+			method: http.MethodPost,
+			path:   path,
+		}, &req.Body, opts)
+		return (*AlertingCreateResponse)(res), err
 	}
 }
@@ -2,20 +2,13 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 )
 
 // TODO: Update the call
 // AlertingDeleteResponse wraps the response from a <todo> call
-type AlertingDeleteResponse struct {
-	StatusCode int
-	Body       *AlertingDeleteResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type AlertingDeleteResponse Response[AlertingDeleteResponseBody]
 
 type AlertingDeleteResponseBody struct{}
 
@@ -30,111 +23,11 @@ func (api *API) newAlertingDelete() func(context.Context, *AlertingDeleteRequest
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "alerting.delete")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := fmt.Sprintf("/api/alerting/rule/%s", req.ID)
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodDelete, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "alerting.delete")
-			if reader := instrument.RecordRequestBody(ctx, "alerting.delete", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &AlertingDeleteResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result AlertingDeleteResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, AlertingDeleteResponseBody](ctx, api, operation{
+			name:   "alerting.delete",
+			method: http.MethodDelete,
+			path:   fmt.Sprintf("/api/alerting/rule/%s", req.ID),
+		}, nil, opts)
+		return (*AlertingDeleteResponse)(res), err
 	}
 }
@@ -1,9 +1,7 @@
 package kbapi
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -13,7 +11,7 @@ import (
 // AlertingDisableResponse wraps the response from a <todo> call
 type AlertingDisableResponse struct {
 	StatusCode int
-	Error      interface{}
+	Error      *Error
 	RawBody    io.ReadCloser
 }
 
@@ -33,113 +31,19 @@ func (api *API) newAlertingDisable() func(context.Context, *AlertingDisableReque
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "alerting.disable")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := fmt.Sprintf("/api/alerting/rule/%s/_disable", req.ID)
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
+		res, err := do[AlertingDisableRequestBody, noBody](ctx, api, operation{
+			name:   "alerting.disable",
+			method: http.MethodPost,
+			path:   fmt.Sprintf("/api/alerting/rule/%s/_disable", req.ID),
+		}, &req.Body, opts)
+		if res == nil {
 			return nil, err
 		}
 
-		jsonBody, err := json.Marshal(req.Body)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		httpReq.Body = io.NopCloser(bytes.NewReader(jsonBody))
-		httpReq.Header.Set("Content-Type", "application/json")
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "alerting.disable")
-			if reader := instrument.RecordRequestBody(ctx, "alerting.disable", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &AlertingDisableResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		if httpResp.StatusCode < 299 {
-			httpResp.Body.Close()
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		return &AlertingDisableResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
 	}
 }
@@ -2,7 +2,6 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,7 +11,7 @@ import (
 // AlertingEnableResponse wraps the response from a <todo> call
 type AlertingEnableResponse struct {
 	StatusCode int
-	Error      interface{}
+	Error      *Error
 	RawBody    io.ReadCloser
 }
 
@@ -27,102 +26,19 @@ func (api *API) newAlertingEnable() func(context.Context, *AlertingEnableRequest
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "alerting.enable")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := fmt.Sprintf("/api/alerting/rule/%s/_enable", req.ID)
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:   "alerting.enable",
+			method: http.MethodPost,
+			path:   fmt.Sprintf("/api/alerting/rule/%s/_enable", req.ID),
+		}, nil, opts)
+		if res == nil {
 			return nil, err
 		}
 
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "alerting.enable")
-			if reader := instrument.RecordRequestBody(ctx, "alerting.enable", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &AlertingEnableResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		if httpResp.StatusCode < 299 {
-			httpResp.Body.Close()
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		return &AlertingEnableResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
 	}
 }
@@ -0,0 +1,67 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultBackfillPollInterval is the time WaitForCompletion waits between status checks by default.
+const DefaultBackfillPollInterval = 5 * time.Second
+
+type AlertingBackfillWaitRequest struct {
+	ID string
+	// PollInterval The time between status checks, DefaultBackfillPollInterval when zero.
+	PollInterval time.Duration
+}
+
+// WaitForCompletion polls the backfill until every scheduled run completed,
+// failed or timed out, and returns its last known state. Kibana deletes a
+// backfill once all of its runs are done, so a backfill that disappears after
+// it was seen is considered finished as well. A backfill that is not found on
+// the first check returns the not found error, as the ID may be wrong.
+// Cancel ctx to stop waiting.
+func (b AlertingBackfills) WaitForCompletion(ctx context.Context, req *AlertingBackfillWaitRequest, opts ...RequestOption) (*AlertingBackfill, error) {
+	if req == nil {
+		return nil, fmt.Errorf("Request cannot be nil")
+	}
+
+	interval := req.PollInterval
+	if interval <= 0 {
+		interval = DefaultBackfillPollInterval
+	}
+
+	var last *AlertingBackfill
+	for {
+		resp, err := b.Get(ctx, &AlertingBackfillGetRequest{ID: req.ID}, opts...)
+		if IsNotFound(err) && last != nil {
+			return last, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		last = resp.Body
+		if last == nil || isBackfillDone(last) {
+			return last, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// isBackfillDone reports whether no run of the backfill is pending or running.
+func isBackfillDone(backfill *AlertingBackfill) bool {
+	for _, run := range backfill.Schedule {
+		if run.Status == AlertingBackfillStatusPending || run.Status == AlertingBackfillStatusRunning {
+			return false
+		}
+	}
+	return true
+}
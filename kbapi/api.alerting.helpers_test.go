@@ -0,0 +1,79 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertingBackfills_WaitForCompletion(t *testing.T) {
+	backfill := func(statuses ...string) AlertingBackfill {
+		b := AlertingBackfill{ID: "backfill-1", Status: AlertingBackfillStatusRunning}
+		for _, status := range statuses {
+			b.Schedule = append(b.Schedule, AlertingBackfillScheduledRun{RunAt: "2026-10-01T00:00:00.000Z", Interval: "1h", Status: status})
+		}
+		return b
+	}
+
+	t.Run("all runs done", func(t *testing.T) {
+		var calls int
+		api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, "/api/alerting/rules/backfill/backfill-1", req.URL.Path)
+			calls++
+			switch calls {
+			case 1:
+				return jsonResponse(t, 200, backfill(AlertingBackfillStatusRunning, AlertingBackfillStatusPending)), nil
+			case 2:
+				return jsonResponse(t, 200, backfill(AlertingBackfillStatusComplete, AlertingBackfillStatusRunning)), nil
+			}
+			return jsonResponse(t, 200, backfill(AlertingBackfillStatusComplete, AlertingBackfillStatusError)), nil
+		}))
+
+		result, err := api.Alerting.Backfill.WaitForCompletion(context.Background(), &AlertingBackfillWaitRequest{ID: "backfill-1", PollInterval: time.Millisecond})
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls)
+		require.NotNil(t, result)
+		assert.Equal(t, AlertingBackfillStatusError, result.Schedule[1].Status)
+	})
+
+	t.Run("deleted once finished", func(t *testing.T) {
+		var calls int
+		api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 1 {
+				return jsonResponse(t, 200, backfill(AlertingBackfillStatusRunning)), nil
+			}
+			return jsonResponse(t, 404, map[string]interface{}{"statusCode": 404, "error": "Not Found"}), nil
+		}))
+
+		result, err := api.Alerting.Backfill.WaitForCompletion(context.Background(), &AlertingBackfillWaitRequest{ID: "backfill-1", PollInterval: time.Millisecond})
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, "backfill-1", result.ID)
+	})
+
+	t.Run("not found on the first check", func(t *testing.T) {
+		api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+			return jsonResponse(t, 404, map[string]interface{}{"statusCode": 404, "error": "Not Found"}), nil
+		}))
+
+		result, err := api.Alerting.Backfill.WaitForCompletion(context.Background(), &AlertingBackfillWaitRequest{ID: "typo", PollInterval: time.Millisecond})
+		assert.True(t, IsNotFound(err))
+		assert.Nil(t, result)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+			cancel()
+			return jsonResponse(t, 200, backfill(AlertingBackfillStatusPending)), nil
+		}))
+
+		_, err := api.Alerting.Backfill.WaitForCompletion(ctx, &AlertingBackfillWaitRequest{ID: "backfill-1", PollInterval: time.Hour})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -4,20 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 )
 
 // TODO: Update the call
 // AlertingListResponse wraps the response from a <todo> call
-type AlertingListResponse struct {
-	StatusCode int
-	Body       *AlertingListResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type AlertingListResponse Response[AlertingListResponseBody]
 
 type AlertingListResponseBody struct {
 	Data    []AlertingResponseBase `json:"data"`
@@ -63,160 +59,76 @@ func (api *API) newAlertingList() func(context.Context, *AlertingListRequest, ..
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "alerting.list")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/alerting/rules/_find"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Filter != nil {
-			params["filter"] = *req.Params.Filter
+			params.Set("filter", *req.Params.Filter)
 		}
 		if req.Params.Page != nil {
-			params["page"] = strconv.Itoa(*req.Params.Page)
+			params.Set("page", strconv.Itoa(*req.Params.Page))
 		}
 		if req.Params.PerPage != nil {
-			params["per_page"] = strconv.Itoa(*req.Params.PerPage)
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
 		}
 		if req.Params.SortField != nil {
-			params["sort_field"] = *req.Params.SortField
+			params.Set("sort_field", *req.Params.SortField)
 		}
 		if req.Params.SortOrder != nil {
-			params["sort_order"] = *req.Params.SortOrder
+			params.Set("sort_order", *req.Params.SortOrder)
 		}
 		if req.Params.Search != nil {
-			params["search"] = *req.Params.Search
+			params.Set("search", *req.Params.Search)
 		}
 		if req.Params.DefaultSearchOperator != nil {
-			params["default_search_operator"] = *req.Params.DefaultSearchOperator
+			params.Set("default_search_operator", *req.Params.DefaultSearchOperator)
 		}
 		if req.Params.SearchFields != nil && len(*req.Params.SearchFields) > 0 {
 			searchFields := strings.Join(*req.Params.SearchFields, ",")
-			params["search_fields"] = searchFields
+			params.Set("search_fields", searchFields)
 		}
 		if req.Params.FilterConsumers != nil && len(*req.Params.FilterConsumers) > 0 {
 			filterConsumers := strings.Join(*req.Params.FilterConsumers, ",")
-			params["filter_consumers"] = filterConsumers
+			params.Set("filter_consumers", filterConsumers)
 		}
 		if req.Params.HasReference != nil {
 			hasRefJSON, err := json.Marshal(req.Params.HasReference)
 			if err != nil {
 				return nil, err
 			}
-			params["has_reference"] = string(hasRefJSON)
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
+			params.Set("has_reference", string(hasRefJSON))
 		}
 
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "alerting.list")
-			if reader := instrument.RecordRequestBody(ctx, "alerting.list", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
+		res, err := do[noBody, AlertingListResponseBody](ctx, api, operation{
+			name:   "alerting.list",
+			method: http.MethodGet,
+			path:   "/api/alerting/rules/_find",
+			query:  params,
+		}, nil, opts)
+		return (*AlertingListResponse)(res), err
+	}
+}
 
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
+// All returns an iterator over all rules matching req. Pages of
+// req.Params.PerPage items, or DefaultPerPage when unset, are fetched on demand.
+func (a Alerting) All(ctx context.Context, req *AlertingListRequest, opts ...RequestOption) iter.Seq2[AlertingResponseBase, error] {
+	return items(a.Pages(ctx, req, opts...))
+}
 
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
+// Pages returns an iterator over the pages of rules matching req.
+func (a Alerting) Pages(ctx context.Context, req *AlertingListRequest, opts ...RequestOption) iter.Seq2[*Page[AlertingResponseBase], error] {
+	var params AlertingListRequestParams
+	if req != nil {
+		params = req.Params
+	}
 
+	return pages(ctx, intValue(params.Page, 1), intValue(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[AlertingResponseBase], error) {
+		params.Page = &page
+		params.PerPage = &perPage
+		resp, err := a.List(ctx, &AlertingListRequest{Params: params}, opts...)
 		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
 			return nil, err
 		}
-
-		// Prepare response
-		resp := &AlertingListResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result AlertingListResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
-	}
+		return &Page[AlertingResponseBase]{Items: resp.Body.Data, Page: resp.Body.Page, PerPage: resp.Body.PerPage, Total: resp.Body.Total}, nil
+	})
 }
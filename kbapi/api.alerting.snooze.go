@@ -0,0 +1,40 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// AlertingSnoozeResponse wraps the response from a Alerting.Snooze call
+type AlertingSnoozeResponse Response[AlertingSnoozeResponseBody]
+
+type AlertingSnoozeResponseBody struct {
+	Schedule AlertingSnoozeSchedule `json:"schedule"`
+}
+
+type AlertingSnoozeRequestBody struct {
+	Schedule AlertingSnoozeSchedule `json:"schedule"`
+}
+
+type AlertingSnoozeRequest struct {
+	ID   string
+	Body AlertingSnoozeRequestBody
+}
+
+// newAlertingSnooze returns a function that performs POST /api/alerting/rule/{id}/snooze_schedule API requests
+func (api *API) newAlertingSnooze() func(context.Context, *AlertingSnoozeRequest, ...RequestOption) (*AlertingSnoozeResponse, error) {
+	return func(ctx context.Context, req *AlertingSnoozeRequest, opts ...RequestOption) (*AlertingSnoozeResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[AlertingSnoozeRequestBody, AlertingSnoozeResponseBody](ctx, api, operation{
+			name:    "alerting.snooze",
+			feature: FeatureAlertingSnoozeSchedule,
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/api/alerting/rule/%s/snooze_schedule", req.ID),
+		}, &req.Body, opts)
+		return (*AlertingSnoozeResponse)(res), err
+	}
+}
@@ -132,15 +132,15 @@ type ActionResponse struct {
 
 // ExecutionStatus represents the current execution state of a rule
 type ExecutionStatus struct {
-	Error             *Error   `json:"error,omitempty"`
-	LastDuration      *float32 `json:"last_duration,omitempty"`
-	LastExecutionDate string   `json:"last_execution_date"`
-	Status            string   `json:"status"`
-	Warning           *Warning `json:"warning,omitempty"`
+	Error             *ExecutionStatusError `json:"error,omitempty"`
+	LastDuration      *float32              `json:"last_duration,omitempty"`
+	LastExecutionDate string                `json:"last_execution_date"`
+	Status            string                `json:"status"`
+	Warning           *Warning              `json:"warning,omitempty"`
 }
 
-// Error provides details about an error
-type Error struct {
+// ExecutionStatusError provides details about a rule execution error
+type ExecutionStatusError struct {
 	Message string `json:"message"`
 	Reason  string `json:"reason"`
 }
@@ -370,3 +370,97 @@ type ActionCreate struct {
 	Params    map[string]any `json:"params"`
 	Frequency Frequency      `json:"frequency"`
 }
+
+// AlertingSnoozeSchedule is a snooze schedule of a rule, created with Alerting.Snooze.
+type AlertingSnoozeSchedule struct {
+	// ID The ID of the schedule, only set in responses.
+	ID     *string                      `json:"id,omitempty"`
+	Custom AlertingSnoozeCustomSchedule `json:"custom"`
+}
+
+// AlertingSnoozeCustomSchedule snoozes a rule for Duration from Start, once or on a recurring basis.
+type AlertingSnoozeCustomSchedule struct {
+	// Start The start of the schedule in ISO 8601 format, e.g. 2025-03-12T12:00:00.000Z.
+	Start string `json:"start"`
+	// Duration The duration of each snooze in <integer><unit> format, where unit is one of d, h, m or s, e.g. 5h.
+	Duration string `json:"duration"`
+	// Timezone The IANA time zone of the schedule, UTC when unset.
+	Timezone  *string                  `json:"timezone,omitempty"`
+	Recurring *AlertingSnoozeRecurring `json:"recurring,omitempty"`
+}
+
+// AlertingSnoozeRecurring repeats a snooze schedule.
+type AlertingSnoozeRecurring struct {
+	// Every The interval between snoozes in <integer><unit> format, where unit is one of d, w, M or y, e.g. 2w.
+	Every *string `json:"every,omitempty"`
+	// End The end of the recurrence in ISO 8601 format. Mutually exclusive with Occurrences.
+	End *string `json:"end,omitempty"`
+	// Occurrences The number of snoozes. Mutually exclusive with End.
+	Occurrences *int `json:"occurrences,omitempty"`
+	// OnWeekDay The days of the week to snooze on, e.g. MO or +2TU for the second Tuesday of the month.
+	OnWeekDay  []string `json:"onWeekDay,omitempty"`
+	OnMonthDay []int    `json:"onMonthDay,omitempty"`
+	OnMonth    []int    `json:"onMonth,omitempty"`
+}
+
+// Statuses of a backfill and of its scheduled runs.
+const (
+	AlertingBackfillStatusPending  = "pending"
+	AlertingBackfillStatusRunning  = "running"
+	AlertingBackfillStatusComplete = "complete"
+	AlertingBackfillStatusError    = "error"
+	AlertingBackfillStatusTimeout  = "timeout"
+)
+
+// AlertingBackfillRange is a time range a rule is run over by a backfill.
+type AlertingBackfillRange struct {
+	// Start The start of the range in ISO 8601 format.
+	Start string `json:"start"`
+	// End The end of the range in ISO 8601 format.
+	End string `json:"end"`
+}
+
+// AlertingBackfill is an ad-hoc run of a rule over a past time range.
+type AlertingBackfill struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"created_at"`
+	// Duration The time range covered by each run, the interval of the rule.
+	Duration string               `json:"duration"`
+	Enabled  bool                 `json:"enabled"`
+	Rule     AlertingBackfillRule `json:"rule"`
+	SpaceID  string               `json:"space_id"`
+	Start    string               `json:"start"`
+	End      *string              `json:"end,omitempty"`
+	// Status One of the AlertingBackfillStatus* values.
+	Status   string                         `json:"status"`
+	Schedule []AlertingBackfillScheduledRun `json:"schedule"`
+	Warnings []string                       `json:"warnings,omitempty"`
+}
+
+// AlertingBackfillRule is the rule run by a backfill, as it was when the backfill was scheduled.
+type AlertingBackfillRule struct {
+	ID                  string         `json:"id"`
+	Name                string         `json:"name"`
+	RuleTypeID          string         `json:"rule_type_id"`
+	Consumer            string         `json:"consumer"`
+	Enabled             bool           `json:"enabled"`
+	Tags                []string       `json:"tags"`
+	Params              map[string]any `json:"params"`
+	Schedule            Schedule       `json:"schedule"`
+	Revision            int            `json:"revision"`
+	APIKeyOwner         *string        `json:"api_key_owner"`
+	APIKeyCreatedByUser *bool          `json:"api_key_created_by_user,omitempty"`
+	CreatedBy           *string        `json:"created_by"`
+	UpdatedBy           *string        `json:"updated_by"`
+	CreatedAt           string         `json:"created_at"`
+	UpdatedAt           string         `json:"updated_at"`
+}
+
+// AlertingBackfillScheduledRun is a single run of a backfill.
+type AlertingBackfillScheduledRun struct {
+	// RunAt The end of the time range covered by the run.
+	RunAt    string `json:"run_at"`
+	Interval string `json:"interval"`
+	// Status One of the AlertingBackfillStatus* values.
+	Status string `json:"status"`
+}
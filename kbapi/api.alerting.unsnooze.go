@@ -0,0 +1,45 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// AlertingUnsnoozeResponse wraps the response from a Alerting.Unsnooze call
+type AlertingUnsnoozeResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type AlertingUnsnoozeRequest struct {
+	RuleID     string
+	ScheduleID string
+}
+
+// newAlertingUnsnooze returns a function that performs DELETE /api/alerting/rule/{ruleId}/snooze_schedule/{scheduleId} API requests
+func (api *API) newAlertingUnsnooze() func(context.Context, *AlertingUnsnoozeRequest, ...RequestOption) (*AlertingUnsnoozeResponse, error) {
+	return func(ctx context.Context, req *AlertingUnsnoozeRequest, opts ...RequestOption) (*AlertingUnsnoozeResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:    "alerting.unsnooze",
+			feature: FeatureAlertingSnoozeSchedule,
+			method:  http.MethodDelete,
+			path:    fmt.Sprintf("/api/alerting/rule/%s/snooze_schedule/%s", req.RuleID, req.ScheduleID),
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &AlertingUnsnoozeResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
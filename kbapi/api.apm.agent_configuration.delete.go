@@ -1,22 +1,14 @@
 package kbapi
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 )
 
 // TODO: Update the call
 // APMAgentConfigurationDeleteResponse wraps the response from a <todo> call
-type APMAgentConfigurationDeleteResponse struct {
-	StatusCode int
-	Body       *APMAgentConfigurationDeleteResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type APMAgentConfigurationDeleteResponse Response[APMAgentConfigurationDeleteResponseBody]
 
 type APMAgentConfigurationDeleteResponseBody struct {
 	Result string `json:"result"`
@@ -37,122 +29,11 @@ func (api *API) newAPMAgentConfigurationDelete() func(context.Context, *APMAgent
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "apm.agent_configuration.delete")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/apm/settings/agent-configuration"
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodDelete, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		jsonBody, err := json.Marshal(req.Body)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		httpReq.Body = io.NopCloser(bytes.NewReader(jsonBody))
-		httpReq.Header.Set("Content-Type", "application/json")
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "apm.agent_configuration.delete")
-			if reader := instrument.RecordRequestBody(ctx, "apm.agent_configuration.delete", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &APMAgentConfigurationDeleteResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result APMAgentConfigurationDeleteResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[APMAgentConfigurationDeleteRequestBody, APMAgentConfigurationDeleteResponseBody](ctx, api, operation{
+			name:   "apm.agent_configuration.delete",
+			method: http.MethodDelete,
+			path:   "/api/apm/settings/agent-configuration",
+		}, &req.Body, opts)
+		return (*APMAgentConfigurationDeleteResponse)(res), err
 	}
 }
@@ -2,20 +2,12 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
 )
 
 // TODO: Update the call
 // APMAgentConfigurationListResponse wraps the response from a <todo> call
-type APMAgentConfigurationListResponse struct {
-	StatusCode int
-	Body       *APMAgentConfigurationListResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type APMAgentConfigurationListResponse Response[APMAgentConfigurationListResponseBody]
 
 type APMAgentConfigurationListResponseBody struct {
 	Configurations []APMUIAgentConfigurationObject `json:"configurations"`
@@ -24,112 +16,11 @@ type APMAgentConfigurationListResponseBody struct {
 // newAPMAgentConfigurationList returns a function that performs GET /api/apm/settings/agent-configuration API requests
 func (api *API) newAPMAgentConfigurationList() func(context.Context, ...RequestOption) (*APMAgentConfigurationListResponse, error) {
 	return func(ctx context.Context, opts ...RequestOption) (*APMAgentConfigurationListResponse, error) {
-
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "apm.agent_configuration.list")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/apm/settings/agent-configuration"
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "apm.agent_configuration.list")
-			if reader := instrument.RecordRequestBody(ctx, "apm.agent_configuration.list", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &APMAgentConfigurationListResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result APMAgentConfigurationListResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, APMAgentConfigurationListResponseBody](ctx, api, operation{
+			name:   "apm.agent_configuration.list",
+			method: http.MethodGet,
+			path:   "/api/apm/settings/agent-configuration",
+		}, nil, opts)
+		return (*APMAgentConfigurationListResponse)(res), err
 	}
 }
@@ -2,20 +2,14 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 )
 
 // TODO: Update the call
 // APMAnnotationSearchResponse wraps the response from a <todo> call
-type APMAnnotationSearchResponse struct {
-	StatusCode int
-	Body       *APMAnnotationSearchResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type APMAnnotationSearchResponse Response[APMAnnotationSearchResponseBody]
 
 type APMAnnotationSearchResponseBody struct {
 	// Annotations Annotations
@@ -48,133 +42,25 @@ func (api *API) newAPMAnnotationSearch() func(context.Context, *APMAnnotationSea
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "apm.annotation.search")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := fmt.Sprintf("/api/apm/services/%s/annotation/search", req.ServiceName)
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Environment != nil {
-			params["environment"] = *req.Params.Environment
+			params.Set("environment", *req.Params.Environment)
 		}
 		if req.Params.Start != nil {
-			params["start"] = *req.Params.Start
+			params.Set("start", *req.Params.Start)
 		}
 		if req.Params.End != nil {
-			params["end"] = *req.Params.End
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "apm.annotation.search")
-			if reader := instrument.RecordRequestBody(ctx, "apm.annotation.search", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
+			params.Set("end", *req.Params.End)
 		}
 
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &APMAnnotationSearchResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result APMAnnotationSearchResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, APMAnnotationSearchResponseBody](ctx, api, operation{
+			name:   "apm.annotation.search",
+			method: http.MethodGet,
+			path:   fmt.Sprintf("/api/apm/services/%s/annotation/search", req.ServiceName),
+			query:  params,
+		}, nil, opts)
+		return (*APMAnnotationSearchResponse)(res), err
 	}
 }
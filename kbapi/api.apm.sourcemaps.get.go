@@ -2,21 +2,15 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // TODO: Update the call
 // APMSourcemapsGetResponse wraps the response from a <todo> call
-type APMSourcemapsGetResponse struct {
-	StatusCode int
-	Body       *APMSourcemapsGetResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type APMSourcemapsGetResponse Response[APMSourcemapsGetResponseBody]
 
 type APMSourcemapsGetResponseBody struct {
 	Artifacts *[]struct {
@@ -64,129 +58,21 @@ func (api *API) newAPMSourcemapsGet() func(context.Context, *APMSourcemapsGetReq
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "apm.sourcemaps.get")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/apm/sourcemaps"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 		if req.Params.Page != nil {
-			params["page"] = strconv.Itoa(*req.Params.Page)
+			params.Set("page", strconv.Itoa(*req.Params.Page))
 		}
 		if req.Params.PerPage != nil {
-			params["per_page"] = strconv.Itoa(*req.Params.PerPage)
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "apm.sourcemaps.get")
-			if reader := instrument.RecordRequestBody(ctx, "apm.sourcemaps.get", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
 		}
 
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &APMSourcemapsGetResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result APMSourcemapsGetResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, APMSourcemapsGetResponseBody](ctx, api, operation{
+			name:   "apm.sourcemaps.get",
+			method: http.MethodGet,
+			path:   "/api/apm/sourcemaps",
+			query:  params,
+		}, nil, opts)
+		return (*APMSourcemapsGetResponse)(res), err
 	}
 }
@@ -3,21 +3,14 @@ package kbapi
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"mime/multipart"
 	"net/http"
 )
 
 // TODO: Update the call
 // APMSourcemapsUploadResponse wraps the response from a <todo> call
-type APMSourcemapsUploadResponse struct {
-	StatusCode int
-	Body       *APMSourcemapsUploadResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type APMSourcemapsUploadResponse Response[APMSourcemapsUploadResponseBody]
 
 type APMSourcemapsUploadResponseBody struct {
 	Body                 *string  `json:"body,omitempty"`
@@ -58,24 +51,21 @@ func (api *API) newAPMSourcemapsUpload() func(context.Context, *APMSourcemapsUpl
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "apm.sourcemaps.upload")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
 		// Set up multipart form data
 		body := &bytes.Buffer{}
 		writer := multipart.NewWriter(body)
 
+		fields := map[string]string{
+			"service_name":    req.Body.ServiceName,
+			"service_version": req.Body.ServiceVersion,
+			"bundle_filepath": req.Body.BundleFilepath,
+		}
+		for name, value := range fields {
+			if err := writer.WriteField(name, value); err != nil {
+				return nil, fmt.Errorf("failed to add %s field: %w", name, err)
+			}
+		}
+
 		part, err := writer.CreateFormFile("sourcemap", "sourcemap.json")
 		if err != nil {
 			return nil, fmt.Errorf("failed to create form file: %w", err)
@@ -90,111 +80,13 @@ func (api *API) newAPMSourcemapsUpload() func(context.Context, *APMSourcemapsUpl
 			return nil, fmt.Errorf("failed to close writer: %w", err)
 		}
 
-		path := "/api/apm/sourcemaps"
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, body)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Set the content type for multipart form data
-		httpReq.Header.Set("Content-Type", writer.FormDataContentType())
-
-		jsonBody, err := json.Marshal(req.Body)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		httpReq.Body = io.NopCloser(bytes.NewReader(jsonBody))
-		httpReq.Header.Set("Content-Type", "application/json")
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "apm.sourcemaps.upload")
-			if reader := instrument.RecordRequestBody(ctx, "apm.sourcemaps.upload", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &APMSourcemapsUploadResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result APMSourcemapsUploadResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, APMSourcemapsUploadResponseBody](ctx, api, operation{
+			name:        "apm.sourcemaps.upload",
+			method:      http.MethodPost,
+			path:        "/api/apm/sourcemaps",
+			body:        body,
+			contentType: writer.FormDataContentType(),
+		}, nil, opts)
+		return (*APMSourcemapsUploadResponse)(res), err
 	}
 }
@@ -4,20 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 )
 
 // TODO: Update the call
 // CasesListActivityResponse wraps the response from a <todo> call
-type CasesListActivityResponse struct {
-	StatusCode int
-	Body       *CasesListActivityResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type CasesListActivityResponse Response[CasesListActivityResponseBody]
 
 type CasesListActivityResponseBody struct {
 	Page        int                     `json:"page"`
@@ -199,136 +194,28 @@ func (api *API) newCasesListActivity() func(context.Context, *CasesListActivityR
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "cases.list_activity")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := fmt.Sprintf("/api/cases/%s/user_actions/_find", req.ID)
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Page != nil {
-			params["page"] = strconv.Itoa(*req.Params.Page)
+			params.Set("page", strconv.Itoa(*req.Params.Page))
 		}
 		if req.Params.PerPage != nil {
-			params["per_page"] = strconv.Itoa(*req.Params.PerPage)
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
 		}
 		if req.Params.SortOrder != nil {
-			params["sort_order"] = *req.Params.SortOrder
+			params.Set("sort_order", *req.Params.SortOrder)
 		}
 		if req.Params.Types != nil {
-			params["types"] = strings.Join(*req.Params.Types, ",")
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
+			params.Set("types", strings.Join(*req.Params.Types, ","))
 		}
 
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "cases.list_activity")
-			if reader := instrument.RecordRequestBody(ctx, "cases.list_activity", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &CasesListActivityResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result CasesListActivityResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, CasesListActivityResponseBody](ctx, api, operation{
+			name:   "cases.list_activity",
+			method: http.MethodGet,
+			path:   fmt.Sprintf("/api/cases/%s/user_actions/_find", req.ID),
+			query:  params,
+		}, nil, opts)
+		return (*CasesListActivityResponse)(res), err
 	}
 }
@@ -4,19 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // TODO: Update the call
 // CasesListCommentsAlertsResponse wraps the response from a <todo> call
-type CasesListCommentsAlertsResponse struct {
-	StatusCode int
-	Body       *CasesListCommentsAlertsResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type CasesListCommentsAlertsResponse Response[CasesListCommentsAlertsResponseBody]
 
 type CasesListCommentsAlertsResponseBody struct {
 	Comments []json.RawMessage `json:"comments"`
@@ -94,133 +89,25 @@ func (api *API) newCasesListCommentsAlerts() func(context.Context, *CasesListCom
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "cases.list_alert_comment")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := fmt.Sprintf("/api/cases/%s/comments/_find", req.ID)
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Page != nil {
-			params["page"] = strconv.Itoa(*req.Params.Page)
+			params.Set("page", strconv.Itoa(*req.Params.Page))
 		}
 		if req.Params.PerPage != nil {
-			params["per_page"] = strconv.Itoa(*req.Params.PerPage)
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
 		}
 		if req.Params.SortOrder != nil {
-			params["sort_order"] = *req.Params.SortOrder
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "cases.list_alert_comment")
-			if reader := instrument.RecordRequestBody(ctx, "cases.list_alert_comment", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
+			params.Set("sort_order", *req.Params.SortOrder)
 		}
 
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &CasesListCommentsAlertsResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result CasesListCommentsAlertsResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, CasesListCommentsAlertsResponseBody](ctx, api, operation{
+			name:   "cases.list_alert_comment",
+			method: http.MethodGet,
+			path:   fmt.Sprintf("/api/cases/%s/comments/_find", req.ID),
+			query:  params,
+		}, nil, opts)
+		return (*CasesListCommentsAlertsResponse)(res), err
 	}
 }
@@ -2,22 +2,17 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 )
 
 // TODO: Update the call
 // CasesSearchResponse wraps the response from a <todo> call
-type CasesSearchResponse struct {
-	StatusCode int
-	Body       *CasesSearchResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type CasesSearchResponse Response[CasesSearchResponseBody]
 
 type CasesSearchResponseBody struct {
 	Cases                *[]CasesObjectResponse `json:"cases,omitempty"`
@@ -92,172 +87,93 @@ func (api *API) newCasesSearch() func(context.Context, *CasesSearchRequest, ...R
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "cases.search")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/cases/_find"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Assignees != nil {
-			params["assignees"] = strings.Join(*req.Params.Assignees, ",")
+			params.Set("assignees", strings.Join(*req.Params.Assignees, ","))
 		}
 		if req.Params.Category != nil {
-			params["category"] = strings.Join(*req.Params.Category, ",")
+			params.Set("category", strings.Join(*req.Params.Category, ","))
 		}
 		if req.Params.DefaultSearchOperator != nil {
-			params["defaultSearchOperator"] = *req.Params.DefaultSearchOperator
+			params.Set("defaultSearchOperator", *req.Params.DefaultSearchOperator)
 		}
 		if req.Params.From != nil {
-			params["from"] = *req.Params.From
+			params.Set("from", *req.Params.From)
 		}
 		if req.Params.Owner != nil {
-			params["owner"] = strings.Join(*req.Params.Owner, ",")
+			params.Set("owner", strings.Join(*req.Params.Owner, ","))
 		}
 		if req.Params.Page != nil {
-			params["page"] = strconv.Itoa(*req.Params.Page)
+			params.Set("page", strconv.Itoa(*req.Params.Page))
 		}
 		if req.Params.PerPage != nil {
-			params["perPage"] = strconv.Itoa(*req.Params.PerPage)
+			params.Set("perPage", strconv.Itoa(*req.Params.PerPage))
 		}
 		if req.Params.Reporters != nil {
-			params["reporters"] = strings.Join(*req.Params.Reporters, ",")
+			params.Set("reporters", strings.Join(*req.Params.Reporters, ","))
 		}
 		if req.Params.Search != nil {
-			params["search"] = *req.Params.Search
+			params.Set("search", *req.Params.Search)
 		}
 		if req.Params.SearchFields != nil {
-			params["searchFields"] = strings.Join(*req.Params.SearchFields, ",")
+			params.Set("searchFields", strings.Join(*req.Params.SearchFields, ","))
 		}
 		if req.Params.Severity != nil {
-			params["severity"] = *req.Params.Severity
+			params.Set("severity", *req.Params.Severity)
 		}
 		if req.Params.SortField != nil {
-			params["sortField"] = *req.Params.SortField
+			params.Set("sortField", *req.Params.SortField)
 		}
 		if req.Params.SortOrder != nil {
-			params["sortOrder"] = *req.Params.SortOrder
+			params.Set("sortOrder", *req.Params.SortOrder)
 		}
 		if req.Params.Status != nil {
-			params["status"] = *req.Params.Status
+			params.Set("status", *req.Params.Status)
 		}
 		if req.Params.Tags != nil {
-			params["tags"] = strings.Join(*req.Params.Tags, ",")
+			params.Set("tags", strings.Join(*req.Params.Tags, ","))
 		}
 		if req.Params.To != nil {
-			params["to"] = *req.Params.To
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
+			params.Set("to", *req.Params.To)
 		}
 
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "cases.search")
-			if reader := instrument.RecordRequestBody(ctx, "cases.search", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
+		res, err := do[noBody, CasesSearchResponseBody](ctx, api, operation{
+			name:   "cases.search",
+			method: http.MethodGet,
+			path:   "/api/cases/_find",
+			query:  params,
+		}, nil, opts)
+		return (*CasesSearchResponse)(res), err
+	}
+}
 
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
+// All returns an iterator over all cases matching req. Pages of
+// req.Params.PerPage cases, or DefaultPerPage when unset, are fetched on demand.
+func (c Cases) All(ctx context.Context, req *CasesSearchRequest, opts ...RequestOption) iter.Seq2[CasesObjectResponse, error] {
+	return items(c.Pages(ctx, req, opts...))
+}
 
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
+// Pages returns an iterator over the pages of cases matching req.
+func (c Cases) Pages(ctx context.Context, req *CasesSearchRequest, opts ...RequestOption) iter.Seq2[*Page[CasesObjectResponse], error] {
+	var params CasesSearchRequestParams
+	if req != nil {
+		params = req.Params
+	}
 
+	return pages(ctx, intValue(params.Page, 1), intValue(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[CasesObjectResponse], error) {
+		params.Page = &page
+		params.PerPage = &perPage
+		resp, err := c.Search(ctx, &CasesSearchRequest{Params: params}, opts...)
 		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
 			return nil, err
 		}
 
-		// Prepare response
-		resp := &CasesSearchResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
+		result := &Page[CasesObjectResponse]{Page: page, PerPage: perPage, Total: intValue(resp.Body.Total, 0)}
+		if resp.Body.Cases != nil {
+			result.Items = *resp.Body.Cases
 		}
-
-		var result CasesSearchResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
-	}
+		return result, nil
+	})
 }
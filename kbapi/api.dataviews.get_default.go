@@ -2,20 +2,12 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
 )
 
 // TODO: Update the call
 // DataViewsGetDefaultResponse wraps the response from a <todo> call
-type DataViewsGetDefaultResponse struct {
-	StatusCode int
-	Body       *DataViewsGetDefaultResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type DataViewsGetDefaultResponse Response[DataViewsGetDefaultResponseBody]
 
 type DataViewsGetDefaultResponseBody struct {
 	DataViewID string `json:"data_view_id"`
@@ -24,112 +16,11 @@ type DataViewsGetDefaultResponseBody struct {
 // newDataViewsGetDefault returns a function that performs GET /api/data_views/default API requests
 func (api *API) newDataViewsGetDefault() func(context.Context, ...RequestOption) (*DataViewsGetDefaultResponse, error) {
 	return func(ctx context.Context, opts ...RequestOption) (*DataViewsGetDefaultResponse, error) {
-
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "dataviews.get_default")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/data_views/default"
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "dataviews.get_default")
-			if reader := instrument.RecordRequestBody(ctx, "dataviews.get_default", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &DataViewsGetDefaultResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result DataViewsGetDefaultResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, DataViewsGetDefaultResponseBody](ctx, api, operation{
+			name:   "dataviews.get_default",
+			method: http.MethodGet,
+			path:   "/api/data_views/default",
+		}, nil, opts)
+		return (*DataViewsGetDefaultResponse)(res), err
 	}
 }
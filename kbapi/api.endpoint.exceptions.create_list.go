@@ -2,20 +2,12 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
 )
 
 // TODO: Update the call
 // EndpointExceptionsCreateListResponse wraps the response from a <todo> call
-type EndpointExceptionsCreateListResponse struct {
-	StatusCode int
-	Body       *EndpointExceptionsCreateListResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type EndpointExceptionsCreateListResponse Response[EndpointExceptionsCreateListResponseBody]
 
 type EndpointExceptionsCreateListResponseBody struct {
 	// CreatedAt Autogenerated date of object creation.
@@ -62,112 +54,11 @@ type EndpointExceptionsCreateListResponseBody struct {
 // newEndpointExceptionsCreateList returns a function that performs POST /api/endpoint_list API requests
 func (api *API) newEndpointExceptionsCreateList() func(context.Context, ...RequestOption) (*EndpointExceptionsCreateListResponse, error) {
 	return func(ctx context.Context, opts ...RequestOption) (*EndpointExceptionsCreateListResponse, error) {
-
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "endpoint.exceptions.create_list")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/endpoint_list"
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "endpoint.exceptions.create_list")
-			if reader := instrument.RecordRequestBody(ctx, "endpoint.exceptions.create_list", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &EndpointExceptionsCreateListResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result EndpointExceptionsCreateListResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, EndpointExceptionsCreateListResponseBody](ctx, api, operation{
+			name:   "endpoint.exceptions.create_list",
+			method: http.MethodPost,
+			path:   "/api/endpoint_list",
+		}, nil, opts)
+		return (*EndpointExceptionsCreateListResponse)(res), err
 	}
 }
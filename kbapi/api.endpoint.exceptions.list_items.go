@@ -2,21 +2,16 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // TODO: Update the call
 // EndpointExceptionsListItemsResponse wraps the response from a <todo> call
-type EndpointExceptionsListItemsResponse struct {
-	StatusCode int
-	Body       *EndpointExceptionsListItemsResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type EndpointExceptionsListItemsResponse Response[EndpointExceptionsListItemsResponseBody]
 
 type EndpointExceptionsListItemsResponseBody struct {
 	Data    []EndpointExceptionsListItem `json:"data"`
@@ -51,139 +46,55 @@ func (api *API) newEndpointExceptionsListItems() func(context.Context, *Endpoint
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "endpoint.exceptions.list_items")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/endpoint_list/items/_find"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Filter != nil {
-			params["filter"] = *req.Params.Filter
+			params.Set("filter", *req.Params.Filter)
 		}
 		if req.Params.Page != nil {
-			params["page"] = strconv.Itoa(*req.Params.Page)
+			params.Set("page", strconv.Itoa(*req.Params.Page))
 		}
 		if req.Params.PerPage != nil {
-			params["per_page"] = strconv.Itoa(*req.Params.PerPage)
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
 		}
 		if req.Params.SortField != nil {
-			params["sort_field"] = *req.Params.SortField
+			params.Set("sort_field", *req.Params.SortField)
 		}
 		if req.Params.SortOrder != nil {
-			params["sort_order"] = *req.Params.SortOrder
+			params.Set("sort_order", *req.Params.SortOrder)
 		}
 
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "endpoint.exceptions.list_items")
-			if reader := instrument.RecordRequestBody(ctx, "endpoint.exceptions.list_items", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
+		res, err := do[noBody, EndpointExceptionsListItemsResponseBody](ctx, api, operation{
+			name:   "endpoint.exceptions.list_items",
+			method: http.MethodGet,
+			path:   "/api/endpoint_list/items/_find",
+			query:  params,
+		}, nil, opts)
+		return (*EndpointExceptionsListItemsResponse)(res), err
+	}
+}
 
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
+// AllItems returns an iterator over all endpoint exception list items matching req. Pages of
+// req.Params.PerPage items, or DefaultPerPage when unset, are fetched on demand.
+func (e Exceptions) AllItems(ctx context.Context, req *EndpointExceptionsListItemsRequest, opts ...RequestOption) iter.Seq2[EndpointExceptionsListItem, error] {
+	return items(e.ItemPages(ctx, req, opts...))
+}
 
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
+// ItemPages returns an iterator over the pages of endpoint exception list items matching req.
+func (e Exceptions) ItemPages(ctx context.Context, req *EndpointExceptionsListItemsRequest, opts ...RequestOption) iter.Seq2[*Page[EndpointExceptionsListItem], error] {
+	var params EndpointExceptionsListItemsRequestParams
+	if req != nil {
+		params = req.Params
+	}
 
+	return pages(ctx, intValue(params.Page, 1), intValue(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[EndpointExceptionsListItem], error) {
+		params.Page = &page
+		params.PerPage = &perPage
+		resp, err := e.ListItems(ctx, &EndpointExceptionsListItemsRequest{Params: params}, opts...)
 		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
 			return nil, err
 		}
-
-		// Prepare response
-		resp := &EndpointExceptionsListItemsResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result EndpointExceptionsListItemsResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
-	}
+		return &Page[EndpointExceptionsListItem]{Items: resp.Body.Data, Page: resp.Body.Page, PerPage: resp.Body.PerPage, Total: resp.Body.Total}, nil
+	})
 }
@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// EntityAnalyticsAssetCriticalityBulkUpsertResponse wraps the response from a EntityAnalytics.AssetCriticality.BulkUpsert call
+type EntityAnalyticsAssetCriticalityBulkUpsertResponse Response[BulkUpsertAssetCriticalityRecordsResponse]
+
+type EntityAnalyticsAssetCriticalityBulkUpsertRequest struct {
+	Body BulkUpsertAssetCriticalityRecordsJSONRequestBody
+}
+
+// newEntityAnalyticsAssetCriticalityBulkUpsert returns a function that performs POST /api/asset_criticality/bulk API requests
+func (api *API) newEntityAnalyticsAssetCriticalityBulkUpsert() func(context.Context, *EntityAnalyticsAssetCriticalityBulkUpsertRequest, ...RequestOption) (*EntityAnalyticsAssetCriticalityBulkUpsertResponse, error) {
+	return func(ctx context.Context, req *EntityAnalyticsAssetCriticalityBulkUpsertRequest, opts ...RequestOption) (*EntityAnalyticsAssetCriticalityBulkUpsertResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[BulkUpsertAssetCriticalityRecordsJSONRequestBody, BulkUpsertAssetCriticalityRecordsResponse](ctx, api, operation{
+			name:    "entity_analytics.asset_criticality.bulk_upsert",
+			feature: FeatureAssetCriticality,
+			method:  http.MethodPost,
+			path:    "/api/asset_criticality/bulk",
+		}, &req.Body, opts)
+		return (*EntityAnalyticsAssetCriticalityBulkUpsertResponse)(res), err
+	}
+}
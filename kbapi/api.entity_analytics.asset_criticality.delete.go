@@ -0,0 +1,46 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// EntityAnalyticsAssetCriticalityDeleteResponse wraps the response from a EntityAnalytics.AssetCriticality.Delete call
+type EntityAnalyticsAssetCriticalityDeleteResponse Response[DeleteAssetCriticalityRecordResponse]
+
+type EntityAnalyticsAssetCriticalityDeleteRequest struct {
+	Params DeleteAssetCriticalityRecordParams
+}
+
+// newEntityAnalyticsAssetCriticalityDelete returns a function that performs DELETE /api/asset_criticality API requests
+func (api *API) newEntityAnalyticsAssetCriticalityDelete() func(context.Context, *EntityAnalyticsAssetCriticalityDeleteRequest, ...RequestOption) (*EntityAnalyticsAssetCriticalityDeleteResponse, error) {
+	return func(ctx context.Context, req *EntityAnalyticsAssetCriticalityDeleteRequest, opts ...RequestOption) (*EntityAnalyticsAssetCriticalityDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.IdValue != "" {
+			params.Set("id_value", req.Params.IdValue)
+		}
+		if req.Params.IdField != "" {
+			params.Set("id_field", req.Params.IdField)
+		}
+		if req.Params.Refresh != nil {
+			params.Set("refresh", *req.Params.Refresh)
+		}
+
+		res, err := do[noBody, DeleteAssetCriticalityRecordResponse](ctx, api, operation{
+			name:    "entity_analytics.asset_criticality.delete",
+			feature: FeatureAssetCriticality,
+			method:  http.MethodDelete,
+			path:    "/api/asset_criticality",
+			query:   params,
+		}, nil, opts)
+		return (*EntityAnalyticsAssetCriticalityDeleteResponse)(res), err
+	}
+}
@@ -0,0 +1,43 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// EntityAnalyticsAssetCriticalityGetResponse wraps the response from a EntityAnalytics.AssetCriticality.Get call
+type EntityAnalyticsAssetCriticalityGetResponse Response[SecurityEntityAnalyticsAPIAssetCriticalityRecord]
+
+type EntityAnalyticsAssetCriticalityGetRequest struct {
+	Params GetAssetCriticalityRecordParams
+}
+
+// newEntityAnalyticsAssetCriticalityGet returns a function that performs GET /api/asset_criticality API requests
+func (api *API) newEntityAnalyticsAssetCriticalityGet() func(context.Context, *EntityAnalyticsAssetCriticalityGetRequest, ...RequestOption) (*EntityAnalyticsAssetCriticalityGetResponse, error) {
+	return func(ctx context.Context, req *EntityAnalyticsAssetCriticalityGetRequest, opts ...RequestOption) (*EntityAnalyticsAssetCriticalityGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.IdValue != "" {
+			params.Set("id_value", req.Params.IdValue)
+		}
+		if req.Params.IdField != "" {
+			params.Set("id_field", req.Params.IdField)
+		}
+
+		res, err := do[noBody, SecurityEntityAnalyticsAPIAssetCriticalityRecord](ctx, api, operation{
+			name:    "entity_analytics.asset_criticality.get",
+			feature: FeatureAssetCriticality,
+			method:  http.MethodGet,
+			path:    "/api/asset_criticality",
+			query:   params,
+		}, nil, opts)
+		return (*EntityAnalyticsAssetCriticalityGetResponse)(res), err
+	}
+}
@@ -0,0 +1,79 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// EntityAnalyticsAssetCriticalityListResponse wraps the response from a EntityAnalytics.AssetCriticality.List call
+type EntityAnalyticsAssetCriticalityListResponse Response[FindAssetCriticalityRecordsResponse]
+
+type EntityAnalyticsAssetCriticalityListRequest struct {
+	Params FindAssetCriticalityRecordsParams
+}
+
+// newEntityAnalyticsAssetCriticalityList returns a function that performs GET /api/asset_criticality/list API requests
+func (api *API) newEntityAnalyticsAssetCriticalityList() func(context.Context, *EntityAnalyticsAssetCriticalityListRequest, ...RequestOption) (*EntityAnalyticsAssetCriticalityListResponse, error) {
+	return func(ctx context.Context, req *EntityAnalyticsAssetCriticalityListRequest, opts ...RequestOption) (*EntityAnalyticsAssetCriticalityListResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.SortField != nil {
+			params.Set("sort_field", *req.Params.SortField)
+		}
+		if req.Params.SortDirection != nil {
+			params.Set("sort_direction", *req.Params.SortDirection)
+		}
+		if req.Params.Page != nil {
+			params.Set("page", strconv.Itoa(*req.Params.Page))
+		}
+		if req.Params.PerPage != nil {
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
+		}
+		if req.Params.Kuery != nil {
+			params.Set("kuery", *req.Params.Kuery)
+		}
+
+		res, err := do[noBody, FindAssetCriticalityRecordsResponse](ctx, api, operation{
+			name:    "entity_analytics.asset_criticality.list",
+			feature: FeatureAssetCriticality,
+			method:  http.MethodGet,
+			path:    "/api/asset_criticality/list",
+			query:   params,
+		}, nil, opts)
+		return (*EntityAnalyticsAssetCriticalityListResponse)(res), err
+	}
+}
+
+// All returns an iterator over all asset criticality records matching req.
+// Pages of req.Params.PerPage items, or DefaultPerPage when unset, are fetched
+// on demand.
+func (a AssetCriticality) All(ctx context.Context, req *EntityAnalyticsAssetCriticalityListRequest, opts ...RequestOption) iter.Seq2[SecurityEntityAnalyticsAPIAssetCriticalityRecord, error] {
+	return items(a.Pages(ctx, req, opts...))
+}
+
+// Pages returns an iterator over the pages of asset criticality records matching req.
+func (a AssetCriticality) Pages(ctx context.Context, req *EntityAnalyticsAssetCriticalityListRequest, opts ...RequestOption) iter.Seq2[*Page[SecurityEntityAnalyticsAPIAssetCriticalityRecord], error] {
+	var params FindAssetCriticalityRecordsParams
+	if req != nil {
+		params = req.Params
+	}
+
+	return pages(ctx, intValue(params.Page, 1), intValue(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[SecurityEntityAnalyticsAPIAssetCriticalityRecord], error) {
+		params.Page = IntPtr(page)
+		params.PerPage = IntPtr(perPage)
+		resp, err := a.List(ctx, &EntityAnalyticsAssetCriticalityListRequest{Params: params}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &Page[SecurityEntityAnalyticsAPIAssetCriticalityRecord]{Items: resp.Body.Records, Page: resp.Body.Page, PerPage: resp.Body.PerPage, Total: resp.Body.Total}, nil
+	})
+}
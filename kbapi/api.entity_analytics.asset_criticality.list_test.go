@@ -0,0 +1,68 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetCriticality_All(t *testing.T) {
+	records := []SecurityEntityAnalyticsAPIAssetCriticalityRecord{{IdValue: "host-1"}, {IdValue: "host-2"}, {IdValue: "host-3"}}
+
+	var requested []string
+	api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/s/soc/api/asset_criticality/list", req.URL.Path)
+		q := req.URL.Query()
+		requested = append(requested, q.Get("page"))
+		page, _ := strconv.Atoi(q.Get("page"))
+		perPage, _ := strconv.Atoi(q.Get("per_page"))
+		from := min((page-1)*perPage, len(records))
+		to := min(from+perPage, len(records))
+		return jsonResponse(t, 200, FindAssetCriticalityRecordsResponse{Records: records[from:to], Page: page, PerPage: perPage, Total: len(records)}), nil
+	})).Space("soc")
+
+	req := &EntityAnalyticsAssetCriticalityListRequest{Params: FindAssetCriticalityRecordsParams{PerPage: IntPtr(2)}}
+
+	var ids []string
+	for record, err := range api.EntityAnalytics.AssetCriticality.All(context.Background(), req) {
+		require.NoError(t, err)
+		ids = append(ids, record.IdValue)
+	}
+	assert.Equal(t, []string{"host-1", "host-2", "host-3"}, ids)
+	assert.Equal(t, []string{"1", "2"}, requested)
+}
+
+func TestEntityStoreListEntities(t *testing.T) {
+	mockTransport := NewMockTransportWithRawResponse(200, `{
+		"page": 1,
+		"per_page": 10,
+		"total": 2,
+		"records": [
+			{"entity": {"name": "web-1", "source": "logs-*"}, "host": {"name": "web-1"}},
+			{"entity": {"name": "alice", "source": "logs-*"}, "user": {"name": "alice"}}
+		]
+	}`, nil)
+	api := New(mockTransport)
+
+	resp, err := api.EntityAnalytics.EntityStore.ListEntities(context.Background(), &EntityAnalyticsEntityStoreListEntitiesRequest{
+		Params: ListEntitiesParams{EntityTypes: []string{EntityAnalyticsEntityTypeHost, EntityAnalyticsEntityTypeUser}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"host", "user"}, mockTransport.LastRequest().URL.Query()["entity_types"])
+
+	require.Len(t, resp.Body.Records, 2)
+	assert.Equal(t, EntityAnalyticsEntityTypeHost, resp.Body.Records[0].Type())
+	host, err := resp.Body.Records[0].AsHostEntity()
+	require.NoError(t, err)
+	assert.Equal(t, "web-1", host.Host.Name)
+
+	_, err = resp.Body.Records[1].AsHostEntity()
+	assert.Error(t, err)
+	user, err := resp.Body.Records[1].AsUserEntity()
+	require.NoError(t, err)
+	assert.Equal(t, "alice", user.User.Name)
+}
@@ -0,0 +1,58 @@
+package kbapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+)
+
+// EntityAnalyticsAssetCriticalityUploadCSVResponse wraps the response from a EntityAnalytics.AssetCriticality.UploadCSV call
+type EntityAnalyticsAssetCriticalityUploadCSVResponse Response[BulkUpsertAssetCriticalityRecordsResponse]
+
+type EntityAnalyticsAssetCriticalityUploadCSVRequestBody struct {
+	// File is the CSV content, one `id_field,id_value,criticality_level` record per line
+	File []byte
+}
+
+type EntityAnalyticsAssetCriticalityUploadCSVRequest struct {
+	Body EntityAnalyticsAssetCriticalityUploadCSVRequestBody
+}
+
+// newEntityAnalyticsAssetCriticalityUploadCSV returns a function that performs POST /api/asset_criticality/upload_csv API requests
+func (api *API) newEntityAnalyticsAssetCriticalityUploadCSV() func(context.Context, *EntityAnalyticsAssetCriticalityUploadCSVRequest, ...RequestOption) (*EntityAnalyticsAssetCriticalityUploadCSVResponse, error) {
+	return func(ctx context.Context, req *EntityAnalyticsAssetCriticalityUploadCSVRequest, opts ...RequestOption) (*EntityAnalyticsAssetCriticalityUploadCSVResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Set up multipart form data
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+
+		part, err := writer.CreateFormFile("file", "asset_criticality.csv")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create form file: %w", err)
+		}
+
+		if _, err := part.Write(req.Body.File); err != nil {
+			return nil, fmt.Errorf("failed to write data to form: %w", err)
+		}
+
+		// Close the multipart writer
+		if err := writer.Close(); err != nil {
+			return nil, fmt.Errorf("failed to close writer: %w", err)
+		}
+
+		res, err := do[noBody, BulkUpsertAssetCriticalityRecordsResponse](ctx, api, operation{
+			name:        "entity_analytics.asset_criticality.upload_csv",
+			feature:     FeatureAssetCriticality,
+			method:      http.MethodPost,
+			path:        "/api/asset_criticality/upload_csv",
+			body:        body,
+			contentType: writer.FormDataContentType(),
+		}, nil, opts)
+		return (*EntityAnalyticsAssetCriticalityUploadCSVResponse)(res), err
+	}
+}
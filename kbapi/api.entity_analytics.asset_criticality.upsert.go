@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// EntityAnalyticsAssetCriticalityUpsertResponse wraps the response from a EntityAnalytics.AssetCriticality.Upsert call
+type EntityAnalyticsAssetCriticalityUpsertResponse Response[SecurityEntityAnalyticsAPIAssetCriticalityRecord]
+
+type EntityAnalyticsAssetCriticalityUpsertRequest struct {
+	Body CreateAssetCriticalityRecordJSONRequestBody
+}
+
+// newEntityAnalyticsAssetCriticalityUpsert returns a function that performs POST /api/asset_criticality API requests
+func (api *API) newEntityAnalyticsAssetCriticalityUpsert() func(context.Context, *EntityAnalyticsAssetCriticalityUpsertRequest, ...RequestOption) (*EntityAnalyticsAssetCriticalityUpsertResponse, error) {
+	return func(ctx context.Context, req *EntityAnalyticsAssetCriticalityUpsertRequest, opts ...RequestOption) (*EntityAnalyticsAssetCriticalityUpsertResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[CreateAssetCriticalityRecordJSONRequestBody, SecurityEntityAnalyticsAPIAssetCriticalityRecord](ctx, api, operation{
+			name:    "entity_analytics.asset_criticality.upsert",
+			feature: FeatureAssetCriticality,
+			method:  http.MethodPost,
+			path:    "/api/asset_criticality",
+		}, &req.Body, opts)
+		return (*EntityAnalyticsAssetCriticalityUpsertResponse)(res), err
+	}
+}
@@ -0,0 +1,22 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// EntityAnalyticsEntityStoreApplyDataviewIndicesResponse wraps the response from a EntityAnalytics.EntityStore.ApplyDataviewIndices call
+type EntityAnalyticsEntityStoreApplyDataviewIndicesResponse Response[ApplyEntityEngineDataviewIndicesResponse]
+
+// newEntityAnalyticsEntityStoreApplyDataviewIndices returns a function that performs POST /api/entity_store/engines/apply_dataview_indices API requests
+func (api *API) newEntityAnalyticsEntityStoreApplyDataviewIndices() func(context.Context, ...RequestOption) (*EntityAnalyticsEntityStoreApplyDataviewIndicesResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsEntityStoreApplyDataviewIndicesResponse, error) {
+		res, err := do[noBody, ApplyEntityEngineDataviewIndicesResponse](ctx, api, operation{
+			name:    "entity_analytics.entity_store.apply_dataview_indices",
+			feature: FeatureEntityStore,
+			method:  http.MethodPost,
+			path:    "/api/entity_store/engines/apply_dataview_indices",
+		}, nil, opts)
+		return (*EntityAnalyticsEntityStoreApplyDataviewIndicesResponse)(res), err
+	}
+}
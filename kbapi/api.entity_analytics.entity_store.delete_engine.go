@@ -0,0 +1,42 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// EntityAnalyticsEntityStoreDeleteEngineResponse wraps the response from a EntityAnalytics.EntityStore.DeleteEngine call
+type EntityAnalyticsEntityStoreDeleteEngineResponse Response[DeleteEntityEngineResponse]
+
+type EntityAnalyticsEntityStoreDeleteEngineRequest struct {
+	EntityType SecurityEntityAnalyticsAPIEntityType
+	Params     DeleteEntityEngineParams
+}
+
+// newEntityAnalyticsEntityStoreDeleteEngine returns a function that performs DELETE /api/entity_store/engines/{entityType} API requests
+func (api *API) newEntityAnalyticsEntityStoreDeleteEngine() func(context.Context, *EntityAnalyticsEntityStoreDeleteEngineRequest, ...RequestOption) (*EntityAnalyticsEntityStoreDeleteEngineResponse, error) {
+	return func(ctx context.Context, req *EntityAnalyticsEntityStoreDeleteEngineRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreDeleteEngineResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Data != nil {
+			params.Set("data", strconv.FormatBool(*req.Params.Data))
+		}
+
+		res, err := do[noBody, DeleteEntityEngineResponse](ctx, api, operation{
+			name:    "entity_analytics.entity_store.delete_engine",
+			feature: FeatureEntityStore,
+			method:  http.MethodDelete,
+			path:    fmt.Sprintf("/api/entity_store/engines/%s", req.EntityType),
+			query:   params,
+		}, nil, opts)
+		return (*EntityAnalyticsEntityStoreDeleteEngineResponse)(res), err
+	}
+}
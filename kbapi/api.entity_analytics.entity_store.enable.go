@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// EntityAnalyticsEntityStoreEnableResponse wraps the response from a EntityAnalytics.EntityStore.Enable call
+type EntityAnalyticsEntityStoreEnableResponse Response[InitEntityStoreResponse]
+
+type EntityAnalyticsEntityStoreEnableRequest struct {
+	Body InitEntityStoreJSONRequestBody
+}
+
+// newEntityAnalyticsEntityStoreEnable returns a function that performs POST /api/entity_store/enable API requests
+func (api *API) newEntityAnalyticsEntityStoreEnable() func(context.Context, *EntityAnalyticsEntityStoreEnableRequest, ...RequestOption) (*EntityAnalyticsEntityStoreEnableResponse, error) {
+	return func(ctx context.Context, req *EntityAnalyticsEntityStoreEnableRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreEnableResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[InitEntityStoreJSONRequestBody, InitEntityStoreResponse](ctx, api, operation{
+			name:    "entity_analytics.entity_store.enable",
+			feature: FeatureEntityStore,
+			method:  http.MethodPost,
+			path:    "/api/entity_store/enable",
+		}, &req.Body, opts)
+		return (*EntityAnalyticsEntityStoreEnableResponse)(res), err
+	}
+}
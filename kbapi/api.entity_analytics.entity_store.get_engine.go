@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// EntityAnalyticsEntityStoreGetEngineResponse wraps the response from a EntityAnalytics.EntityStore.GetEngine call
+type EntityAnalyticsEntityStoreGetEngineResponse Response[SecurityEntityAnalyticsAPIEngineDescriptor]
+
+type EntityAnalyticsEntityStoreGetEngineRequest struct {
+	EntityType SecurityEntityAnalyticsAPIEntityType
+}
+
+// newEntityAnalyticsEntityStoreGetEngine returns a function that performs GET /api/entity_store/engines/{entityType} API requests
+func (api *API) newEntityAnalyticsEntityStoreGetEngine() func(context.Context, *EntityAnalyticsEntityStoreGetEngineRequest, ...RequestOption) (*EntityAnalyticsEntityStoreGetEngineResponse, error) {
+	return func(ctx context.Context, req *EntityAnalyticsEntityStoreGetEngineRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreGetEngineResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, SecurityEntityAnalyticsAPIEngineDescriptor](ctx, api, operation{
+			name:    "entity_analytics.entity_store.get_engine",
+			feature: FeatureEntityStore,
+			method:  http.MethodGet,
+			path:    fmt.Sprintf("/api/entity_store/engines/%s", req.EntityType),
+		}, nil, opts)
+		return (*EntityAnalyticsEntityStoreGetEngineResponse)(res), err
+	}
+}
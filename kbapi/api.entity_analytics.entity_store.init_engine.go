@@ -0,0 +1,32 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// EntityAnalyticsEntityStoreInitEngineResponse wraps the response from a EntityAnalytics.EntityStore.InitEngine call
+type EntityAnalyticsEntityStoreInitEngineResponse Response[SecurityEntityAnalyticsAPIEngineDescriptor]
+
+type EntityAnalyticsEntityStoreInitEngineRequest struct {
+	EntityType SecurityEntityAnalyticsAPIEntityType
+	Body       InitEntityEngineJSONRequestBody
+}
+
+// newEntityAnalyticsEntityStoreInitEngine returns a function that performs POST /api/entity_store/engines/{entityType}/init API requests
+func (api *API) newEntityAnalyticsEntityStoreInitEngine() func(context.Context, *EntityAnalyticsEntityStoreInitEngineRequest, ...RequestOption) (*EntityAnalyticsEntityStoreInitEngineResponse, error) {
+	return func(ctx context.Context, req *EntityAnalyticsEntityStoreInitEngineRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreInitEngineResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[InitEntityEngineJSONRequestBody, SecurityEntityAnalyticsAPIEngineDescriptor](ctx, api, operation{
+			name:    "entity_analytics.entity_store.init_engine",
+			feature: FeatureEntityStore,
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/api/entity_store/engines/%s/init", req.EntityType),
+		}, &req.Body, opts)
+		return (*EntityAnalyticsEntityStoreInitEngineResponse)(res), err
+	}
+}
@@ -0,0 +1,22 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// EntityAnalyticsEntityStoreListEnginesResponse wraps the response from a EntityAnalytics.EntityStore.ListEngines call
+type EntityAnalyticsEntityStoreListEnginesResponse Response[ListEntityEnginesResponse]
+
+// newEntityAnalyticsEntityStoreListEngines returns a function that performs GET /api/entity_store/engines API requests
+func (api *API) newEntityAnalyticsEntityStoreListEngines() func(context.Context, ...RequestOption) (*EntityAnalyticsEntityStoreListEnginesResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsEntityStoreListEnginesResponse, error) {
+		res, err := do[noBody, ListEntityEnginesResponse](ctx, api, operation{
+			name:    "entity_analytics.entity_store.list_engines",
+			feature: FeatureEntityStore,
+			method:  http.MethodGet,
+			path:    "/api/entity_store/engines",
+		}, nil, opts)
+		return (*EntityAnalyticsEntityStoreListEnginesResponse)(res), err
+	}
+}
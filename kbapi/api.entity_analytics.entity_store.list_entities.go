@@ -0,0 +1,56 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// EntityAnalyticsEntityStoreListEntitiesResponse wraps the response from a EntityAnalytics.EntityStore.ListEntities call
+type EntityAnalyticsEntityStoreListEntitiesResponse Response[ListEntitiesResponse]
+
+type EntityAnalyticsEntityStoreListEntitiesRequest struct {
+	Params ListEntitiesParams
+}
+
+// newEntityAnalyticsEntityStoreListEntities returns a function that performs GET /api/entity_store/entities/list API requests
+func (api *API) newEntityAnalyticsEntityStoreListEntities() func(context.Context, *EntityAnalyticsEntityStoreListEntitiesRequest, ...RequestOption) (*EntityAnalyticsEntityStoreListEntitiesResponse, error) {
+	return func(ctx context.Context, req *EntityAnalyticsEntityStoreListEntitiesRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreListEntitiesResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.SortField != nil {
+			params.Set("sort_field", *req.Params.SortField)
+		}
+		if req.Params.SortOrder != nil {
+			params.Set("sort_order", *req.Params.SortOrder)
+		}
+		if req.Params.Page != nil {
+			params.Set("page", strconv.Itoa(*req.Params.Page))
+		}
+		if req.Params.PerPage != nil {
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
+		}
+		if req.Params.FilterQuery != nil {
+			params.Set("filterQuery", *req.Params.FilterQuery)
+		}
+		for _, v := range req.Params.EntityTypes {
+			params.Add("entity_types", v)
+		}
+
+		res, err := do[noBody, ListEntitiesResponse](ctx, api, operation{
+			name:    "entity_analytics.entity_store.list_entities",
+			feature: FeatureEntityStore,
+			method:  http.MethodGet,
+			path:    "/api/entity_store/entities/list",
+			query:   params,
+		}, nil, opts)
+		return (*EntityAnalyticsEntityStoreListEntitiesResponse)(res), err
+	}
+}
@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// EntityAnalyticsEntityStoreStartEngineResponse wraps the response from a EntityAnalytics.EntityStore.StartEngine call
+type EntityAnalyticsEntityStoreStartEngineResponse Response[StartEntityEngineResponse]
+
+type EntityAnalyticsEntityStoreStartEngineRequest struct {
+	EntityType SecurityEntityAnalyticsAPIEntityType
+}
+
+// newEntityAnalyticsEntityStoreStartEngine returns a function that performs POST /api/entity_store/engines/{entityType}/start API requests
+func (api *API) newEntityAnalyticsEntityStoreStartEngine() func(context.Context, *EntityAnalyticsEntityStoreStartEngineRequest, ...RequestOption) (*EntityAnalyticsEntityStoreStartEngineResponse, error) {
+	return func(ctx context.Context, req *EntityAnalyticsEntityStoreStartEngineRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreStartEngineResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, StartEntityEngineResponse](ctx, api, operation{
+			name:    "entity_analytics.entity_store.start_engine",
+			feature: FeatureEntityStore,
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/api/entity_store/engines/%s/start", req.EntityType),
+		}, nil, opts)
+		return (*EntityAnalyticsEntityStoreStartEngineResponse)(res), err
+	}
+}
@@ -0,0 +1,41 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// EntityAnalyticsEntityStoreStatusResponse wraps the response from a EntityAnalytics.EntityStore.Status call
+type EntityAnalyticsEntityStoreStatusResponse Response[GetEntityStoreStatusResponse]
+
+type EntityAnalyticsEntityStoreStatusRequest struct {
+	Params GetEntityStoreStatusParams
+}
+
+// newEntityAnalyticsEntityStoreStatus returns a function that performs GET /api/entity_store/status API requests
+func (api *API) newEntityAnalyticsEntityStoreStatus() func(context.Context, *EntityAnalyticsEntityStoreStatusRequest, ...RequestOption) (*EntityAnalyticsEntityStoreStatusResponse, error) {
+	return func(ctx context.Context, req *EntityAnalyticsEntityStoreStatusRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreStatusResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.IncludeComponents != nil {
+			params.Set("include_components", strconv.FormatBool(*req.Params.IncludeComponents))
+		}
+
+		res, err := do[noBody, GetEntityStoreStatusResponse](ctx, api, operation{
+			name:    "entity_analytics.entity_store.status",
+			feature: FeatureEntityStore,
+			method:  http.MethodGet,
+			path:    "/api/entity_store/status",
+			query:   params,
+		}, nil, opts)
+		return (*EntityAnalyticsEntityStoreStatusResponse)(res), err
+	}
+}
@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// EntityAnalyticsEntityStoreStopEngineResponse wraps the response from a EntityAnalytics.EntityStore.StopEngine call
+type EntityAnalyticsEntityStoreStopEngineResponse Response[StopEntityEngineResponse]
+
+type EntityAnalyticsEntityStoreStopEngineRequest struct {
+	EntityType SecurityEntityAnalyticsAPIEntityType
+}
+
+// newEntityAnalyticsEntityStoreStopEngine returns a function that performs POST /api/entity_store/engines/{entityType}/stop API requests
+func (api *API) newEntityAnalyticsEntityStoreStopEngine() func(context.Context, *EntityAnalyticsEntityStoreStopEngineRequest, ...RequestOption) (*EntityAnalyticsEntityStoreStopEngineResponse, error) {
+	return func(ctx context.Context, req *EntityAnalyticsEntityStoreStopEngineRequest, opts ...RequestOption) (*EntityAnalyticsEntityStoreStopEngineResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, StopEntityEngineResponse](ctx, api, operation{
+			name:    "entity_analytics.entity_store.stop_engine",
+			feature: FeatureEntityStore,
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/api/entity_store/engines/%s/stop", req.EntityType),
+		}, nil, opts)
+		return (*EntityAnalyticsEntityStoreStopEngineResponse)(res), err
+	}
+}
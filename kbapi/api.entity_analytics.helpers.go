@@ -0,0 +1,68 @@
+package kbapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Entity types managed by the entity store.
+const (
+	EntityAnalyticsEntityTypeHost    = "host"
+	EntityAnalyticsEntityTypeService = "service"
+	EntityAnalyticsEntityTypeUser    = "user"
+)
+
+// Risk engine statuses reported by RiskEngine.Status.
+const (
+	EntityAnalyticsRiskEngineStatusNotInstalled = "NOT_INSTALLED"
+	EntityAnalyticsRiskEngineStatusDisabled     = "DISABLED"
+	EntityAnalyticsRiskEngineStatusEnabled      = "ENABLED"
+)
+
+// Type returns the type of the entity, one of the EntityAnalyticsEntityType*
+// values, or an empty string when it can't be determined.
+func (t SecurityEntityAnalyticsAPIEntity) Type() string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(t.union, &fields); err != nil {
+		return ""
+	}
+	for _, entityType := range []string{EntityAnalyticsEntityTypeHost, EntityAnalyticsEntityTypeUser, EntityAnalyticsEntityTypeService} {
+		if _, ok := fields[entityType]; ok {
+			return entityType
+		}
+	}
+	return ""
+}
+
+// AsHostEntity returns the entity as a host entity.
+func (t SecurityEntityAnalyticsAPIEntity) AsHostEntity() (SecurityEntityAnalyticsAPIHostEntity, error) {
+	var entity SecurityEntityAnalyticsAPIHostEntity
+	return entity, t.as(EntityAnalyticsEntityTypeHost, &entity)
+}
+
+// AsUserEntity returns the entity as a user entity.
+func (t SecurityEntityAnalyticsAPIEntity) AsUserEntity() (SecurityEntityAnalyticsAPIUserEntity, error) {
+	var entity SecurityEntityAnalyticsAPIUserEntity
+	return entity, t.as(EntityAnalyticsEntityTypeUser, &entity)
+}
+
+// AsServiceEntity returns the entity as a service entity.
+func (t SecurityEntityAnalyticsAPIEntity) AsServiceEntity() (SecurityEntityAnalyticsAPIServiceEntity, error) {
+	var entity SecurityEntityAnalyticsAPIServiceEntity
+	return entity, t.as(EntityAnalyticsEntityTypeService, &entity)
+}
+
+func (t SecurityEntityAnalyticsAPIEntity) as(entityType string, v any) error {
+	if actual := t.Type(); actual != entityType {
+		return fmt.Errorf("entity is of type %q, not %q", actual, entityType)
+	}
+	return json.Unmarshal(t.union, v)
+}
+
+func (t SecurityEntityAnalyticsAPIEntity) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityEntityAnalyticsAPIEntity) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
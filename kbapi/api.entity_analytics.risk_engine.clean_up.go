@@ -0,0 +1,21 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// EntityAnalyticsRiskEngineCleanUpResponse wraps the response from a EntityAnalytics.RiskEngine.CleanUp call
+type EntityAnalyticsRiskEngineCleanUpResponse Response[CleanUpRiskEngineResponse]
+
+// newEntityAnalyticsRiskEngineCleanUp returns a function that performs DELETE /api/risk_score/engine/dangerously_delete_data API requests
+func (api *API) newEntityAnalyticsRiskEngineCleanUp() func(context.Context, ...RequestOption) (*EntityAnalyticsRiskEngineCleanUpResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsRiskEngineCleanUpResponse, error) {
+		res, err := do[noBody, CleanUpRiskEngineResponse](ctx, api, operation{
+			name:   "entity_analytics.risk_engine.clean_up",
+			method: http.MethodDelete,
+			path:   "/api/risk_score/engine/dangerously_delete_data",
+		}, nil, opts)
+		return (*EntityAnalyticsRiskEngineCleanUpResponse)(res), err
+	}
+}
@@ -0,0 +1,21 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// EntityAnalyticsRiskEngineDisableResponse wraps the response from a EntityAnalytics.RiskEngine.Disable call
+type EntityAnalyticsRiskEngineDisableResponse Response[EntityAnalyticsRiskEngineToggleResponseBody]
+
+// newEntityAnalyticsRiskEngineDisable returns a function that performs POST /internal/risk_score/engine/disable API requests
+func (api *API) newEntityAnalyticsRiskEngineDisable() func(context.Context, ...RequestOption) (*EntityAnalyticsRiskEngineDisableResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsRiskEngineDisableResponse, error) {
+		res, err := do[noBody, EntityAnalyticsRiskEngineToggleResponseBody](ctx, api, operation{
+			name:   "entity_analytics.risk_engine.disable",
+			method: http.MethodPost,
+			path:   "/internal/risk_score/engine/disable",
+		}, nil, opts)
+		return (*EntityAnalyticsRiskEngineDisableResponse)(res), err
+	}
+}
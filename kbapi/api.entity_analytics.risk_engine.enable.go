@@ -0,0 +1,26 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// EntityAnalyticsRiskEngineEnableResponse wraps the response from a EntityAnalytics.RiskEngine.Enable call
+type EntityAnalyticsRiskEngineEnableResponse Response[EntityAnalyticsRiskEngineToggleResponseBody]
+
+// EntityAnalyticsRiskEngineToggleResponseBody is returned when the risk engine is enabled or disabled
+type EntityAnalyticsRiskEngineToggleResponseBody struct {
+	Success *bool `json:"success,omitempty"`
+}
+
+// newEntityAnalyticsRiskEngineEnable returns a function that performs POST /internal/risk_score/engine/enable API requests
+func (api *API) newEntityAnalyticsRiskEngineEnable() func(context.Context, ...RequestOption) (*EntityAnalyticsRiskEngineEnableResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsRiskEngineEnableResponse, error) {
+		res, err := do[noBody, EntityAnalyticsRiskEngineToggleResponseBody](ctx, api, operation{
+			name:   "entity_analytics.risk_engine.enable",
+			method: http.MethodPost,
+			path:   "/internal/risk_score/engine/enable",
+		}, nil, opts)
+		return (*EntityAnalyticsRiskEngineEnableResponse)(res), err
+	}
+}
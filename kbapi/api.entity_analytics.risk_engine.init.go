@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// EntityAnalyticsRiskEngineInitResponse wraps the response from a EntityAnalytics.RiskEngine.Init call
+type EntityAnalyticsRiskEngineInitResponse Response[EntityAnalyticsRiskEngineInitResponseBody]
+
+type EntityAnalyticsRiskEngineInitResponseBody struct {
+	Result struct {
+		Errors                         []string `json:"errors"`
+		LegacyRiskEngineDisabled       bool     `json:"legacy_risk_engine_disabled"`
+		RiskEngineConfigurationCreated bool     `json:"risk_engine_configuration_created"`
+		RiskEngineEnabled              bool     `json:"risk_engine_enabled"`
+		RiskEngineResourcesInstalled   bool     `json:"risk_engine_resources_installed"`
+	} `json:"result"`
+}
+
+// newEntityAnalyticsRiskEngineInit returns a function that performs POST /internal/risk_score/engine/init API requests
+func (api *API) newEntityAnalyticsRiskEngineInit() func(context.Context, ...RequestOption) (*EntityAnalyticsRiskEngineInitResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsRiskEngineInitResponse, error) {
+		res, err := do[noBody, EntityAnalyticsRiskEngineInitResponseBody](ctx, api, operation{
+			name:   "entity_analytics.risk_engine.init",
+			method: http.MethodPost,
+			path:   "/internal/risk_score/engine/init",
+		}, nil, opts)
+		return (*EntityAnalyticsRiskEngineInitResponse)(res), err
+	}
+}
@@ -0,0 +1,21 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// EntityAnalyticsRiskEngineScheduleNowResponse wraps the response from a EntityAnalytics.RiskEngine.ScheduleNow call
+type EntityAnalyticsRiskEngineScheduleNowResponse Response[SecurityEntityAnalyticsAPIRiskEngineScheduleNowResponse]
+
+// newEntityAnalyticsRiskEngineScheduleNow returns a function that performs POST /api/risk_score/engine/schedule_now API requests
+func (api *API) newEntityAnalyticsRiskEngineScheduleNow() func(context.Context, ...RequestOption) (*EntityAnalyticsRiskEngineScheduleNowResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsRiskEngineScheduleNowResponse, error) {
+		res, err := do[noBody, SecurityEntityAnalyticsAPIRiskEngineScheduleNowResponse](ctx, api, operation{
+			name:   "entity_analytics.risk_engine.schedule_now",
+			method: http.MethodPost,
+			path:   "/api/risk_score/engine/schedule_now",
+		}, nil, opts)
+		return (*EntityAnalyticsRiskEngineScheduleNowResponse)(res), err
+	}
+}
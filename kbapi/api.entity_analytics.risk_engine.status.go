@@ -0,0 +1,35 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// EntityAnalyticsRiskEngineStatusResponse wraps the response from a EntityAnalytics.RiskEngine.Status call
+type EntityAnalyticsRiskEngineStatusResponse Response[EntityAnalyticsRiskEngineStatusResponseBody]
+
+type EntityAnalyticsRiskEngineStatusResponseBody struct {
+	// RiskEngineStatus is one of the EntityAnalyticsRiskEngineStatus* values
+	RiskEngineStatus                string `json:"risk_engine_status"`
+	LegacyRiskEngineStatus          string `json:"legacy_risk_engine_status"`
+	IsMaxAmountOfRiskEnginesReached bool   `json:"is_max_amount_of_risk_engines_reached"`
+	RiskEngineTaskStatus            *struct {
+		Runs      int        `json:"runs"`
+		Status    string     `json:"status"`
+		StartedAt *time.Time `json:"startedAt,omitempty"`
+		RunAt     time.Time  `json:"runAt"`
+	} `json:"risk_engine_task_status,omitempty"`
+}
+
+// newEntityAnalyticsRiskEngineStatus returns a function that performs GET /internal/risk_score/engine/status API requests
+func (api *API) newEntityAnalyticsRiskEngineStatus() func(context.Context, ...RequestOption) (*EntityAnalyticsRiskEngineStatusResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*EntityAnalyticsRiskEngineStatusResponse, error) {
+		res, err := do[noBody, EntityAnalyticsRiskEngineStatusResponseBody](ctx, api, operation{
+			name:   "entity_analytics.risk_engine.status",
+			method: http.MethodGet,
+			path:   "/internal/risk_score/engine/status",
+		}, nil, opts)
+		return (*EntityAnalyticsRiskEngineStatusResponse)(res), err
+	}
+}
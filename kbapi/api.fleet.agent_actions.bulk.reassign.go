@@ -1,21 +1,14 @@
 package kbapi
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 )
 
 // FleetBulkReassignAgentResponse wraps the response from a FleetReassignAgent
-type FleetBulkReassignAgentResponse struct {
-	StatusCode int
-	Body       *FleetBulkReassignAgentResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetBulkReassignAgentResponse Response[FleetBulkReassignAgentResponseBody]
 
 type FleetBulkReassignAgentResponseBody struct {
 	ActionId string `json:"actionId"`
@@ -60,100 +53,11 @@ func (api *API) newFleetBulkReassignAgents() func(context.Context, *FleetBulkRea
 			return nil, fmt.Errorf("Policy ID is not defined")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.agents.bulk.reassign")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/fleet/agents/bulk_reassign"
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		jsonBody, err := json.Marshal(req.Body)
-		if err != nil {
-			return nil, err
-		}
-
-		httpReq.Body = io.NopCloser(bytes.NewReader(jsonBody))
-		httpReq.Header.Set("Content-Type", "application/json")
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.agents.bulk.reassign")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.agents.bulk.reassign", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &FleetBulkReassignAgentResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result FleetBulkReassignAgentResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[FleetBulkReassignAgentRequestBody, FleetBulkReassignAgentResponseBody](ctx, api, operation{
+			name:   "fleet.agents.bulk.reassign",
+			method: http.MethodPost,
+			path:   "/api/fleet/agents/bulk_reassign",
+		}, req.Body, opts)
+		return (*FleetBulkReassignAgentResponse)(res), err
 	}
 }
@@ -2,21 +2,15 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // TODO: Update the call
 // FleetAgentActionsListStatusResponse wraps the response from a <todo> call
-type FleetAgentActionsListStatusResponse struct {
-	StatusCode int
-	Body       *FleetAgentActionsListStatusResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetAgentActionsListStatusResponse Response[FleetAgentActionsListStatusResponseBody]
 
 type FleetAgentActionsListStatusResponseBody struct {
 	Items []struct {
@@ -85,139 +79,31 @@ func (api *API) newFleetAgentActionsListStatus() func(context.Context, *FleetAge
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.agent_actions.list_status")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/fleet/agents/action_status"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Page != nil {
-			params["page"] = strconv.FormatFloat(float64(*req.Params.Page), 'f', -1, 32)
+			params.Set("page", strconv.FormatFloat(float64(*req.Params.Page), 'f', -1, 32))
 		}
 		if req.Params.PerPage != nil {
-			params["perPage"] = strconv.FormatFloat(float64(*req.Params.PerPage), 'f', -1, 32)
+			params.Set("perPage", strconv.FormatFloat(float64(*req.Params.PerPage), 'f', -1, 32))
 		}
 		if req.Params.Date != nil {
-			params["date"] = *req.Params.Date
+			params.Set("date", *req.Params.Date)
 		}
 		if req.Params.Latest != nil {
-			params["latest"] = strconv.Itoa(*req.Params.Latest)
+			params.Set("latest", strconv.Itoa(*req.Params.Latest))
 		}
 		if req.Params.ErrorSize != nil {
-			params["errorSize"] = strconv.Itoa(*req.Params.ErrorSize)
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.agent_actions.list_status")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.agent_actions.list_status", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
+			params.Set("errorSize", strconv.Itoa(*req.Params.ErrorSize))
 		}
 
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &FleetAgentActionsListStatusResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result FleetAgentActionsListStatusResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, FleetAgentActionsListStatusResponseBody](ctx, api, operation{
+			name:   "fleet.agent_actions.list_status",
+			method: http.MethodGet,
+			path:   "/api/fleet/agents/action_status",
+			query:  params,
+		}, nil, opts)
+		return (*FleetAgentActionsListStatusResponse)(res), err
 	}
 }
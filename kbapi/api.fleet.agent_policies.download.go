@@ -2,21 +2,15 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 )
 
 // FleetDownloadAgentPolicyResponse wraps the response from a FleetDownloadAgentPolicy call
-type FleetDownloadAgentPolicyResponse struct {
-	StatusCode int
-	Body       *string
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetDownloadAgentPolicyResponse Response[string]
 
 // FleetDownloadAgentPolicyRequest is the request for newFleetDownloadAgentPolicy
 type FleetDownloadAgentPolicyRequest struct {
@@ -38,111 +32,26 @@ func (api *API) newFleetDownloadAgentPolicy() func(context.Context, *FleetDownlo
 			return nil, fmt.Errorf("Required Agent Policy ID is not defined")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.agent_policies.download")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := fmt.Sprintf("/api/fleet/agent_policies/%s/download", req.ID)
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Download != nil {
-			params["download"] = strconv.FormatBool(*req.Params.Download)
+			params.Set("download", strconv.FormatBool(*req.Params.Download))
 		}
 		if req.Params.Standalone != nil {
-			params["standalone"] = strconv.FormatBool(*req.Params.Standalone)
+			params.Set("standalone", strconv.FormatBool(*req.Params.Standalone))
 		}
 		if req.Params.Kubernetes != nil {
-			params["kubernetes"] = strconv.FormatBool(*req.Params.Kubernetes)
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.agent_policies.download")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.agent_policies.download", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
+			params.Set("kubernetes", strconv.FormatBool(*req.Params.Kubernetes))
 		}
 
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &FleetDownloadAgentPolicyResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		bodyBytes, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read response body: %v", err)
-		}
-		httpResp.Body.Close()
-
-		if httpResp.StatusCode == 200 {
-			resp.Body = StrPtr(string(bodyBytes))
-			return resp, nil
-		} else {
-			// For all non-200 responses
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, string](ctx, api, operation{
+			name:   "fleet.agent_policies.download",
+			method: http.MethodGet,
+			path:   fmt.Sprintf("/api/fleet/agent_policies/%s/download", req.ID),
+			query:  params,
+		}, nil, opts)
+		return (*FleetDownloadAgentPolicyResponse)(res), err
 	}
 }
 
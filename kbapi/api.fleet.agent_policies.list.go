@@ -2,20 +2,14 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
+	"iter"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // FleetListAgentPolicies wraps the response from a FleetListAgentPolicies call
-type FleetListAgentPoliciesResponse struct {
-	StatusCode int
-	Body       *GetFleetAgentPoliciesResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetListAgentPoliciesResponse Response[GetFleetAgentPoliciesResponseBody]
 
 type GetFleetAgentPoliciesResponseBody struct {
 	Items   []AgentPolicy `json:"items"`
@@ -46,131 +40,58 @@ func (api *API) newFleetAgentListPoliciesFunc() func(context.Context, *FleetAgen
 			req = &FleetAgentPoliciesRequest{}
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.agent_policies.list")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/fleet/agent_policies"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Page != nil {
-			params["page"] = strconv.FormatFloat(float64(*req.Params.Page), 'f', -1, 32)
+			params.Set("page", strconv.FormatFloat(float64(*req.Params.Page), 'f', -1, 32))
 		}
 		if req.Params.PerPage != nil {
-			params["perPage"] = strconv.FormatFloat(float64(*req.Params.PerPage), 'f', -1, 32)
+			params.Set("perPage", strconv.FormatFloat(float64(*req.Params.PerPage), 'f', -1, 32))
 		}
 		if req.Params.Kuery != nil {
-			params["kuery"] = *req.Params.Kuery
+			params.Set("kuery", *req.Params.Kuery)
 		}
 		if req.Params.Format != nil {
-			params["format"] = *req.Params.Format
+			params.Set("format", *req.Params.Format)
 		}
 		if req.Params.Full != nil {
-			params["full"] = strconv.FormatBool(*req.Params.Full)
+			params.Set("full", strconv.FormatBool(*req.Params.Full))
 		}
 		if req.Params.NoAgentCount != nil {
-			params["noAgentCount"] = strconv.FormatBool(*req.Params.NoAgentCount)
+			params.Set("noAgentCount", strconv.FormatBool(*req.Params.NoAgentCount))
 		}
 
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.agent_policies.list")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.agent_policies.list", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
+		res, err := do[noBody, GetFleetAgentPoliciesResponseBody](ctx, api, operation{
+			name:   "fleet.agent_policies.list",
+			method: http.MethodGet,
+			path:   "/api/fleet/agent_policies",
+			query:  params,
+		}, nil, opts)
+		return (*FleetListAgentPoliciesResponse)(res), err
+	}
+}
 
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
+// All returns an iterator over all agent policies matching req. Pages of
+// req.Params.PerPage items, or DefaultPerPage when unset, are fetched on demand.
+func (a AgentPolicies) All(ctx context.Context, req *FleetAgentPoliciesRequest, opts ...RequestOption) iter.Seq2[AgentPolicy, error] {
+	return items(a.Pages(ctx, req, opts...))
+}
 
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
+// Pages returns an iterator over the pages of agent policies matching req.
+func (a AgentPolicies) Pages(ctx context.Context, req *FleetAgentPoliciesRequest, opts ...RequestOption) iter.Seq2[*Page[AgentPolicy], error] {
+	var params FleetAgentPoliciesRequestParams
+	if req != nil {
+		params = req.Params
+	}
 
+	return pages(ctx, float32Value(params.Page, 1), float32Value(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[AgentPolicy], error) {
+		params.Page = Float32Ptr(float32(page))
+		params.PerPage = Float32Ptr(float32(perPage))
+		resp, err := a.List(ctx, &FleetAgentPoliciesRequest{Params: params}, opts...)
 		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
 			return nil, err
 		}
-
-		// Prepare response
-		resp := &FleetListAgentPoliciesResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result GetFleetAgentPoliciesResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage := string(bodyBytes)
-				if jsonMap, ok := errorObj.(map[string]interface{}); ok {
-					if msg, exists := jsonMap["message"]; exists {
-						if msgStr, ok := msg.(string); ok {
-							errorMessage = msgStr
-						}
-					}
-				}
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
-	}
+		return &Page[AgentPolicy]{Items: resp.Body.Items, Page: int(resp.Body.Page), PerPage: int(resp.Body.PerPage), Total: int(resp.Body.Total)}, nil
+	})
 }
@@ -2,20 +2,14 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
+	"iter"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // FleetBulkGetAgentPolicies wraps the response from a FleetBulkGetAgentPolicies call
-type FleetListAgentsResponse struct {
-	StatusCode int
-	Body       *FleetListAgentsResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetListAgentsResponse Response[FleetListAgentsResponseBody]
 
 type FleetListAgentsRequest struct {
 	Params FleetListAgentsRequestParams
@@ -30,7 +24,16 @@ type FleetListAgentsRequestParams struct {
 	WithMetrics      *bool    `form:"withMetrics,omitempty" json:"withMetrics,omitempty"`
 	ShowUpgradeable  *bool    `form:"showUpgradeable,omitempty" json:"showUpgradeable,omitempty"`
 	GetStatusSummary *bool    `form:"getStatusSummary,omitempty" json:"getStatusSummary,omitempty"`
+	SortField        *string  `form:"sortField,omitempty" json:"sortField,omitempty"`
 	SortOrder        *string  `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+	// SearchAfter is the JSON encoded sort values returned as NextSearchAfter by the previous page.
+	SearchAfter *string `form:"searchAfter,omitempty" json:"searchAfter,omitempty"`
+	// OpenPit opens a point in time for consistent pagination with SearchAfter.
+	OpenPit *bool `form:"openPit,omitempty" json:"openPit,omitempty"`
+	// PitID is the point in time ID returned as Pit by the previous page.
+	PitID *string `form:"pitId,omitempty" json:"pitId,omitempty"`
+	// PitKeepAlive is how long the point in time is kept alive, e.g. 1m.
+	PitKeepAlive *string `form:"pitKeepAlive,omitempty" json:"pitKeepAlive,omitempty"`
 }
 
 // newFleetListAgents returns a function that performs GET /api/fleet/agents API requests
@@ -40,131 +43,103 @@ func (api *API) newFleetListAgents() func(context.Context, *FleetListAgentsReque
 			req = &FleetListAgentsRequest{}
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.agents.list")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/fleet/agents"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Page != nil {
-			params["page"] = strconv.FormatFloat(float64(*req.Params.Page), 'f', -1, 32)
+			params.Set("page", strconv.FormatFloat(float64(*req.Params.Page), 'f', -1, 32))
 		}
 		if req.Params.PerPage != nil {
-			params["perPage"] = strconv.FormatFloat(float64(*req.Params.PerPage), 'f', -1, 32)
+			params.Set("perPage", strconv.FormatFloat(float64(*req.Params.PerPage), 'f', -1, 32))
 		}
 		if req.Params.Kuery != nil {
-			params["kuery"] = *req.Params.Kuery
+			params.Set("kuery", *req.Params.Kuery)
 		}
 		if req.Params.ShowInactive != nil {
-			params["showInactive"] = strconv.FormatBool(*req.Params.ShowInactive)
+			params.Set("showInactive", strconv.FormatBool(*req.Params.ShowInactive))
 		}
 		if req.Params.WithMetrics != nil {
-			params["withMetrics"] = strconv.FormatBool(*req.Params.WithMetrics)
+			params.Set("withMetrics", strconv.FormatBool(*req.Params.WithMetrics))
 		}
 		if req.Params.ShowUpgradeable != nil {
-			params["showUpgradeable"] = strconv.FormatBool(*req.Params.ShowUpgradeable)
+			params.Set("showUpgradeable", strconv.FormatBool(*req.Params.ShowUpgradeable))
 		}
 		if req.Params.GetStatusSummary != nil {
-			params["getStatusSummary"] = strconv.FormatBool(*req.Params.GetStatusSummary)
+			params.Set("getStatusSummary", strconv.FormatBool(*req.Params.GetStatusSummary))
+		}
+		if req.Params.SortField != nil {
+			params.Set("sortField", *req.Params.SortField)
 		}
 		if req.Params.SortOrder != nil {
-			params["sortOrder"] = *req.Params.SortOrder
+			params.Set("sortOrder", *req.Params.SortOrder)
 		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			return nil, err
+		if req.Params.SearchAfter != nil {
+			params.Set("searchAfter", *req.Params.SearchAfter)
 		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
+		if req.Params.OpenPit != nil {
+			params.Set("openPit", strconv.FormatBool(*req.Params.OpenPit))
 		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
+		if req.Params.PitID != nil {
+			params.Set("pitId", *req.Params.PitID)
 		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.agents.list")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.agents.list", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
+		if req.Params.PitKeepAlive != nil {
+			params.Set("pitKeepAlive", *req.Params.PitKeepAlive)
 		}
 
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
+		res, err := do[noBody, FleetListAgentsResponseBody](ctx, api, operation{
+			name:   "fleet.agents.list",
+			method: http.MethodGet,
+			path:   "/api/fleet/agents",
+			query:  params,
+		}, nil, opts)
+		return (*FleetListAgentsResponse)(res), err
+	}
+}
 
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
+// All returns an iterator over all agents matching req. Pages of
+// req.Params.PerPage agents, or DefaultPerPage when unset, are fetched on demand.
+func (a Agents) All(ctx context.Context, req *FleetListAgentsRequest, opts ...RequestOption) iter.Seq2[FleetListAgentsResponseBodyItem, error] {
+	return items(a.Pages(ctx, req, opts...))
+}
 
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
+// Pages returns an iterator over the pages of agents matching req. Once Kibana
+// returns NextSearchAfter, subsequent pages are requested with searchAfter
+// instead of page numbers so that deep pagination is not limited by the
+// Elasticsearch result window.
+func (a Agents) Pages(ctx context.Context, req *FleetListAgentsRequest, opts ...RequestOption) iter.Seq2[*Page[FleetListAgentsResponseBodyItem], error] {
+	var params FleetListAgentsRequestParams
+	if req != nil {
+		params = req.Params
+	}
+	start := float32Value(params.Page, 1)
+	perPage := float32Value(params.PerPage, DefaultPerPage)
+
+	// The cursor is kept per iteration, so that ranging over the sequence
+	// again starts from the first page
+	return func(yield func(*Page[FleetListAgentsResponseBodyItem], error) bool) {
+		searchAfter := params.SearchAfter
+		for p, err := range pages(ctx, start, perPage, func(ctx context.Context, page, perPage int) (*Page[FleetListAgentsResponseBodyItem], error) {
+			pageParams := params
+			pageParams.PerPage = Float32Ptr(float32(perPage))
+			if searchAfter != nil {
+				pageParams.Page = nil
+				pageParams.SearchAfter = searchAfter
+			} else {
+				pageParams.Page = Float32Ptr(float32(page))
 			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &FleetListAgentsResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
 
-		var result FleetListAgentsResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
+			resp, err := a.List(ctx, &FleetListAgentsRequest{Params: pageParams}, opts...)
+			if err != nil {
 				return nil, err
 			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
+			if resp.Body.NextSearchAfter != nil && *resp.Body.NextSearchAfter != "" {
+				searchAfter = resp.Body.NextSearchAfter
 			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
+			return &Page[FleetListAgentsResponseBodyItem]{Items: resp.Body.Items, Page: page, PerPage: perPage, Total: int(resp.Body.Total)}, nil
+		}) {
+			if !yield(p, err) {
+				return
 			}
 		}
-
 	}
 }
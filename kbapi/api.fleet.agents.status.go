@@ -2,19 +2,12 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 )
 
 // FleetAgentStatusResponse wraps the response from a FleetAgentStatus call
-type FleetAgentStatusResponse struct {
-	StatusCode int
-	Body       *FleetAgentStatusResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetAgentStatusResponse Response[FleetAgentStatusResponseBody]
 
 type FleetAgentStatusResponseBody struct {
 	Results struct {
@@ -48,113 +41,22 @@ func (api *API) newFleetAgentStatusFunc() func(context.Context, *FleetAgentStatu
 			req = &FleetAgentStatusRequest{}
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.agents.status")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/fleet/agent_status"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.PolicyId != nil {
-			params["policyId"] = *req.Params.PolicyId
+			params.Set("policyId", *req.Params.PolicyId)
 		}
 		if req.Params.Kuery != nil {
-			params["kuery"] = *req.Params.Kuery
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.agents.status")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.agents.status", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &FleetAgentStatusResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result FleetAgentStatusResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
+			params.Set("kuery", *req.Params.Kuery)
 		}
 
+		res, err := do[noBody, FleetAgentStatusResponseBody](ctx, api, operation{
+			name:   "fleet.agents.status",
+			method: http.MethodGet,
+			path:   "/api/fleet/agent_status",
+			query:  params,
+		}, nil, opts)
+		return (*FleetAgentStatusResponse)(res), err
 	}
 }
@@ -0,0 +1,367 @@
+package kbapi
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFleet_RequestBodies(t *testing.T) {
+	ctx := context.Background()
+	agents := json.RawMessage(`["agent-1","agent-2"]`)
+
+	testCases := []struct {
+		name     string
+		call     func(api *API) error
+		response string
+		method   string
+		path     string
+		query    map[string]string
+		body     interface{}
+	}{
+		{
+			name: "agent actions bulk get diagnostics",
+			call: func(api *API) error {
+				_, err := api.Fleet.AgentActions.BulkGetDiagnostics(ctx, &FleetBulkGetDiagnosticsAgentRequest{
+					Body: FleetBulkGetDiagnosticsAgentRequestBody{Agents: agents, AdditionalMetrics: &[]string{"CPU"}},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/agents/request_diagnostics",
+			body:   map[string]interface{}{"agents": []string{"agent-1", "agent-2"}, "additional_metrics": []string{"CPU"}},
+		},
+		{
+			name: "agent actions bulk reassign",
+			call: func(api *API) error {
+				_, err := api.Fleet.AgentActions.BulkReassign(ctx, &FleetBulkReassignAgentRequest{
+					Body: &FleetBulkReassignAgentRequestBody{Agents: agents, PolicyId: "policy-1"},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/agents/bulk_reassign",
+			body:   map[string]interface{}{"agents": []string{"agent-1", "agent-2"}, "policy_id": "policy-1"},
+		},
+		{
+			name: "agent actions bulk unenroll",
+			call: func(api *API) error {
+				_, err := api.Fleet.AgentActions.BulkUnenroll(ctx, &FleetBulkUnenrollAgentsRequest{
+					Body: FleetBulkUnenrollAgentsRequestBody{Agents: agents, Revoke: BoolPtr(true)},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/agents/bulk_unenroll",
+			body:   map[string]interface{}{"agents": []string{"agent-1", "agent-2"}, "revoke": true},
+		},
+		{
+			name: "agent actions bulk update tags",
+			call: func(api *API) error {
+				_, err := api.Fleet.AgentActions.BulkUpdateAgentTags(ctx, &FleetBulkUpdateAgentTagsRequest{
+					Body: FleetBulkUpdateAgentTagsRequestBody{Agents: agents, Tags: &[]string{"prod"}},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/agents/bulk_update_agent_tags",
+			body:   map[string]interface{}{"agents": []string{"agent-1", "agent-2"}, "tags": []string{"prod"}},
+		},
+		{
+			name: "agent actions bulk upgrade",
+			call: func(api *API) error {
+				_, err := api.Fleet.AgentActions.BulkUpgrade(ctx, &FleetBulkUpgradeAgentsRequest{
+					Body: FleetBulkUpgradeAgentsRequestBody{Agents: agents, Version: "8.17.0"},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/agents/bulk_upgrade",
+			body:   map[string]interface{}{"agents": []string{"agent-1", "agent-2"}, "version": "8.17.0"},
+		},
+		{
+			name: "agent actions get diagnostics",
+			call: func(api *API) error {
+				_, err := api.Fleet.AgentActions.GetDiagnostics(ctx, &FleetGetDiagnosticsAgentRequest{
+					AgentID: "agent-1",
+					Body:    FleetGetDiagnosticsAgentRequestBody{AdditionalMetrics: &[]string{"CPU"}},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/agents/agent-1/request_diagnostics",
+			body:   map[string]interface{}{"additional_metrics": []string{"CPU"}},
+		},
+		{
+			name: "agent actions reassign",
+			call: func(api *API) error {
+				_, err := api.Fleet.AgentActions.Reassign(ctx, &FleetReassignAgentRequest{
+					AgentID: "agent-1",
+					Body:    FleetReassignAgentRequestBody{PolicyId: "policy-1"},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/agents/agent-1/reassign",
+			body:   map[string]interface{}{"policy_id": "policy-1"},
+		},
+		{
+			name: "agent actions unenroll",
+			call: func(api *API) error {
+				_, err := api.Fleet.AgentActions.Unenroll(ctx, &FleetUnenrollAgentRequest{
+					AgentID: "agent-1",
+					Body:    FleetUnenrollAgentRequestBody{Force: BoolPtr(true)},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/agents/agent-1/unenroll",
+			body:   map[string]interface{}{"force": true},
+		},
+		{
+			name: "agent actions upgrade",
+			call: func(api *API) error {
+				_, err := api.Fleet.AgentActions.Upgrade(ctx, &FleetUpgradeAgentRequest{
+					AgentID: "agent-1",
+					Body:    FleetUpgradeAgentRequestBody{Version: "8.17.0"},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/agents/agent-1/upgrade",
+			body:   map[string]interface{}{"version": "8.17.0"},
+		},
+		{
+			name: "agent policies copy",
+			call: func(api *API) error {
+				_, err := api.Fleet.AgentPolicies.Copy(ctx, &FleetCopyAgentPolicyRequest{
+					ID:   "policy-1",
+					Body: FleetAgentPolicyCopyRequestBody{Name: "policy-1 (copy)"},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/agent_policies/policy-1/copy",
+			body:   map[string]interface{}{"name": "policy-1 (copy)"},
+		},
+		{
+			name: "agent policies delete",
+			call: func(api *API) error {
+				_, err := api.Fleet.AgentPolicies.Delete(ctx, &FleetDeleteAgentPolicyRequest{
+					Body: FleetDeleteAgentPolicyRequestBody{AgentPolicyId: "policy-1", Force: BoolPtr(true)},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/agent_policies/delete",
+			body:   map[string]interface{}{"agentPolicyId": "policy-1", "force": true},
+		},
+		{
+			name: "agent policies update",
+			call: func(api *API) error {
+				_, err := api.Fleet.AgentPolicies.Update(ctx, &FleetUpdateAgentPolicyRequest{
+					ID:   "policy-1",
+					Body: PutFleetAgentPolicyRequestBody{Name: "policy-1", Namespace: "default"},
+				})
+				return err
+			},
+			method: "PUT",
+			path:   "/api/fleet/agent_policies/policy-1",
+			body:   PutFleetAgentPolicyRequestBody{Name: "policy-1", Namespace: "default"},
+		},
+		{
+			name: "agents initiate setup",
+			call: func(api *API) error {
+				_, err := api.Fleet.Agents.InitiateSetup(ctx, &FleetInitiateSetupRequest{
+					Body: FleetInitiateSetupRequestBody{AdminUsername: "elastic", AdminPassword: "changeme"},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/agents/setup",
+			body:   map[string]interface{}{"admin_username": "elastic", "admin_password": "changeme"},
+		},
+		{
+			name: "agents list by action ID",
+			call: func(api *API) error {
+				_, err := api.Fleet.Agents.ListByActionID(ctx, &FleetListAgentsByActionIDRequest{
+					ActionIds: []string{"action-1"},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/agents",
+			body:   map[string]interface{}{"actionIds": []string{"action-1"}},
+		},
+		{
+			name: "agents update",
+			call: func(api *API) error {
+				_, err := api.Fleet.Agents.UpdateAgent(ctx, &FleetUpdateAgentRequest{
+					AgentID: "agent-1",
+					Body:    FleetUpdateAgentRequestBody{Tags: &[]string{"prod"}},
+				})
+				return err
+			},
+			method: "PUT",
+			path:   "/api/fleet/agents/agent-1",
+			body:   map[string]interface{}{"tags": []string{"prod"}},
+		},
+		{
+			name: "enrollment API keys create",
+			call: func(api *API) error {
+				_, err := api.Fleet.EnrollmentAPIKeys.Create(ctx, &FleetEnrollmentAPIKeysCreateRequest{
+					Body: FleetEnrollmentAPIKeysCreateRequestBody{Name: StrPtr("key-1"), PolicyID: "policy-1"},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/enrollment_api_keys",
+			body:   map[string]interface{}{"expiration": nil, "name": "key-1", "policy_id": "policy-1"},
+		},
+		{
+			name: "EPM authorize transforms",
+			call: func(api *API) error {
+				body := FleetEPMAuthorizeTransformsRequestBody{}
+				body.Transforms = append(body.Transforms, struct {
+					TransformId string `json:"transformId"`
+				}{TransformId: "transform-1"})
+				_, err := api.Fleet.EPM.AuthorizeTransforms(ctx, &FleetEPMAuthorizeTransformsRequest{
+					PackageName:    "nginx",
+					PackageVersion: StrPtr("1.2.0"),
+					Params:         FleetEPMAuthorizeTransformsRequestParams{Prerelease: BoolPtr(true)},
+					Body:           body,
+				})
+				return err
+			},
+			response: `[{"transformId":"transform-1","success":true}]`,
+			method:   "POST",
+			path:     "/api/fleet/epm/packages/nginx/1.2.0/transforms/authorize",
+			query:    map[string]string{"prerelease": "true"},
+			body:     map[string]interface{}{"transforms": []interface{}{map[string]interface{}{"transformId": "transform-1"}}},
+		},
+		{
+			name: "EPM bulk install packages",
+			call: func(api *API) error {
+				body := FleetEPMBulkInstallPackagesRequestBody{}
+				body.AddStringPackage("nginx")
+				_, err := api.Fleet.EPM.BulkInstallPackages(ctx, &FleetEPMBulkInstallPackagesRequest{
+					Params: FleetEPMBulkInstallPackagesRequestParams{Prerelease: BoolPtr(true)},
+					Body:   body,
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/epm/packages/_bulk",
+			query:  map[string]string{"prerelease": "true"},
+			body:   map[string]interface{}{"packages": []string{"nginx"}},
+		},
+		{
+			name: "EPM create custom integration",
+			call: func(api *API) error {
+				body := FleetEPMCreateCustomIntegrationRequestBody{IntegrationName: "my_app"}
+				body.Datasets = append(body.Datasets, struct {
+					Name string `json:"name"`
+					Type string `json:"type"`
+				}{Name: "my_app.access", Type: "logs"})
+				_, err := api.Fleet.EPM.CreateCustomIntegration(ctx, &FleetEPMCreateCustomIntegrationRequest{Body: body})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/epm/custom_integrations",
+			body: map[string]interface{}{
+				"integrationName": "my_app",
+				"datasets":        []interface{}{map[string]interface{}{"name": "my_app.access", "type": "logs"}},
+			},
+		},
+		{
+			name: "EPM install package from registry",
+			call: func(api *API) error {
+				_, err := api.Fleet.EPM.InstallPackageRegistry(ctx, &FleetEPMInstallPackageRegistryRequest{
+					PackageName:    "nginx",
+					PackageVersion: StrPtr("1.2.0"),
+					Params:         FleetEPMInstallPackageRegistryRequestParams{Prerelease: BoolPtr(true)},
+					Body:           FleetEPMInstallPackageRegistryRequestBody{Force: BoolPtr(true)},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/epm/packages/nginx/1.2.0",
+			query:  map[string]string{"prerelease": "true"},
+			body:   map[string]interface{}{"force": true},
+		},
+		{
+			name: "EPM update package settings",
+			call: func(api *API) error {
+				_, err := api.Fleet.EPM.UpdatePackageSettings(ctx, &FleetEPMUpdatePackageSettingsRequest{
+					PackageName: "nginx",
+					Body:        FleetEPMUpdatePackageSettingsRequestBody{KeepPoliciesUpToDate: true},
+				})
+				return err
+			},
+			method: "PUT",
+			path:   "/api/fleet/epm/packages/nginx",
+			body:   map[string]interface{}{"keepPoliciesUpToDate": true},
+		},
+		{
+			name: "internal check fleet server health",
+			call: func(api *API) error {
+				_, err := api.Fleet.Internal.CheckFleetServerHealth(ctx, &FleetInternalCheckFleetServerHealthRequest{
+					Body: FleetInternalCheckFleetServerHealthRequestBody{ID: "fleet-server-1"},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/health_check",
+			body:   map[string]interface{}{"id": "fleet-server-1"},
+		},
+		{
+			name: "internal check permissions",
+			call: func(api *API) error {
+				_, err := api.Fleet.Internal.CheckPermissions(ctx, &FleetInternalCheckPermissionsRequest{
+					Params: FleetInternalCheckPermissionsRequestParams{FleetServerSetup: BoolPtr(true)},
+				})
+				return err
+			},
+			method: "GET",
+			path:   "/api/fleet/check-permissions",
+			query:  map[string]string{"fleetServerSetup": "true"},
+		},
+		{
+			name: "message signing service rotate",
+			call: func(api *API) error {
+				_, err := api.Fleet.MessageSigningService.Rotate(ctx, &FleetMessageSigningServiceRotateRequest{
+					Params: FleetMessageSigningServiceRotateRequestParams{Acknowledge: BoolPtr(true)},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/fleet/message_signing_service/rotate_key_pair",
+			query:  map[string]string{"acknowledge": "true"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			response := tc.response
+			if response == "" {
+				response = `{}`
+			}
+			mockTransport := NewMockTransportWithRawResponse(200, response, nil)
+			api := New(mockTransport)
+
+			require.NoError(t, tc.call(api))
+
+			req := mockTransport.LastRequest()
+			AssertRequestMethod(t, req, tc.method)
+			AssertRequestPath(t, req, tc.path)
+			for key, value := range tc.query {
+				AssertRequestParam(t, req, key, value)
+			}
+			if tc.body != nil {
+				AssertRequestBodyJSON(t, req, tc.body)
+			}
+		})
+	}
+}
@@ -2,20 +2,14 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
+	"iter"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // FleetEnrollmentAPIKeysListResponse  wraps the response from a FleetBulkGetAgentPolicies call
-type FleetEnrollmentAPIKeysListResponse struct {
-	StatusCode int
-	Body       *FleetEnrollmentAPIKeysListResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetEnrollmentAPIKeysListResponse Response[FleetEnrollmentAPIKeysListResponseBody]
 
 type FleetEnrollmentAPIKeysListResponseBody struct {
 	Action  string             `json:"action"`
@@ -63,116 +57,49 @@ func (api *API) newFleetEnrollmentAPIKeysList() func(context.Context, *FleetEnro
 			req = &FleetEnrollmentAPIKeysListRequest{}
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.enrollment_api_keys.list")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/fleet/enrollment_api_keys"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Page != nil {
-			params["page"] = strconv.FormatFloat(float64(*req.Params.Page), 'f', -1, 32)
+			params.Set("page", strconv.FormatFloat(float64(*req.Params.Page), 'f', -1, 32))
 		}
 		if req.Params.PerPage != nil {
-			params["perPage"] = strconv.FormatFloat(float64(*req.Params.PerPage), 'f', -1, 32)
+			params.Set("perPage", strconv.FormatFloat(float64(*req.Params.PerPage), 'f', -1, 32))
 		}
 		if req.Params.Kuery != nil {
-			params["kuery"] = *req.Params.Kuery
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
+			params.Set("kuery", *req.Params.Kuery)
 		}
 
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.enrollment_api_keys.list")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.enrollment_api_keys.list", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
+		res, err := do[noBody, FleetEnrollmentAPIKeysListResponseBody](ctx, api, operation{
+			name:   "fleet.enrollment_api_keys.list",
+			method: http.MethodGet,
+			path:   "/api/fleet/enrollment_api_keys",
+			query:  params,
+		}, nil, opts)
+		return (*FleetEnrollmentAPIKeysListResponse)(res), err
+	}
+}
 
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
+// All returns an iterator over all enrollment API keys matching req. Pages of
+// req.Params.PerPage items, or DefaultPerPage when unset, are fetched on demand.
+func (e EnrollmentAPIKeys) All(ctx context.Context, req *FleetEnrollmentAPIKeysListRequest, opts ...RequestOption) iter.Seq2[EnrollmentApiKey, error] {
+	return items(e.Pages(ctx, req, opts...))
+}
 
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
+// Pages returns an iterator over the pages of enrollment API keys matching req.
+func (e EnrollmentAPIKeys) Pages(ctx context.Context, req *FleetEnrollmentAPIKeysListRequest, opts ...RequestOption) iter.Seq2[*Page[EnrollmentApiKey], error] {
+	var params FleetEnrollmentAPIKeysListRequestParams
+	if req != nil {
+		params = req.Params
+	}
 
+	return pages(ctx, float32Value(params.Page, 1), float32Value(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[EnrollmentApiKey], error) {
+		params.Page = Float32Ptr(float32(page))
+		params.PerPage = Float32Ptr(float32(perPage))
+		resp, err := e.List(ctx, &FleetEnrollmentAPIKeysListRequest{Params: params}, opts...)
 		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
 			return nil, err
 		}
-
-		// Prepare response
-		resp := &FleetEnrollmentAPIKeysListResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result FleetEnrollmentAPIKeysListResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
-
-	}
+		return &Page[EnrollmentApiKey]{Items: resp.Body.Items, Page: int(resp.Body.Page), PerPage: int(resp.Body.PerPage), Total: int(resp.Body.Total)}, nil
+	})
 }
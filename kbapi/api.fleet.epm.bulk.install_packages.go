@@ -1,22 +1,15 @@
 package kbapi
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // FleetEPMBulkInstallPackagesResponse wraps the response from a FleetEPMBulkInstallPackages  call
-type FleetEPMBulkInstallPackagesResponse struct {
-	StatusCode int
-	Body       *FleetEPMBulkInstallPackagesResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetEPMBulkInstallPackagesResponse Response[FleetEPMBulkInstallPackagesResponseBody]
 
 type FleetEPMBulkInstallPackagesResponseBody struct {
 }
@@ -77,117 +70,21 @@ func (api *API) newFleetEPMBulkInstallPackages() func(context.Context, *FleetEPM
 		if req == nil {
 			req = &FleetEPMBulkInstallPackagesRequest{}
 		}
-
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.epm.bulk.install_packages")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Prerelease != nil {
-			params["prerelease"] = strconv.FormatBool(*req.Params.Prerelease)
+			params.Set("prerelease", strconv.FormatBool(*req.Params.Prerelease))
 		}
 
 		path := "/api/fleet/epm/packages/_bulk"
 
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		jsonBody, err := json.Marshal(req.Body)
-		if err != nil {
-			return nil, err
-		}
-
-		httpReq.Body = io.NopCloser(bytes.NewReader(jsonBody))
-		httpReq.Header.Set("Content-Type", "application/json")
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.epm.bulk.install_packages")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.epm.bulk.install_packages", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &FleetEPMBulkInstallPackagesResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result FleetEPMBulkInstallPackagesResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[FleetEPMBulkInstallPackagesRequestBody, FleetEPMBulkInstallPackagesResponseBody](ctx, api, operation{
+			name:   "fleet.epm.bulk.install_packages",
+			method: http.MethodPost,
+			path:   path,
+			query:  params,
+		}, &req.Body, opts)
+		return (*FleetEPMBulkInstallPackagesResponse)(res), err
 	}
 }
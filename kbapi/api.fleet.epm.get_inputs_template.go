@@ -2,20 +2,14 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // FleetEPMGetInputsTemplateResponse wraps the response from a FleetEPMGetInputsTemplate  call
-type FleetEPMGetInputsTemplateResponse struct {
-	StatusCode int
-	Body       *FleetEPMGetInputsTemplateResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetEPMGetInputsTemplateResponse Response[FleetEPMGetInputsTemplateResponseBody]
 
 type FleetEPMGetInputsTemplateResponseBody struct {
 	Inputs []FleetEPMGetInputsTemplateResponseBodyInput `json:"inputs"`
@@ -58,118 +52,29 @@ func (api *API) newFleetEPMGetInputsTemplate() func(context.Context, *FleetEPMGe
 			return nil, fmt.Errorf("Required package name or version is not defined")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.epm.get_inputs_template")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
 		var path string
 
 		path = fmt.Sprintf("/api/fleet/epm/templates/%s/%s/inputs", req.PackageName, req.PackageVersion)
 
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.IgnoreUnverified != nil {
-			params["ignoreUnverified"] = strconv.FormatBool(*req.Params.IgnoreUnverified)
+			params.Set("ignoreUnverified", strconv.FormatBool(*req.Params.IgnoreUnverified))
 		}
 		if req.Params.Prerelease != nil {
-			params["prerelease"] = strconv.FormatBool(*req.Params.Prerelease)
+			params.Set("prerelease", strconv.FormatBool(*req.Params.Prerelease))
 		}
 		if req.Params.Format != nil {
-			params["format"] = *req.Params.Format
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.epm.get_inputs_template")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.epm.get_inputs_template", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &FleetEPMGetInputsTemplateResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result FleetEPMGetInputsTemplateResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
+			params.Set("format", *req.Params.Format)
 		}
 
+		res, err := do[noBody, FleetEPMGetInputsTemplateResponseBody](ctx, api, operation{
+			name:   "fleet.epm.get_inputs_template",
+			method: http.MethodGet,
+			path:   path,
+			query:  params,
+		}, nil, opts)
+		return (*FleetEPMGetInputsTemplateResponse)(res), err
 	}
 }
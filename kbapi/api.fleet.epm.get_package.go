@@ -2,20 +2,14 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // FleetEPMGetPackageResponse wraps the response from a FleetEPMGetPackage  call
-type FleetEPMGetPackageResponse struct {
-	StatusCode int
-	Body       *FleetEPMGetPackageResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetEPMGetPackageResponse Response[FleetEPMGetPackageResponseBody]
 
 type FleetEPMGetPackageResponseBody struct {
 	Item PackageInfo `json:"item"`
@@ -42,20 +36,6 @@ func (api *API) newFleetEPMGetPackage() func(context.Context, *FleetEPMGetPackag
 			return nil, fmt.Errorf("Required package name is not defined")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.epm.get_package")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
 		var path string
 
 		if req.PackageVersion != nil {
@@ -65,102 +45,27 @@ func (api *API) newFleetEPMGetPackage() func(context.Context, *FleetEPMGetPackag
 		}
 		//
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.IgnoreUnverified != nil {
-			params["ignoreUnverified"] = strconv.FormatBool(*req.Params.IgnoreUnverified)
+			params.Set("ignoreUnverified", strconv.FormatBool(*req.Params.IgnoreUnverified))
 		}
 		if req.Params.Prerelease != nil {
-			params["prerelease"] = strconv.FormatBool(*req.Params.Prerelease)
+			params.Set("prerelease", strconv.FormatBool(*req.Params.Prerelease))
 		}
 		if req.Params.Full != nil {
-			params["full"] = strconv.FormatBool(*req.Params.Full)
+			params.Set("full", strconv.FormatBool(*req.Params.Full))
 		}
 		if req.Params.WithMetadata != nil {
-			params["withMetadata"] = strconv.FormatBool(*req.Params.WithMetadata)
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.epm.get_package")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.epm.get_package", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &FleetEPMGetPackageResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result FleetEPMGetPackageResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
+			params.Set("withMetadata", strconv.FormatBool(*req.Params.WithMetadata))
 		}
 
+		res, err := do[noBody, FleetEPMGetPackageResponseBody](ctx, api, operation{
+			name:   "fleet.epm.get_package",
+			method: http.MethodGet,
+			path:   path,
+			query:  params,
+		}, nil, opts)
+		return (*FleetEPMGetPackageResponse)(res), err
 	}
 }
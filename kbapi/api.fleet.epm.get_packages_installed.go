@@ -4,19 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 )
 
 // FleetEPMGetInstalledPackagesResponse wraps the response from a FleetEPMGetInstalledPackages  call
-type FleetEPMGetInstalledPackagesResponse struct {
-	StatusCode int
-	Body       *FleetEPMGetInstalledPackagesResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetEPMGetInstalledPackagesResponse Response[FleetEPMGetInstalledPackagesResponseBody]
 
 type FleetEPMGetInstalledPackagesResponseBody struct {
 	Items []struct {
@@ -66,30 +61,16 @@ func (api *API) newFleetEPMGetInstalledPackages() func(context.Context, *FleetEP
 			req = &FleetEPMGetInstalledPackagesRequest{}
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.epm.get_packages_installed")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.DataStreamType != nil {
-			params["dataStreamType"] = *req.Params.DataStreamType
+			params.Set("dataStreamType", *req.Params.DataStreamType)
 		}
 		if req.Params.ShowOnlyActiveDataStreams != nil {
-			params["showOnlyActiveDataStreams"] = strconv.FormatBool(*req.Params.ShowOnlyActiveDataStreams)
+			params.Set("showOnlyActiveDataStreams", strconv.FormatBool(*req.Params.ShowOnlyActiveDataStreams))
 		}
 		if req.Params.NameQuery != nil {
-			params["nameQuery"] = *req.Params.NameQuery
+			params.Set("nameQuery", *req.Params.NameQuery)
 		}
 		if req.Params.SearchAfter != nil {
 			var searchAfterVal []string
@@ -114,99 +95,24 @@ func (api *API) newFleetEPMGetInstalledPackages() func(context.Context, *FleetEP
 					searchAfterVal = append(searchAfterVal, string(bytes))
 				}
 			}
-			params["searchAfter"] = strings.Join(searchAfterVal, ",")
+			params.Set("searchAfter", strings.Join(searchAfterVal, ","))
 		}
 
 		if req.Params.PerPage != nil {
-			params["perPage"] = strconv.FormatFloat(float64(*req.Params.PerPage), 'f', -1, 32)
+			params.Set("perPage", strconv.FormatFloat(float64(*req.Params.PerPage), 'f', -1, 32))
 		}
 		if req.Params.SortOrder != nil {
-			params["sortOrder"] = *req.Params.SortOrder
+			params.Set("sortOrder", *req.Params.SortOrder)
 		}
 
 		path := "/api/fleet/epm/packages/installed"
 
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.epm.get_packages_installed")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.epm.get_packages_installed", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &FleetEPMGetInstalledPackagesResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result FleetEPMGetInstalledPackagesResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
-
+		res, err := do[noBody, FleetEPMGetInstalledPackagesResponseBody](ctx, api, operation{
+			name:   "fleet.epm.get_packages_installed",
+			method: http.MethodGet,
+			path:   path,
+			query:  params,
+		}, nil, opts)
+		return (*FleetEPMGetInstalledPackagesResponse)(res), err
 	}
 }
@@ -1,22 +1,15 @@
 package kbapi
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // FleetEPMInstallPackageRegistryResponse wraps the response from a FleetEPMInstallPackageRegistry  call
-type FleetEPMInstallPackageRegistryResponse struct {
-	StatusCode int
-	Body       *FleetEPMInstallPackageRegistryResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetEPMInstallPackageRegistryResponse Response[FleetEPMInstallPackageRegistryResponseBody]
 
 type FleetEPMInstallPackageRegistryResponseBody struct {
 	Meta struct {
@@ -50,29 +43,17 @@ func (api *API) newFleetEPMInstallPackageRegistry() func(context.Context, *Fleet
 			return nil, fmt.Errorf("request cannot be empty")
 		}
 
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.epm.install_package_registry")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.IgnoreMappingUpdateErrors != nil {
-			params["ignoreMappingUpdateErrors"] = strconv.FormatBool(*req.Params.IgnoreMappingUpdateErrors)
+			params.Set("ignoreMappingUpdateErrors", strconv.FormatBool(*req.Params.IgnoreMappingUpdateErrors))
 		}
 		if req.Params.SkipDataStreamRollover != nil {
-			params["skipDataStreamRollover"] = strconv.FormatBool(*req.Params.SkipDataStreamRollover)
+			params.Set("skipDataStreamRollover", strconv.FormatBool(*req.Params.SkipDataStreamRollover))
 		}
 		if req.Params.Prerelease != nil {
-			params["prerelease"] = strconv.FormatBool(*req.Params.Prerelease)
+			params.Set("prerelease", strconv.FormatBool(*req.Params.Prerelease))
 		}
 
 		var path string
@@ -82,94 +63,12 @@ func (api *API) newFleetEPMInstallPackageRegistry() func(context.Context, *Fleet
 			path = fmt.Sprintf("/api/fleet/epm/packages/%s", req.PackageName)
 		}
 
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		jsonBody, err := json.Marshal(req.Body)
-		if err != nil {
-			return nil, err
-		}
-
-		httpReq.Body = io.NopCloser(bytes.NewReader(jsonBody))
-		httpReq.Header.Set("Content-Type", "application/json")
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.epm.install_package_registry")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.epm.install_package_registry", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &FleetEPMInstallPackageRegistryResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result FleetEPMInstallPackageRegistryResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[FleetEPMInstallPackageRegistryRequestBody, FleetEPMInstallPackageRegistryResponseBody](ctx, api, operation{
+			name:   "fleet.epm.install_package_registry",
+			method: http.MethodPost,
+			path:   path,
+			query:  params,
+		}, &req.Body, opts)
+		return (*FleetEPMInstallPackageRegistryResponse)(res), err
 	}
 }
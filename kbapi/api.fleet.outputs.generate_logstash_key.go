@@ -2,20 +2,12 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
 )
 
 // TODO: Update the call
 // FleetOutputsGenerateLogstashKeyResponse wraps the response from a <todo> call
-type FleetOutputsGenerateLogstashKeyResponse struct {
-	StatusCode int
-	Body       *FleetOutputsGenerateLogstashKeyResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetOutputsGenerateLogstashKeyResponse Response[FleetOutputsGenerateLogstashKeyResponseBody]
 
 type FleetOutputsGenerateLogstashKeyResponseBody struct {
 	APIKey string `json:"api_key"`
@@ -24,111 +16,11 @@ type FleetOutputsGenerateLogstashKeyResponseBody struct {
 // newFleetOutputsGenerateLogstashKey returns a function that performs POST /api/fleet/logstash_api_keys API requests
 func (api *API) newFleetOutputsGenerateLogstashKey() func(context.Context, ...RequestOption) (*FleetOutputsGenerateLogstashKeyResponse, error) {
 	return func(ctx context.Context, opts ...RequestOption) (*FleetOutputsGenerateLogstashKeyResponse, error) {
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.outputs.generate_logstash_key")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/fleet/logstash_api_keys"
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.outputs.generate_logstash_key")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.outputs.generate_logstash_key", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &FleetOutputsGenerateLogstashKeyResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result FleetOutputsGenerateLogstashKeyResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, FleetOutputsGenerateLogstashKeyResponseBody](ctx, api, operation{
+			name:   "fleet.outputs.generate_logstash_key",
+			method: http.MethodPost,
+			path:   "/api/fleet/logstash_api_keys",
+		}, nil, opts)
+		return (*FleetOutputsGenerateLogstashKeyResponse)(res), err
 	}
 }
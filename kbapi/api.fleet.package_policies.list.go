@@ -2,21 +2,15 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
+	"iter"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // TODO: Update the call
 // FleetPackagePoliciesListResponse wraps the response from a <todo> call
-type FleetPackagePoliciesListResponse struct {
-	StatusCode int
-	Body       *FleetPackagePoliciesListResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetPackagePoliciesListResponse Response[FleetPackagePoliciesListResponseBody]
 
 type FleetPackagePoliciesListResponseBody struct {
 	Items   []PackagePolicy `json:"items"`
@@ -49,149 +43,65 @@ func (api *API) newFleetPackagePoliciesList() func(context.Context, *FleetPackag
 			req = &FleetPackagePoliciesListRequest{}
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.package_policies.list")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/fleet/package_policies"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Page != nil {
-			params["page"] = strconv.FormatFloat(float64(*req.Params.Page), 'f', -1, 32)
+			params.Set("page", strconv.FormatFloat(float64(*req.Params.Page), 'f', -1, 32))
 		}
 		if req.Params.PerPage != nil {
-			params["perPage"] = strconv.FormatFloat(float64(*req.Params.PerPage), 'f', -1, 32)
+			params.Set("perPage", strconv.FormatFloat(float64(*req.Params.PerPage), 'f', -1, 32))
 		}
 		if req.Params.Kuery != nil {
-			params["kuery"] = *req.Params.Kuery
+			params.Set("kuery", *req.Params.Kuery)
 		}
 		if req.Params.SortOrder != nil {
-			params["sortOrder"] = *req.Params.SortOrder
+			params.Set("sortOrder", *req.Params.SortOrder)
 		}
 		if req.Params.SortField != nil {
-			params["sortField"] = *req.Params.SortField
+			params.Set("sortField", *req.Params.SortField)
 		}
 		if req.Params.Format != nil {
-			params["format"] = *req.Params.Format
+			params.Set("format", *req.Params.Format)
 		}
 		if req.Params.ShowUpgradeable != nil {
-			params["showUpgradeable"] = strconv.FormatBool(*req.Params.ShowUpgradeable)
+			params.Set("showUpgradeable", strconv.FormatBool(*req.Params.ShowUpgradeable))
 		}
 
 		if req.Params.WithAgentCount != nil {
-			params["withAgentCount"] = strconv.FormatBool(*req.Params.WithAgentCount)
+			params.Set("withAgentCount", strconv.FormatBool(*req.Params.WithAgentCount))
 		}
 
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.package_policies.list")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.package_policies.list", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
+		res, err := do[noBody, FleetPackagePoliciesListResponseBody](ctx, api, operation{
+			name:   "fleet.package_policies.list",
+			method: http.MethodGet,
+			path:   "/api/fleet/package_policies",
+			query:  params,
+		}, nil, opts)
+		return (*FleetPackagePoliciesListResponse)(res), err
+	}
+}
 
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
+// All returns an iterator over all package policies matching req. Pages of
+// req.Params.PerPage items, or DefaultPerPage when unset, are fetched on demand.
+func (p PackagePolicies) All(ctx context.Context, req *FleetPackagePoliciesListRequest, opts ...RequestOption) iter.Seq2[PackagePolicy, error] {
+	return items(p.Pages(ctx, req, opts...))
+}
 
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
+// Pages returns an iterator over the pages of package policies matching req.
+func (p PackagePolicies) Pages(ctx context.Context, req *FleetPackagePoliciesListRequest, opts ...RequestOption) iter.Seq2[*Page[PackagePolicy], error] {
+	var params FleetPackagePoliciesListRequestParams
+	if req != nil {
+		params = req.Params
+	}
 
+	return pages(ctx, float32Value(params.Page, 1), float32Value(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[PackagePolicy], error) {
+		params.Page = Float32Ptr(float32(page))
+		params.PerPage = Float32Ptr(float32(perPage))
+		resp, err := p.List(ctx, &FleetPackagePoliciesListRequest{Params: params}, opts...)
 		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
 			return nil, err
 		}
-
-		// Prepare response
-		resp := &FleetPackagePoliciesListResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result FleetPackagePoliciesListResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
-	}
+		return &Page[PackagePolicy]{Items: resp.Body.Items, Page: int(resp.Body.Page), PerPage: int(resp.Body.PerPage), Total: int(resp.Body.Total)}, nil
+	})
 }
@@ -2,20 +2,12 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
 )
 
 // TODO: Update the call
 // FleetUninstallTokensGetMetadataResponse wraps the response from a <todo> call
-type FleetUninstallTokensGetMetadataResponse struct {
-	StatusCode int
-	Body       *FleetUninstallTokensGetMetadataResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type FleetUninstallTokensGetMetadataResponse Response[FleetUninstallTokensGetMetadataResponseBody]
 
 type FleetUninstallTokensGetMetadataResponseBody struct {
 	Items []struct {
@@ -33,112 +25,11 @@ type FleetUninstallTokensGetMetadataResponseBody struct {
 // newFleetUninstallTokensGetMetadata returns a function that performs GET /api/fleet/uninstall_tokens API requests
 func (api *API) newFleetUninstallTokensGetMetadata() func(context.Context, ...RequestOption) (*FleetUninstallTokensGetMetadataResponse, error) {
 	return func(ctx context.Context, opts ...RequestOption) (*FleetUninstallTokensGetMetadataResponse, error) {
-
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "fleet.uninstall_tokens.get_metadata")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/fleet/uninstall_tokens"
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "fleet.uninstall_tokens.get_metadata")
-			if reader := instrument.RecordRequestBody(ctx, "fleet.uninstall_tokens.get_metadata", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &FleetUninstallTokensGetMetadataResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result FleetUninstallTokensGetMetadataResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, FleetUninstallTokensGetMetadataResponseBody](ctx, api, operation{
+			name:   "fleet.uninstall_tokens.get_metadata",
+			method: http.MethodGet,
+			path:   "/api/fleet/uninstall_tokens",
+		}, nil, opts)
+		return (*FleetUninstallTokensGetMetadataResponse)(res), err
 	}
 }
@@ -0,0 +1,30 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ListsCreateResponse wraps the response from a Lists.Create call
+type ListsCreateResponse Response[SecurityListsAPIList]
+
+type ListsCreateRequest struct {
+	Body CreateListJSONRequestBody
+}
+
+// newListsCreate returns a function that performs POST /api/lists API requests
+func (api *API) newListsCreate() func(context.Context, *ListsCreateRequest, ...RequestOption) (*ListsCreateResponse, error) {
+	return func(ctx context.Context, req *ListsCreateRequest, opts ...RequestOption) (*ListsCreateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[CreateListJSONRequestBody, SecurityListsAPIList](ctx, api, operation{
+			name:   "lists.create",
+			method: http.MethodPost,
+			path:   "/api/lists",
+		}, &req.Body, opts)
+		return (*ListsCreateResponse)(res), err
+	}
+}
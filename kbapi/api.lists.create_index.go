@@ -0,0 +1,21 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListsCreateIndexResponse wraps the response from a Lists.CreateIndex call
+type ListsCreateIndexResponse Response[CreateListIndexResponse]
+
+// newListsCreateIndex returns a function that performs POST /api/lists/index API requests
+func (api *API) newListsCreateIndex() func(context.Context, ...RequestOption) (*ListsCreateIndexResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*ListsCreateIndexResponse, error) {
+		res, err := do[noBody, CreateListIndexResponse](ctx, api, operation{
+			name:   "lists.create_index",
+			method: http.MethodPost,
+			path:   "/api/lists/index",
+		}, nil, opts)
+		return (*ListsCreateIndexResponse)(res), err
+	}
+}
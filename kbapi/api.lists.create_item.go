@@ -0,0 +1,30 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ListsCreateItemResponse wraps the response from a Lists.CreateItem call
+type ListsCreateItemResponse Response[SecurityListsAPIListItem]
+
+type ListsCreateItemRequest struct {
+	Body CreateListItemJSONRequestBody
+}
+
+// newListsCreateItem returns a function that performs POST /api/lists/items API requests
+func (api *API) newListsCreateItem() func(context.Context, *ListsCreateItemRequest, ...RequestOption) (*ListsCreateItemResponse, error) {
+	return func(ctx context.Context, req *ListsCreateItemRequest, opts ...RequestOption) (*ListsCreateItemResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[CreateListItemJSONRequestBody, SecurityListsAPIListItem](ctx, api, operation{
+			name:   "lists.create_item",
+			method: http.MethodPost,
+			path:   "/api/lists/items",
+		}, &req.Body, opts)
+		return (*ListsCreateItemResponse)(res), err
+	}
+}
@@ -0,0 +1,46 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListsDeleteResponse wraps the response from a Lists.Delete call
+type ListsDeleteResponse Response[SecurityListsAPIList]
+
+type ListsDeleteRequest struct {
+	Params DeleteListParams
+}
+
+// newListsDelete returns a function that performs DELETE /api/lists API requests
+func (api *API) newListsDelete() func(context.Context, *ListsDeleteRequest, ...RequestOption) (*ListsDeleteResponse, error) {
+	return func(ctx context.Context, req *ListsDeleteRequest, opts ...RequestOption) (*ListsDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Id != "" {
+			params.Set("id", req.Params.Id)
+		}
+		if req.Params.DeleteReferences != nil {
+			params.Set("deleteReferences", strconv.FormatBool(*req.Params.DeleteReferences))
+		}
+		if req.Params.IgnoreReferences != nil {
+			params.Set("ignoreReferences", strconv.FormatBool(*req.Params.IgnoreReferences))
+		}
+
+		res, err := do[noBody, SecurityListsAPIList](ctx, api, operation{
+			name:   "lists.delete",
+			method: http.MethodDelete,
+			path:   "/api/lists",
+			query:  params,
+		}, nil, opts)
+		return (*ListsDeleteResponse)(res), err
+	}
+}
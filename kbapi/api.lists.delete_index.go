@@ -0,0 +1,21 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListsDeleteIndexResponse wraps the response from a Lists.DeleteIndex call
+type ListsDeleteIndexResponse Response[DeleteListIndexResponse]
+
+// newListsDeleteIndex returns a function that performs DELETE /api/lists/index API requests
+func (api *API) newListsDeleteIndex() func(context.Context, ...RequestOption) (*ListsDeleteIndexResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*ListsDeleteIndexResponse, error) {
+		res, err := do[noBody, DeleteListIndexResponse](ctx, api, operation{
+			name:   "lists.delete_index",
+			method: http.MethodDelete,
+			path:   "/api/lists/index",
+		}, nil, opts)
+		return (*ListsDeleteIndexResponse)(res), err
+	}
+}
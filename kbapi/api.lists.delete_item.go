@@ -0,0 +1,48 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ListsDeleteItemResponse wraps the response from a Lists.DeleteItem call
+type ListsDeleteItemResponse Response[DeleteListItemResponse]
+
+type ListsDeleteItemRequest struct {
+	Params DeleteListItemParams
+}
+
+// newListsDeleteItem returns a function that performs DELETE /api/lists/items API requests
+func (api *API) newListsDeleteItem() func(context.Context, *ListsDeleteItemRequest, ...RequestOption) (*ListsDeleteItemResponse, error) {
+	return func(ctx context.Context, req *ListsDeleteItemRequest, opts ...RequestOption) (*ListsDeleteItemResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Id != nil {
+			params.Set("id", *req.Params.Id)
+		}
+		if req.Params.ListId != nil {
+			params.Set("list_id", *req.Params.ListId)
+		}
+		if req.Params.Value != nil {
+			params.Set("value", *req.Params.Value)
+		}
+		if req.Params.Refresh != nil {
+			params.Set("refresh", *req.Params.Refresh)
+		}
+
+		res, err := do[noBody, DeleteListItemResponse](ctx, api, operation{
+			name:   "lists.delete_item",
+			method: http.MethodDelete,
+			path:   "/api/lists/items",
+			query:  params,
+		}, nil, opts)
+		return (*ListsDeleteItemResponse)(res), err
+	}
+}
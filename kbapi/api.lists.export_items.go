@@ -0,0 +1,62 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// ListsExportItemsResponse wraps the response from a Lists.ExportItems call
+type ListsExportItemsResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type ListsExportItemsRequestBody struct {
+	// Output receives the exported list items, one per line, as they are read
+	Output io.Writer
+}
+
+type ListsExportItemsRequest struct {
+	Params ExportListItemsParams
+	Body   ListsExportItemsRequestBody
+}
+
+// newListsExportItems returns a function that performs POST /api/lists/items/_export API requests
+func (api *API) newListsExportItems() func(context.Context, *ListsExportItemsRequest, ...RequestOption) (*ListsExportItemsResponse, error) {
+	return func(ctx context.Context, req *ListsExportItemsRequest, opts ...RequestOption) (*ListsExportItemsResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+		if req.Body.Output == nil {
+			return nil, fmt.Errorf("Output cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.ListId != "" {
+			params.Set("list_id", req.Params.ListId)
+		}
+
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:   "lists.export_items",
+			method: http.MethodPost,
+			path:   "/api/lists/items/_export",
+			query:  params,
+			output: req.Body.Output,
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &ListsExportItemsResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
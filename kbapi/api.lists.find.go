@@ -0,0 +1,55 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListsFindResponse wraps the response from a Lists.Find call
+type ListsFindResponse Response[FindListsResponse]
+
+type ListsFindRequest struct {
+	Params FindListsParams
+}
+
+// newListsFind returns a function that performs GET /api/lists/_find API requests
+func (api *API) newListsFind() func(context.Context, *ListsFindRequest, ...RequestOption) (*ListsFindResponse, error) {
+	return func(ctx context.Context, req *ListsFindRequest, opts ...RequestOption) (*ListsFindResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Page != nil {
+			params.Set("page", strconv.Itoa(*req.Params.Page))
+		}
+		if req.Params.PerPage != nil {
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
+		}
+		if req.Params.SortField != nil {
+			params.Set("sort_field", *req.Params.SortField)
+		}
+		if req.Params.SortOrder != nil {
+			params.Set("sort_order", *req.Params.SortOrder)
+		}
+		if req.Params.Cursor != nil {
+			params.Set("cursor", *req.Params.Cursor)
+		}
+		if req.Params.Filter != nil {
+			params.Set("filter", *req.Params.Filter)
+		}
+
+		res, err := do[noBody, FindListsResponse](ctx, api, operation{
+			name:   "lists.find",
+			method: http.MethodGet,
+			path:   "/api/lists/_find",
+			query:  params,
+		}, nil, opts)
+		return (*ListsFindResponse)(res), err
+	}
+}
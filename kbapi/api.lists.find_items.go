@@ -0,0 +1,58 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListsFindItemsResponse wraps the response from a Lists.FindItems call
+type ListsFindItemsResponse Response[FindListItemsResponse]
+
+type ListsFindItemsRequest struct {
+	Params FindListItemsParams
+}
+
+// newListsFindItems returns a function that performs GET /api/lists/items/_find API requests
+func (api *API) newListsFindItems() func(context.Context, *ListsFindItemsRequest, ...RequestOption) (*ListsFindItemsResponse, error) {
+	return func(ctx context.Context, req *ListsFindItemsRequest, opts ...RequestOption) (*ListsFindItemsResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.ListId != "" {
+			params.Set("list_id", req.Params.ListId)
+		}
+		if req.Params.Page != nil {
+			params.Set("page", strconv.Itoa(*req.Params.Page))
+		}
+		if req.Params.PerPage != nil {
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
+		}
+		if req.Params.SortField != nil {
+			params.Set("sort_field", *req.Params.SortField)
+		}
+		if req.Params.SortOrder != nil {
+			params.Set("sort_order", *req.Params.SortOrder)
+		}
+		if req.Params.Cursor != nil {
+			params.Set("cursor", *req.Params.Cursor)
+		}
+		if req.Params.Filter != nil {
+			params.Set("filter", *req.Params.Filter)
+		}
+
+		res, err := do[noBody, FindListItemsResponse](ctx, api, operation{
+			name:   "lists.find_items",
+			method: http.MethodGet,
+			path:   "/api/lists/items/_find",
+			query:  params,
+		}, nil, opts)
+		return (*ListsFindItemsResponse)(res), err
+	}
+}
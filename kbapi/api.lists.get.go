@@ -0,0 +1,39 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ListsGetResponse wraps the response from a Lists.Get call
+type ListsGetResponse Response[SecurityListsAPIList]
+
+type ListsGetRequest struct {
+	Params ReadListParams
+}
+
+// newListsGet returns a function that performs GET /api/lists API requests
+func (api *API) newListsGet() func(context.Context, *ListsGetRequest, ...RequestOption) (*ListsGetResponse, error) {
+	return func(ctx context.Context, req *ListsGetRequest, opts ...RequestOption) (*ListsGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Id != "" {
+			params.Set("id", req.Params.Id)
+		}
+
+		res, err := do[noBody, SecurityListsAPIList](ctx, api, operation{
+			name:   "lists.get",
+			method: http.MethodGet,
+			path:   "/api/lists",
+			query:  params,
+		}, nil, opts)
+		return (*ListsGetResponse)(res), err
+	}
+}
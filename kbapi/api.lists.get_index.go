@@ -0,0 +1,21 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListsGetIndexResponse wraps the response from a Lists.GetIndex call
+type ListsGetIndexResponse Response[ReadListIndexResponse]
+
+// newListsGetIndex returns a function that performs GET /api/lists/index API requests
+func (api *API) newListsGetIndex() func(context.Context, ...RequestOption) (*ListsGetIndexResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*ListsGetIndexResponse, error) {
+		res, err := do[noBody, ReadListIndexResponse](ctx, api, operation{
+			name:   "lists.get_index",
+			method: http.MethodGet,
+			path:   "/api/lists/index",
+		}, nil, opts)
+		return (*ListsGetIndexResponse)(res), err
+	}
+}
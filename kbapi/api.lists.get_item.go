@@ -0,0 +1,45 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ListsGetItemResponse wraps the response from a Lists.GetItem call
+type ListsGetItemResponse Response[ReadListItemResponse]
+
+type ListsGetItemRequest struct {
+	Params ReadListItemParams
+}
+
+// newListsGetItem returns a function that performs GET /api/lists/items API requests
+func (api *API) newListsGetItem() func(context.Context, *ListsGetItemRequest, ...RequestOption) (*ListsGetItemResponse, error) {
+	return func(ctx context.Context, req *ListsGetItemRequest, opts ...RequestOption) (*ListsGetItemResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Id != nil {
+			params.Set("id", *req.Params.Id)
+		}
+		if req.Params.ListId != nil {
+			params.Set("list_id", *req.Params.ListId)
+		}
+		if req.Params.Value != nil {
+			params.Set("value", *req.Params.Value)
+		}
+
+		res, err := do[noBody, ReadListItemResponse](ctx, api, operation{
+			name:   "lists.get_item",
+			method: http.MethodGet,
+			path:   "/api/lists/items",
+			query:  params,
+		}, nil, opts)
+		return (*ListsGetItemResponse)(res), err
+	}
+}
@@ -0,0 +1,21 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListsGetPrivilegesResponse wraps the response from a Lists.GetPrivileges call
+type ListsGetPrivilegesResponse Response[ReadListPrivilegesResponse]
+
+// newListsGetPrivileges returns a function that performs GET /api/lists/privileges API requests
+func (api *API) newListsGetPrivileges() func(context.Context, ...RequestOption) (*ListsGetPrivilegesResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*ListsGetPrivilegesResponse, error) {
+		res, err := do[noBody, ReadListPrivilegesResponse](ctx, api, operation{
+			name:   "lists.get_privileges",
+			method: http.MethodGet,
+			path:   "/api/lists/privileges",
+		}, nil, opts)
+		return (*ListsGetPrivilegesResponse)(res), err
+	}
+}
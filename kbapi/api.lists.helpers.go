@@ -0,0 +1,113 @@
+package kbapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"iter"
+)
+
+// listItems decodes a response that is either a single list item or an array of list items.
+func listItems(union json.RawMessage) ([]SecurityListsAPIListItem, error) {
+	if len(bytes.TrimSpace(union)) == 0 {
+		return nil, nil
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(union), []byte("[")) {
+		var items []SecurityListsAPIListItem
+		err := json.Unmarshal(union, &items)
+		return items, err
+	}
+
+	var item SecurityListsAPIListItem
+	if err := json.Unmarshal(union, &item); err != nil {
+		return nil, err
+	}
+	return []SecurityListsAPIListItem{item}, nil
+}
+
+// GetItems returns the list items. Kibana returns a single item when it is
+// read by ID and every matching item when it is read by list ID and value.
+func (t ReadListItemResponse) GetItems() ([]SecurityListsAPIListItem, error) {
+	return listItems(t.union)
+}
+
+func (t ReadListItemResponse) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *ReadListItemResponse) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+// GetItems returns the deleted list items. Kibana returns a single item when
+// it is deleted by ID and every matching item when it is deleted by list ID
+// and value.
+func (t DeleteListItemResponse) GetItems() ([]SecurityListsAPIListItem, error) {
+	return listItems(t.union)
+}
+
+func (t DeleteListItemResponse) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *DeleteListItemResponse) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+// All returns an iterator over all value lists matching req. Pages of
+// req.Params.PerPage items, or DefaultPerPage when unset, are fetched on demand.
+func (l Lists) All(ctx context.Context, req *ListsFindRequest, opts ...RequestOption) iter.Seq2[SecurityListsAPIList, error] {
+	return items(l.Pages(ctx, req, opts...))
+}
+
+// Pages returns an iterator over the pages of value lists matching req.
+// Each page is requested with the cursor returned by the previous one.
+func (l Lists) Pages(ctx context.Context, req *ListsFindRequest, opts ...RequestOption) iter.Seq2[*Page[SecurityListsAPIList], error] {
+	var params FindListsParams
+	if req != nil {
+		params = req.Params
+	}
+
+	return pages(ctx, intValue(params.Page, 1), intValue(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[SecurityListsAPIList], error) {
+		params.Page = IntPtr(page)
+		params.PerPage = IntPtr(perPage)
+		resp, err := l.Find(ctx, &ListsFindRequest{Params: params}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Body.Cursor != "" {
+			params.Cursor = StrPtr(resp.Body.Cursor)
+		}
+		return &Page[SecurityListsAPIList]{Items: resp.Body.Data, Page: resp.Body.Page, PerPage: resp.Body.PerPage, Total: resp.Body.Total}, nil
+	})
+}
+
+// AllItems returns an iterator over all items of the value list in req.
+// Pages of req.Params.PerPage items, or DefaultPerPage when unset, are fetched
+// on demand, so lists of any size can be walked with bounded memory.
+func (l Lists) AllItems(ctx context.Context, req *ListsFindItemsRequest, opts ...RequestOption) iter.Seq2[SecurityListsAPIListItem, error] {
+	return items(l.ItemPages(ctx, req, opts...))
+}
+
+// ItemPages returns an iterator over the pages of items of the value list in
+// req. Each page is requested with the cursor returned by the previous one.
+func (l Lists) ItemPages(ctx context.Context, req *ListsFindItemsRequest, opts ...RequestOption) iter.Seq2[*Page[SecurityListsAPIListItem], error] {
+	var params FindListItemsParams
+	if req != nil {
+		params = req.Params
+	}
+
+	return pages(ctx, intValue(params.Page, 1), intValue(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[SecurityListsAPIListItem], error) {
+		params.Page = IntPtr(page)
+		params.PerPage = IntPtr(perPage)
+		resp, err := l.FindItems(ctx, &ListsFindItemsRequest{Params: params}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Body.Cursor != "" {
+			params.Cursor = StrPtr(resp.Body.Cursor)
+		}
+		return &Page[SecurityListsAPIListItem]{Items: resp.Body.Data, Page: resp.Body.Page, PerPage: resp.Body.PerPage, Total: resp.Body.Total}, nil
+	})
+}
@@ -0,0 +1,79 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// ListsImportItemsResponse wraps the response from a Lists.ImportItems call
+type ListsImportItemsResponse Response[SecurityListsAPIList]
+
+type ListsImportItemsRequestBody struct {
+	// File is the content of a `.txt` or `.csv` file with one list item per
+	// line. It is streamed to Kibana as it is read. When File is also an
+	// io.Seeker, it is rewound if the request is retried; otherwise the
+	// transport buffers it unless retries are disabled.
+	File io.Reader
+	// FileName is the name of the uploaded file. When importing into a new
+	// list, Kibana uses it as the list ID and name. Defaults to "list_items.txt".
+	FileName string
+}
+
+type ListsImportItemsRequest struct {
+	Params ImportListItemsParams
+	Body   ListsImportItemsRequestBody
+}
+
+// newListsImportItems returns a function that performs POST /api/lists/items/_import API requests
+func (api *API) newListsImportItems() func(context.Context, *ListsImportItemsRequest, ...RequestOption) (*ListsImportItemsResponse, error) {
+	return func(ctx context.Context, req *ListsImportItemsRequest, opts ...RequestOption) (*ListsImportItemsResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+		if req.Body.File == nil {
+			return nil, fmt.Errorf("File cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.ListId != nil {
+			params.Set("list_id", *req.Params.ListId)
+		}
+		if req.Params.Type != nil {
+			params.Set("type", *req.Params.Type)
+		}
+		if req.Params.Serializer != nil {
+			params.Set("serializer", *req.Params.Serializer)
+		}
+		if req.Params.Deserializer != nil {
+			params.Set("deserializer", *req.Params.Deserializer)
+		}
+		if req.Params.Refresh != nil {
+			params.Set("refresh", *req.Params.Refresh)
+		}
+
+		fileName := req.Body.FileName
+		if fileName == "" {
+			fileName = "list_items.txt"
+		}
+
+		// Stream the file as multipart form data
+		body, contentType, getBody := streamMultipartFile(fileName, req.Body.File)
+		defer body.Close()
+
+		res, err := do[noBody, SecurityListsAPIList](ctx, api, operation{
+			name:        "lists.import_items",
+			method:      http.MethodPost,
+			path:        "/api/lists/items/_import",
+			query:       params,
+			body:        body,
+			contentType: contentType,
+			getBody:     getBody,
+		}, nil, opts)
+		return (*ListsImportItemsResponse)(res), err
+	}
+}
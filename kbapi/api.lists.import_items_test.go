@@ -0,0 +1,87 @@
+package kbapi
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListsImportItems(t *testing.T) {
+	var uploads []string
+	api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/lists/items/_import", req.URL.Path)
+		assert.Equal(t, "ip", req.URL.Query().Get("type"))
+
+		mediaType, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/form-data", mediaType)
+
+		// Read the body twice, as the transport does when retrying
+		for body := req.Body; ; {
+			part, err := multipart.NewReader(body, params["boundary"]).NextPart()
+			require.NoError(t, err)
+			assert.Equal(t, "bad_ips.txt", part.FileName())
+			data, err := io.ReadAll(part)
+			require.NoError(t, err)
+			uploads = append(uploads, string(data))
+
+			if len(uploads) == 2 {
+				break
+			}
+			require.NotNil(t, req.GetBody)
+			body, err = req.GetBody()
+			require.NoError(t, err)
+		}
+
+		return jsonResponse(t, 200, SecurityListsAPIList{Id: "bad_ips.txt", Type: "ip"}), nil
+	}))
+
+	resp, err := api.Lists.ImportItems(context.Background(), &ListsImportItemsRequest{
+		Params: ImportListItemsParams{Type: StrPtr("ip")},
+		Body:   ListsImportItemsRequestBody{File: strings.NewReader("10.0.0.1\n10.0.0.2\n"), FileName: "bad_ips.txt"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "bad_ips.txt", resp.Body.Id)
+	assert.Equal(t, []string{"10.0.0.1\n10.0.0.2\n", "10.0.0.1\n10.0.0.2\n"}, uploads)
+}
+
+func TestListsExportItems(t *testing.T) {
+	mockTransport := NewMockTransportWithRawResponse(200, "10.0.0.1\n10.0.0.2\n", nil)
+	api := New(mockTransport).Space("soc")
+
+	var out bytes.Buffer
+	resp, err := api.Lists.ExportItems(context.Background(), &ListsExportItemsRequest{
+		Params: ExportListItemsParams{ListId: "bad_ips.txt"},
+		Body:   ListsExportItemsRequestBody{Output: &out},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "10.0.0.1\n10.0.0.2\n", out.String())
+
+	req := mockTransport.LastRequest()
+	AssertRequestMethod(t, req, "POST")
+	AssertRequestPath(t, req, "/s/soc/api/lists/items/_export")
+	AssertRequestParam(t, req, "list_id", "bad_ips.txt")
+}
+
+func TestListsExportItems_Error(t *testing.T) {
+	api := New(NewMockTransportWithRawResponse(404, `{"message":"list id: \"missing\" not found","status_code":404}`, nil))
+
+	var out bytes.Buffer
+	resp, err := api.Lists.ExportItems(context.Background(), &ListsExportItemsRequest{
+		Params: ExportListItemsParams{ListId: "missing"},
+		Body:   ListsExportItemsRequestBody{Output: &out},
+	})
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, 404, resp.StatusCode)
+	assert.Empty(t, out.String())
+}
@@ -0,0 +1,30 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ListsUpdateResponse wraps the response from a Lists.Update call
+type ListsUpdateResponse Response[SecurityListsAPIList]
+
+type ListsUpdateRequest struct {
+	Body UpdateListJSONRequestBody
+}
+
+// newListsUpdate returns a function that performs PUT /api/lists API requests
+func (api *API) newListsUpdate() func(context.Context, *ListsUpdateRequest, ...RequestOption) (*ListsUpdateResponse, error) {
+	return func(ctx context.Context, req *ListsUpdateRequest, opts ...RequestOption) (*ListsUpdateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[UpdateListJSONRequestBody, SecurityListsAPIList](ctx, api, operation{
+			name:   "lists.update",
+			method: http.MethodPut,
+			path:   "/api/lists",
+		}, &req.Body, opts)
+		return (*ListsUpdateResponse)(res), err
+	}
+}
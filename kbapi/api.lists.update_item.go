@@ -0,0 +1,30 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ListsUpdateItemResponse wraps the response from a Lists.UpdateItem call
+type ListsUpdateItemResponse Response[SecurityListsAPIListItem]
+
+type ListsUpdateItemRequest struct {
+	Body UpdateListItemJSONRequestBody
+}
+
+// newListsUpdateItem returns a function that performs PUT /api/lists/items API requests
+func (api *API) newListsUpdateItem() func(context.Context, *ListsUpdateItemRequest, ...RequestOption) (*ListsUpdateItemResponse, error) {
+	return func(ctx context.Context, req *ListsUpdateItemRequest, opts ...RequestOption) (*ListsUpdateItemResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[UpdateListItemJSONRequestBody, SecurityListsAPIListItem](ctx, api, operation{
+			name:   "lists.update_item",
+			method: http.MethodPut,
+			path:   "/api/lists/items",
+		}, &req.Body, opts)
+		return (*ListsUpdateItemResponse)(res), err
+	}
+}
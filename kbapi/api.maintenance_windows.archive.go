@@ -0,0 +1,36 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// MaintenanceWindowsArchiveResponse wraps the response from a MaintenanceWindows.Archive call
+type MaintenanceWindowsArchiveResponse Response[MaintenanceWindow]
+
+// maintenanceWindowsArchiveRequestBody is sent by both Archive and Unarchive.
+type maintenanceWindowsArchiveRequestBody struct {
+	Archive bool `json:"archive"`
+}
+
+type MaintenanceWindowsArchiveRequest struct {
+	ID string
+}
+
+// newMaintenanceWindowsArchive returns a function that performs POST /internal/alerting/rules/maintenance_window/{id}/_archive API requests
+func (api *API) newMaintenanceWindowsArchive() func(context.Context, *MaintenanceWindowsArchiveRequest, ...RequestOption) (*MaintenanceWindowsArchiveResponse, error) {
+	return func(ctx context.Context, req *MaintenanceWindowsArchiveRequest, opts ...RequestOption) (*MaintenanceWindowsArchiveResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[maintenanceWindowsArchiveRequestBody, MaintenanceWindow](ctx, api, operation{
+			name:    "maintenance_windows.archive",
+			feature: FeatureMaintenanceWindows,
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/internal/alerting/rules/maintenance_window/%s/_archive", req.ID),
+		}, &maintenanceWindowsArchiveRequestBody{Archive: true}, opts)
+		return (*MaintenanceWindowsArchiveResponse)(res), err
+	}
+}
@@ -0,0 +1,42 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// MaintenanceWindowsCreateResponse wraps the response from a MaintenanceWindows.Create call
+type MaintenanceWindowsCreateResponse Response[MaintenanceWindow]
+
+type MaintenanceWindowsCreateRequestBody struct {
+	Title string `json:"title"`
+	// Duration The duration of each occurrence in milliseconds.
+	Duration int                    `json:"duration"`
+	RRule    MaintenanceWindowRRule `json:"r_rule"`
+	// CategoryIDs Restricts the window to the rules of the given MaintenanceWindowCategory* values.
+	CategoryIDs []string `json:"category_ids,omitempty"`
+	// ScopedQuery Restricts the window to the alerts matching the query. Requires exactly one category.
+	ScopedQuery *MaintenanceWindowScopedQuery `json:"scoped_query,omitempty"`
+}
+
+type MaintenanceWindowsCreateRequest struct {
+	Body MaintenanceWindowsCreateRequestBody
+}
+
+// newMaintenanceWindowsCreate returns a function that performs POST /internal/alerting/rules/maintenance_window API requests
+func (api *API) newMaintenanceWindowsCreate() func(context.Context, *MaintenanceWindowsCreateRequest, ...RequestOption) (*MaintenanceWindowsCreateResponse, error) {
+	return func(ctx context.Context, req *MaintenanceWindowsCreateRequest, opts ...RequestOption) (*MaintenanceWindowsCreateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[MaintenanceWindowsCreateRequestBody, MaintenanceWindow](ctx, api, operation{
+			name:    "maintenance_windows.create",
+			feature: FeatureMaintenanceWindows,
+			method:  http.MethodPost,
+			path:    "/internal/alerting/rules/maintenance_window",
+		}, &req.Body, opts)
+		return (*MaintenanceWindowsCreateResponse)(res), err
+	}
+}
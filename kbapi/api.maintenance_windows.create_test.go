@@ -0,0 +1,90 @@
+package kbapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceWindowsCreate(t *testing.T) {
+	mockTransport := NewMockTransportWithRawResponse(200, `{
+		"id": "mw-1",
+		"title": "release",
+		"enabled": true,
+		"duration": 3600000,
+		"expiration_date": "2027-10-17T00:00:00.000Z",
+		"events": [{"gte": "2026-10-20T22:00:00.000Z", "lte": "2026-10-20T23:00:00.000Z"}],
+		"r_rule": {"dtstart": "2026-10-20T22:00:00.000Z", "tzid": "UTC", "freq": 2, "interval": 1, "byweekday": ["TU"], "count": 4},
+		"status": "upcoming",
+		"event_start_time": null,
+		"event_end_time": null,
+		"category_ids": ["observability"],
+		"scoped_query": {"kql": "service.name: checkout", "filters": [], "dsl": "{}"},
+		"created_by": "deployer",
+		"updated_by": "deployer",
+		"created_at": "2026-10-17T10:00:00.000Z",
+		"updated_at": "2026-10-17T10:00:00.000Z"
+	}`, nil)
+	api := New(mockTransport).Space("ops")
+
+	weekly := MaintenanceWindowFrequencyWeekly
+	resp, err := api.MaintenanceWindows.Create(context.Background(), &MaintenanceWindowsCreateRequest{
+		Body: MaintenanceWindowsCreateRequestBody{
+			Title:    "release",
+			Duration: 3600000,
+			RRule: MaintenanceWindowRRule{
+				Dtstart:   "2026-10-20T22:00:00.000Z",
+				Tzid:      "UTC",
+				Freq:      &weekly,
+				Interval:  IntPtr(1),
+				Count:     IntPtr(4),
+				Byweekday: []string{"TU"},
+			},
+			CategoryIDs: []string{MaintenanceWindowCategoryObservability},
+			ScopedQuery: &MaintenanceWindowScopedQuery{KQL: "service.name: checkout", Filters: []map[string]interface{}{}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "mw-1", resp.Body.ID)
+	assert.Equal(t, MaintenanceWindowStatusUpcoming, resp.Body.Status)
+	require.NotNil(t, resp.Body.RRule.Freq)
+	assert.Equal(t, MaintenanceWindowFrequencyWeekly, *resp.Body.RRule.Freq)
+	require.Len(t, resp.Body.Events, 1)
+	assert.Nil(t, resp.Body.EventStartTime)
+
+	req := mockTransport.LastRequest()
+	AssertRequestMethod(t, req, "POST")
+	AssertRequestPath(t, req, "/s/ops/internal/alerting/rules/maintenance_window")
+	assert.Equal(t, "go-kibana", req.Header.Get("x-elastic-internal-origin"))
+	AssertRequestBodyJSON(t, req, map[string]interface{}{
+		"title":    "release",
+		"duration": float64(3600000),
+		"r_rule": map[string]interface{}{
+			"dtstart":   "2026-10-20T22:00:00.000Z",
+			"tzid":      "UTC",
+			"freq":      float64(2),
+			"interval":  float64(1),
+			"count":     float64(4),
+			"byweekday": []interface{}{"TU"},
+		},
+		"category_ids": []interface{}{"observability"},
+		"scoped_query": map[string]interface{}{"kql": "service.name: checkout", "filters": []interface{}{}},
+	})
+}
+
+func TestMaintenanceWindowsArchive(t *testing.T) {
+	mockTransport := NewMockTransportWithRawResponse(200, `{"id": "mw-1", "status": "archived"}`, nil)
+	api := New(mockTransport)
+
+	_, err := api.MaintenanceWindows.Archive(context.Background(), &MaintenanceWindowsArchiveRequest{ID: "mw-1"})
+	require.NoError(t, err)
+	AssertRequestPath(t, mockTransport.LastRequest(), "/internal/alerting/rules/maintenance_window/mw-1/_archive")
+	AssertRequestBodyJSON(t, mockTransport.LastRequest(), map[string]interface{}{"archive": true})
+
+	_, err = api.MaintenanceWindows.Unarchive(context.Background(), &MaintenanceWindowsUnarchiveRequest{ID: "mw-1"})
+	require.NoError(t, err)
+	AssertRequestPath(t, mockTransport.LastRequest(), "/internal/alerting/rules/maintenance_window/mw-1/_archive")
+	AssertRequestBodyJSON(t, mockTransport.LastRequest(), map[string]interface{}{"archive": false})
+}
@@ -0,0 +1,44 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// MaintenanceWindowsDeleteResponse wraps the response from a MaintenanceWindows.Delete call
+type MaintenanceWindowsDeleteResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type MaintenanceWindowsDeleteRequest struct {
+	ID string
+}
+
+// newMaintenanceWindowsDelete returns a function that performs DELETE /internal/alerting/rules/maintenance_window/{id} API requests
+func (api *API) newMaintenanceWindowsDelete() func(context.Context, *MaintenanceWindowsDeleteRequest, ...RequestOption) (*MaintenanceWindowsDeleteResponse, error) {
+	return func(ctx context.Context, req *MaintenanceWindowsDeleteRequest, opts ...RequestOption) (*MaintenanceWindowsDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:    "maintenance_windows.delete",
+			feature: FeatureMaintenanceWindows,
+			method:  http.MethodDelete,
+			path:    fmt.Sprintf("/internal/alerting/rules/maintenance_window/%s", req.ID),
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &MaintenanceWindowsDeleteResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
@@ -0,0 +1,81 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// MaintenanceWindowsFindResponse wraps the response from a MaintenanceWindows.Find call
+type MaintenanceWindowsFindResponse Response[MaintenanceWindowsFindResponseBody]
+
+type MaintenanceWindowsFindResponseBody struct {
+	Data    []MaintenanceWindow `json:"data"`
+	Page    int                 `json:"page"`
+	PerPage int                 `json:"per_page"`
+	Total   int                 `json:"total"`
+}
+
+type MaintenanceWindowsFindRequestParams struct {
+	Page    *int
+	PerPage *int
+}
+
+type MaintenanceWindowsFindRequest struct {
+	Params MaintenanceWindowsFindRequestParams
+}
+
+// newMaintenanceWindowsFind returns a function that performs GET /internal/alerting/rules/maintenance_window/_find API requests
+func (api *API) newMaintenanceWindowsFind() func(context.Context, *MaintenanceWindowsFindRequest, ...RequestOption) (*MaintenanceWindowsFindResponse, error) {
+	return func(ctx context.Context, req *MaintenanceWindowsFindRequest, opts ...RequestOption) (*MaintenanceWindowsFindResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Page != nil {
+			params.Set("page", strconv.Itoa(*req.Params.Page))
+		}
+		if req.Params.PerPage != nil {
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
+		}
+
+		res, err := do[noBody, MaintenanceWindowsFindResponseBody](ctx, api, operation{
+			name:    "maintenance_windows.find",
+			feature: FeatureMaintenanceWindows,
+			method:  http.MethodGet,
+			path:    "/internal/alerting/rules/maintenance_window/_find",
+			query:   params,
+		}, nil, opts)
+		return (*MaintenanceWindowsFindResponse)(res), err
+	}
+}
+
+// All returns an iterator over all maintenance windows. Pages of
+// req.Params.PerPage items, or DefaultPerPage when unset, are fetched on demand.
+func (m MaintenanceWindows) All(ctx context.Context, req *MaintenanceWindowsFindRequest, opts ...RequestOption) iter.Seq2[MaintenanceWindow, error] {
+	return items(m.Pages(ctx, req, opts...))
+}
+
+// Pages returns an iterator over the pages of maintenance windows.
+func (m MaintenanceWindows) Pages(ctx context.Context, req *MaintenanceWindowsFindRequest, opts ...RequestOption) iter.Seq2[*Page[MaintenanceWindow], error] {
+	var params MaintenanceWindowsFindRequestParams
+	if req != nil {
+		params = req.Params
+	}
+
+	return pages(ctx, intValue(params.Page, 1), intValue(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[MaintenanceWindow], error) {
+		params.Page = IntPtr(page)
+		params.PerPage = IntPtr(perPage)
+		resp, err := m.Find(ctx, &MaintenanceWindowsFindRequest{Params: params}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &Page[MaintenanceWindow]{Items: resp.Body.Data, Page: resp.Body.Page, PerPage: resp.Body.PerPage, Total: resp.Body.Total}, nil
+	})
+}
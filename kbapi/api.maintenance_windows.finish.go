@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// MaintenanceWindowsFinishResponse wraps the response from a MaintenanceWindows.Finish call
+type MaintenanceWindowsFinishResponse Response[MaintenanceWindow]
+
+type MaintenanceWindowsFinishRequest struct {
+	ID string
+}
+
+// newMaintenanceWindowsFinish returns a function that performs POST /internal/alerting/rules/maintenance_window/{id}/_finish API requests
+func (api *API) newMaintenanceWindowsFinish() func(context.Context, *MaintenanceWindowsFinishRequest, ...RequestOption) (*MaintenanceWindowsFinishResponse, error) {
+	return func(ctx context.Context, req *MaintenanceWindowsFinishRequest, opts ...RequestOption) (*MaintenanceWindowsFinishResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, MaintenanceWindow](ctx, api, operation{
+			name:    "maintenance_windows.finish",
+			feature: FeatureMaintenanceWindows,
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/internal/alerting/rules/maintenance_window/%s/_finish", req.ID),
+		}, nil, opts)
+		return (*MaintenanceWindowsFinishResponse)(res), err
+	}
+}
@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// MaintenanceWindowsGetResponse wraps the response from a MaintenanceWindows.Get call
+type MaintenanceWindowsGetResponse Response[MaintenanceWindow]
+
+type MaintenanceWindowsGetRequest struct {
+	ID string
+}
+
+// newMaintenanceWindowsGet returns a function that performs GET /internal/alerting/rules/maintenance_window/{id} API requests
+func (api *API) newMaintenanceWindowsGet() func(context.Context, *MaintenanceWindowsGetRequest, ...RequestOption) (*MaintenanceWindowsGetResponse, error) {
+	return func(ctx context.Context, req *MaintenanceWindowsGetRequest, opts ...RequestOption) (*MaintenanceWindowsGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, MaintenanceWindow](ctx, api, operation{
+			name:    "maintenance_windows.get",
+			feature: FeatureMaintenanceWindows,
+			method:  http.MethodGet,
+			path:    fmt.Sprintf("/internal/alerting/rules/maintenance_window/%s", req.ID),
+		}, nil, opts)
+		return (*MaintenanceWindowsGetResponse)(res), err
+	}
+}
@@ -0,0 +1,22 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// MaintenanceWindowsGetActiveResponse wraps the response from a MaintenanceWindows.GetActive call
+type MaintenanceWindowsGetActiveResponse Response[[]MaintenanceWindow]
+
+// newMaintenanceWindowsGetActive returns a function that performs GET /internal/alerting/rules/maintenance_window/_active API requests
+func (api *API) newMaintenanceWindowsGetActive() func(context.Context, ...RequestOption) (*MaintenanceWindowsGetActiveResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*MaintenanceWindowsGetActiveResponse, error) {
+		res, err := do[noBody, []MaintenanceWindow](ctx, api, operation{
+			name:    "maintenance_windows.get_active",
+			feature: FeatureMaintenanceWindows,
+			method:  http.MethodGet,
+			path:    "/internal/alerting/rules/maintenance_window/_active",
+		}, nil, opts)
+		return (*MaintenanceWindowsGetActiveResponse)(res), err
+	}
+}
@@ -0,0 +1,88 @@
+package kbapi
+
+import (
+	"time"
+)
+
+// MaintenanceWindowFrequency is how often a maintenance window recurs, as defined by RFC 5545.
+type MaintenanceWindowFrequency int
+
+// Recurrence frequencies of a maintenance window.
+const (
+	MaintenanceWindowFrequencyYearly  MaintenanceWindowFrequency = 0
+	MaintenanceWindowFrequencyMonthly MaintenanceWindowFrequency = 1
+	MaintenanceWindowFrequencyWeekly  MaintenanceWindowFrequency = 2
+	MaintenanceWindowFrequencyDaily   MaintenanceWindowFrequency = 3
+)
+
+// Categories of the rules a maintenance window applies to.
+const (
+	MaintenanceWindowCategoryObservability    = "observability"
+	MaintenanceWindowCategorySecuritySolution = "securitySolution"
+	MaintenanceWindowCategoryManagement       = "management"
+)
+
+// Statuses of a maintenance window.
+const (
+	MaintenanceWindowStatusRunning  = "running"
+	MaintenanceWindowStatusUpcoming = "upcoming"
+	MaintenanceWindowStatusFinished = "finished"
+	MaintenanceWindowStatusArchived = "archived"
+	MaintenanceWindowStatusDisabled = "disabled"
+)
+
+// MaintenanceWindowRRule is the recurrence rule of a maintenance window, see RFC 5545.
+// A window without Freq happens once.
+type MaintenanceWindowRRule struct {
+	// Dtstart The start of the first occurrence, in ISO 8601 format.
+	Dtstart string `json:"dtstart"`
+	// Tzid The IANA time zone of the schedule, e.g. Europe/Paris.
+	Tzid     string                      `json:"tzid"`
+	Freq     *MaintenanceWindowFrequency `json:"freq,omitempty"`
+	Interval *int                        `json:"interval,omitempty"`
+	// Until The end of the recurrence, in ISO 8601 format. Mutually exclusive with Count.
+	Until *string `json:"until,omitempty"`
+	// Count The number of occurrences. Mutually exclusive with Until.
+	Count *int `json:"count,omitempty"`
+	// Byweekday The days of the week the window occurs on, e.g. MO or +1MO for the first Monday of the month.
+	Byweekday  []string `json:"byweekday,omitempty"`
+	Bymonthday []int    `json:"bymonthday,omitempty"`
+	Bymonth    []int    `json:"bymonth,omitempty"`
+}
+
+// MaintenanceWindowScopedQuery restricts a maintenance window to the alerts matching a query.
+type MaintenanceWindowScopedQuery struct {
+	KQL string `json:"kql"`
+	// Filters Kibana filters applied together with KQL.
+	Filters []map[string]interface{} `json:"filters"`
+	// DSL The Elasticsearch query built from KQL and Filters, only set in responses.
+	DSL *string `json:"dsl,omitempty"`
+}
+
+// MaintenanceWindowEvent is a single occurrence of a maintenance window.
+type MaintenanceWindowEvent struct {
+	Gte time.Time `json:"gte"`
+	Lte time.Time `json:"lte"`
+}
+
+// MaintenanceWindow is a maintenance window as returned by Kibana.
+type MaintenanceWindow struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Enabled bool   `json:"enabled"`
+	// Duration The duration of each occurrence in milliseconds.
+	Duration       int                      `json:"duration"`
+	ExpirationDate time.Time                `json:"expiration_date"`
+	Events         []MaintenanceWindowEvent `json:"events"`
+	RRule          MaintenanceWindowRRule   `json:"r_rule"`
+	// Status One of the MaintenanceWindowStatus* values.
+	Status         string                        `json:"status"`
+	EventStartTime *time.Time                    `json:"event_start_time"`
+	EventEndTime   *time.Time                    `json:"event_end_time"`
+	CategoryIDs    []string                      `json:"category_ids"`
+	ScopedQuery    *MaintenanceWindowScopedQuery `json:"scoped_query"`
+	CreatedBy      *string                       `json:"created_by"`
+	UpdatedBy      *string                       `json:"updated_by"`
+	CreatedAt      time.Time                     `json:"created_at"`
+	UpdatedAt      time.Time                     `json:"updated_at"`
+}
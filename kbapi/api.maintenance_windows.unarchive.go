@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// MaintenanceWindowsUnarchiveResponse wraps the response from a MaintenanceWindows.Unarchive call
+type MaintenanceWindowsUnarchiveResponse Response[MaintenanceWindow]
+
+type MaintenanceWindowsUnarchiveRequest struct {
+	ID string
+}
+
+// newMaintenanceWindowsUnarchive returns a function that performs POST /internal/alerting/rules/maintenance_window/{id}/_archive API requests
+func (api *API) newMaintenanceWindowsUnarchive() func(context.Context, *MaintenanceWindowsUnarchiveRequest, ...RequestOption) (*MaintenanceWindowsUnarchiveResponse, error) {
+	return func(ctx context.Context, req *MaintenanceWindowsUnarchiveRequest, opts ...RequestOption) (*MaintenanceWindowsUnarchiveResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[maintenanceWindowsArchiveRequestBody, MaintenanceWindow](ctx, api, operation{
+			name:    "maintenance_windows.unarchive",
+			feature: FeatureMaintenanceWindows,
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/internal/alerting/rules/maintenance_window/%s/_archive", req.ID),
+		}, &maintenanceWindowsArchiveRequestBody{Archive: false}, opts)
+		return (*MaintenanceWindowsUnarchiveResponse)(res), err
+	}
+}
@@ -0,0 +1,42 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// MaintenanceWindowsUpdateResponse wraps the response from a MaintenanceWindows.Update call
+type MaintenanceWindowsUpdateResponse Response[MaintenanceWindow]
+
+type MaintenanceWindowsUpdateRequestBody struct {
+	Title   *string `json:"title,omitempty"`
+	Enabled *bool   `json:"enabled,omitempty"`
+	// Duration The duration of each occurrence in milliseconds.
+	Duration    *int                          `json:"duration,omitempty"`
+	RRule       *MaintenanceWindowRRule       `json:"r_rule,omitempty"`
+	CategoryIDs []string                      `json:"category_ids,omitempty"`
+	ScopedQuery *MaintenanceWindowScopedQuery `json:"scoped_query,omitempty"`
+}
+
+type MaintenanceWindowsUpdateRequest struct {
+	ID   string
+	Body MaintenanceWindowsUpdateRequestBody
+}
+
+// newMaintenanceWindowsUpdate returns a function that performs PATCH /internal/alerting/rules/maintenance_window/{id} API requests
+func (api *API) newMaintenanceWindowsUpdate() func(context.Context, *MaintenanceWindowsUpdateRequest, ...RequestOption) (*MaintenanceWindowsUpdateResponse, error) {
+	return func(ctx context.Context, req *MaintenanceWindowsUpdateRequest, opts ...RequestOption) (*MaintenanceWindowsUpdateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[MaintenanceWindowsUpdateRequestBody, MaintenanceWindow](ctx, api, operation{
+			name:    "maintenance_windows.update",
+			feature: FeatureMaintenanceWindows,
+			method:  http.MethodPatch,
+			path:    fmt.Sprintf("/internal/alerting/rules/maintenance_window/%s", req.ID),
+		}, &req.Body, opts)
+		return (*MaintenanceWindowsUpdateResponse)(res), err
+	}
+}
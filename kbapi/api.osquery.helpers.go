@@ -0,0 +1,169 @@
+package kbapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// GetStringValue returns the value of the ECS mapping when it is a single value.
+func (t SecurityOsqueryAPIECSMappingItem_Value) GetStringValue() (string, bool) {
+	var value string
+	if err := json.Unmarshal(t.union, &value); err != nil {
+		return "", false
+	}
+	return value, true
+}
+
+// GetStringSliceValue returns the value of the ECS mapping when it is a list of values.
+func (t SecurityOsqueryAPIECSMappingItem_Value) GetStringSliceValue() ([]string, bool) {
+	var values []string
+	if err := json.Unmarshal(t.union, &values); err != nil {
+		return nil, false
+	}
+	return values, true
+}
+
+// SetStringValue sets the value of the ECS mapping to a single value.
+func (t *SecurityOsqueryAPIECSMappingItem_Value) SetStringValue(value string) error {
+	union, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal string value: %w", err)
+	}
+	t.union = union
+	return nil
+}
+
+// SetStringSliceValue sets the value of the ECS mapping to a list of values.
+func (t *SecurityOsqueryAPIECSMappingItem_Value) SetStringSliceValue(values []string) error {
+	union, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Errorf("failed to marshal string slice value: %w", err)
+	}
+	t.union = union
+	return nil
+}
+
+func (t SecurityOsqueryAPIECSMappingItem_Value) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityOsqueryAPIECSMappingItem_Value) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+// DefaultOsqueryPollInterval is the time WaitForResults waits between status checks by default.
+const DefaultOsqueryPollInterval = 5 * time.Second
+
+type OsqueryWaitForResultsRequest struct {
+	// ID The ID of the live query, the action_id returned when it was created.
+	ID string
+	// ActionID The action ID of the query to wait for. It can be left empty when the live query runs a single query.
+	ActionID string
+	// PollInterval The time between status checks, DefaultOsqueryPollInterval when zero.
+	PollInterval time.Duration
+	// PageSize The number of results fetched per request, DefaultPerPage when zero.
+	PageSize int
+}
+
+// OsqueryLiveQueryResults are the results of a query action collected from every targeted agent.
+type OsqueryLiveQueryResults struct {
+	// Action The final state of the query action, with the number of agents that responded, succeeded and failed.
+	Action OsqueryLiveQueryAction
+	// Results The rows returned by all agents.
+	Results []OsqueryLiveQueryResult
+}
+
+// WaitForResults polls the live query until every targeted agent has
+// responded to the query action, or the action expired, and then returns the
+// results of all agents. Cancel ctx to stop waiting.
+func (l LiveQueries) WaitForResults(ctx context.Context, req *OsqueryWaitForResultsRequest, opts ...RequestOption) (*OsqueryLiveQueryResults, error) {
+	if req == nil {
+		return nil, fmt.Errorf("Request cannot be nil")
+	}
+
+	interval := req.PollInterval
+	if interval <= 0 {
+		interval = DefaultOsqueryPollInterval
+	}
+
+	var action *OsqueryLiveQueryAction
+	for {
+		resp, err := l.Get(ctx, &OsqueryLiveQueriesGetRequest{ID: req.ID}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		action, err = findOsqueryAction(resp.Body.Data, req.ActionID)
+		if err != nil {
+			return nil, err
+		}
+		if isOsqueryActionDone(action) {
+			break
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	results := &OsqueryLiveQueryResults{Action: *action}
+	perPage := req.PageSize
+	if perPage < 1 {
+		perPage = DefaultPerPage
+	}
+
+	// The results endpoint pages are zero based
+	seq := pages(ctx, 1, perPage, func(ctx context.Context, page, perPage int) (*Page[OsqueryLiveQueryResult], error) {
+		resultsPage := page - 1
+		resp, err := l.GetResults(ctx, &OsqueryLiveQueriesGetResultsRequest{
+			ID:       req.ID,
+			ActionID: action.ActionID,
+			Params:   OsqueryGetLiveQueryResultsParams{Page: &resultsPage, PageSize: &perPage},
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &Page[OsqueryLiveQueryResult]{Items: resp.Body.Data.Edges, Page: page, PerPage: perPage, Total: resp.Body.Data.Total}, nil
+	})
+	for result, err := range items(seq) {
+		if err != nil {
+			return nil, err
+		}
+		results.Results = append(results.Results, result)
+	}
+
+	return results, nil
+}
+
+// findOsqueryAction returns the query action of the live query with the given
+// action ID, or its only query when actionID is empty.
+func findOsqueryAction(liveQuery OsqueryLiveQuery, actionID string) (*OsqueryLiveQueryAction, error) {
+	if actionID == "" {
+		if len(liveQuery.Queries) != 1 {
+			return nil, fmt.Errorf("live query %s runs %d queries, an action ID is required", liveQuery.ActionID, len(liveQuery.Queries))
+		}
+		return &liveQuery.Queries[0], nil
+	}
+
+	for i := range liveQuery.Queries {
+		if liveQuery.Queries[i].ActionID == actionID {
+			return &liveQuery.Queries[i], nil
+		}
+	}
+	return nil, fmt.Errorf("live query %s has no query action %s", liveQuery.ActionID, actionID)
+}
+
+// isOsqueryActionDone reports whether every targeted agent responded to the
+// action. Kibana marks actions completed once they expire as well.
+func isOsqueryActionDone(action *OsqueryLiveQueryAction) bool {
+	if action.Status != nil && *action.Status == "completed" {
+		return true
+	}
+	return action.Pending != nil && *action.Pending == 0 && action.Responded != nil && *action.Responded >= len(action.Agents)
+}
@@ -0,0 +1,83 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLiveQueries_WaitForResults(t *testing.T) {
+	agents := []string{"agent-1", "agent-2", "agent-3"}
+	details := func(status string, pending, responded int) map[string]interface{} {
+		return map[string]interface{}{"data": map[string]interface{}{
+			"action_id": "live-1",
+			"agents":    agents,
+			"queries": []map[string]interface{}{{
+				"action_id": "action-1",
+				"id":        "uptime",
+				"query":     "select * from uptime;",
+				"agents":    agents,
+				"pending":   pending,
+				"responded": responded,
+				"status":    status,
+			}},
+		}}
+	}
+
+	var detailCalls int
+	var resultPages []string
+	api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/api/osquery/live_queries/live-1":
+			detailCalls++
+			if detailCalls < 3 {
+				return jsonResponse(t, 200, details("running", 3-detailCalls, detailCalls)), nil
+			}
+			return jsonResponse(t, 200, details("completed", 0, 3)), nil
+		case "/api/osquery/live_queries/live-1/results/action-1":
+			page := req.URL.Query().Get("page")
+			resultPages = append(resultPages, page)
+			edges := []OsqueryLiveQueryResult{{ID: "1"}, {ID: "2"}}
+			if page == "1" {
+				edges = []OsqueryLiveQueryResult{{ID: "3"}}
+			}
+			body := OsqueryLiveQueriesGetResultsResponseBody{}
+			body.Data.Edges = edges
+			body.Data.Total = 3
+			return jsonResponse(t, 200, body), nil
+		}
+		t.Fatalf("unexpected request %s", req.URL.Path)
+		return nil, nil
+	}))
+
+	results, err := api.Osquery.LiveQueries.WaitForResults(context.Background(), &OsqueryWaitForResultsRequest{
+		ID:           "live-1",
+		PollInterval: time.Millisecond,
+		PageSize:     2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, detailCalls)
+	assert.Equal(t, []string{"0", "1"}, resultPages)
+	assert.Equal(t, "action-1", results.Action.ActionID)
+	require.Len(t, results.Results, 3)
+	assert.Equal(t, "3", results.Results[2].ID)
+}
+
+func TestLiveQueries_WaitForResultsContextCanceled(t *testing.T) {
+	api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(t, 200, map[string]interface{}{"data": map[string]interface{}{
+			"action_id": "live-1",
+			"queries":   []map[string]interface{}{{"action_id": "action-1", "agents": []string{"agent-1"}, "pending": 1, "status": "running"}},
+		}}), nil
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := api.Osquery.LiveQueries.WaitForResults(ctx, &OsqueryWaitForResultsRequest{ID: "live-1", ActionID: "action-1", PollInterval: time.Millisecond})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
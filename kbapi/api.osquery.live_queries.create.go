@@ -0,0 +1,34 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// OsqueryLiveQueriesCreateResponse wraps the response from a Osquery.LiveQueries.Create call
+type OsqueryLiveQueriesCreateResponse Response[OsqueryLiveQueriesCreateResponseBody]
+
+type OsqueryLiveQueriesCreateResponseBody struct {
+	Data OsqueryLiveQuery `json:"data"`
+}
+
+type OsqueryLiveQueriesCreateRequest struct {
+	Body SecurityOsqueryAPICreateLiveQueryRequestBody
+}
+
+// newOsqueryLiveQueriesCreate returns a function that performs POST /api/osquery/live_queries API requests
+func (api *API) newOsqueryLiveQueriesCreate() func(context.Context, *OsqueryLiveQueriesCreateRequest, ...RequestOption) (*OsqueryLiveQueriesCreateResponse, error) {
+	return func(ctx context.Context, req *OsqueryLiveQueriesCreateRequest, opts ...RequestOption) (*OsqueryLiveQueriesCreateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SecurityOsqueryAPICreateLiveQueryRequestBody, OsqueryLiveQueriesCreateResponseBody](ctx, api, operation{
+			name:   "osquery.live_queries.create",
+			method: http.MethodPost,
+			path:   "/api/osquery/live_queries",
+		}, &req.Body, opts)
+		return (*OsqueryLiveQueriesCreateResponse)(res), err
+	}
+}
@@ -0,0 +1,34 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// OsqueryLiveQueriesGetResponse wraps the response from a Osquery.LiveQueries.Get call
+type OsqueryLiveQueriesGetResponse Response[OsqueryLiveQueriesGetResponseBody]
+
+type OsqueryLiveQueriesGetResponseBody struct {
+	Data OsqueryLiveQuery `json:"data"`
+}
+
+type OsqueryLiveQueriesGetRequest struct {
+	ID string
+}
+
+// newOsqueryLiveQueriesGet returns a function that performs GET /api/osquery/live_queries/{id} API requests
+func (api *API) newOsqueryLiveQueriesGet() func(context.Context, *OsqueryLiveQueriesGetRequest, ...RequestOption) (*OsqueryLiveQueriesGetResponse, error) {
+	return func(ctx context.Context, req *OsqueryLiveQueriesGetRequest, opts ...RequestOption) (*OsqueryLiveQueriesGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, OsqueryLiveQueriesGetResponseBody](ctx, api, operation{
+			name:   "osquery.live_queries.get",
+			method: http.MethodGet,
+			path:   fmt.Sprintf("/api/osquery/live_queries/%s", req.ID),
+		}, nil, opts)
+		return (*OsqueryLiveQueriesGetResponse)(res), err
+	}
+}
@@ -0,0 +1,61 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// OsqueryLiveQueriesGetResultsResponse wraps the response from a Osquery.LiveQueries.GetResults call
+type OsqueryLiveQueriesGetResultsResponse Response[OsqueryLiveQueriesGetResultsResponseBody]
+
+type OsqueryLiveQueriesGetResultsResponseBody struct {
+	Data struct {
+		Edges []OsqueryLiveQueryResult `json:"edges"`
+		Total int                      `json:"total"`
+	} `json:"data"`
+}
+
+type OsqueryLiveQueriesGetResultsRequest struct {
+	ID       string
+	ActionID string
+	Params   OsqueryGetLiveQueryResultsParams
+}
+
+// newOsqueryLiveQueriesGetResults returns a function that performs GET /api/osquery/live_queries/{id}/results/{actionId} API requests
+func (api *API) newOsqueryLiveQueriesGetResults() func(context.Context, *OsqueryLiveQueriesGetResultsRequest, ...RequestOption) (*OsqueryLiveQueriesGetResultsResponse, error) {
+	return func(ctx context.Context, req *OsqueryLiveQueriesGetResultsRequest, opts ...RequestOption) (*OsqueryLiveQueriesGetResultsResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Kuery != nil {
+			params.Set("kuery", *req.Params.Kuery)
+		}
+		if req.Params.Page != nil {
+			params.Set("page", strconv.Itoa(*req.Params.Page))
+		}
+		if req.Params.PageSize != nil {
+			params.Set("pageSize", strconv.Itoa(*req.Params.PageSize))
+		}
+		if req.Params.Sort != nil {
+			params.Set("sort", *req.Params.Sort)
+		}
+		if req.Params.SortOrder != nil {
+			params.Set("sortOrder", *req.Params.SortOrder)
+		}
+
+		res, err := do[noBody, OsqueryLiveQueriesGetResultsResponseBody](ctx, api, operation{
+			name:   "osquery.live_queries.get_results",
+			method: http.MethodGet,
+			path:   fmt.Sprintf("/api/osquery/live_queries/%s/results/%s", req.ID, req.ActionID),
+			query:  params,
+		}, nil, opts)
+		return (*OsqueryLiveQueriesGetResultsResponse)(res), err
+	}
+}
@@ -0,0 +1,66 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// OsqueryLiveQueriesListResponse wraps the response from a Osquery.LiveQueries.List call
+type OsqueryLiveQueriesListResponse Response[OsqueryLiveQueriesListResponseBody]
+
+type OsqueryLiveQueriesListResponseBody struct {
+	Data struct {
+		Items []OsqueryLiveQueriesListResponseBodyItem `json:"items"`
+		Total int                                      `json:"total"`
+	} `json:"data"`
+}
+
+// OsqueryLiveQueriesListResponseBodyItem is a live query action document
+type OsqueryLiveQueriesListResponseBodyItem struct {
+	ID     string                 `json:"_id"`
+	Source OsqueryLiveQuery       `json:"_source"`
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+type OsqueryLiveQueriesListRequest struct {
+	Params OsqueryFindLiveQueriesParams
+}
+
+// newOsqueryLiveQueriesList returns a function that performs GET /api/osquery/live_queries API requests
+func (api *API) newOsqueryLiveQueriesList() func(context.Context, *OsqueryLiveQueriesListRequest, ...RequestOption) (*OsqueryLiveQueriesListResponse, error) {
+	return func(ctx context.Context, req *OsqueryLiveQueriesListRequest, opts ...RequestOption) (*OsqueryLiveQueriesListResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Kuery != nil {
+			params.Set("kuery", *req.Params.Kuery)
+		}
+		if req.Params.Page != nil {
+			params.Set("page", strconv.Itoa(*req.Params.Page))
+		}
+		if req.Params.PageSize != nil {
+			params.Set("pageSize", strconv.Itoa(*req.Params.PageSize))
+		}
+		if req.Params.Sort != nil {
+			params.Set("sort", *req.Params.Sort)
+		}
+		if req.Params.SortOrder != nil {
+			params.Set("sortOrder", *req.Params.SortOrder)
+		}
+
+		res, err := do[noBody, OsqueryLiveQueriesListResponseBody](ctx, api, operation{
+			name:   "osquery.live_queries.list",
+			method: http.MethodGet,
+			path:   "/api/osquery/live_queries",
+			query:  params,
+		}, nil, opts)
+		return (*OsqueryLiveQueriesListResponse)(res), err
+	}
+}
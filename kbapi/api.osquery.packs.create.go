@@ -0,0 +1,34 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// OsqueryPacksCreateResponse wraps the response from a Osquery.Packs.Create call
+type OsqueryPacksCreateResponse Response[OsqueryPacksCreateResponseBody]
+
+type OsqueryPacksCreateResponseBody struct {
+	Data OsqueryPack `json:"data"`
+}
+
+type OsqueryPacksCreateRequest struct {
+	Body SecurityOsqueryAPICreatePacksRequestBody
+}
+
+// newOsqueryPacksCreate returns a function that performs POST /api/osquery/packs API requests
+func (api *API) newOsqueryPacksCreate() func(context.Context, *OsqueryPacksCreateRequest, ...RequestOption) (*OsqueryPacksCreateResponse, error) {
+	return func(ctx context.Context, req *OsqueryPacksCreateRequest, opts ...RequestOption) (*OsqueryPacksCreateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SecurityOsqueryAPICreatePacksRequestBody, OsqueryPacksCreateResponseBody](ctx, api, operation{
+			name:   "osquery.packs.create",
+			method: http.MethodPost,
+			path:   "/api/osquery/packs",
+		}, &req.Body, opts)
+		return (*OsqueryPacksCreateResponse)(res), err
+	}
+}
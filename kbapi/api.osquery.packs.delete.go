@@ -0,0 +1,43 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// OsqueryPacksDeleteResponse wraps the response from a Osquery.Packs.Delete call
+type OsqueryPacksDeleteResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type OsqueryPacksDeleteRequest struct {
+	ID string
+}
+
+// newOsqueryPacksDelete returns a function that performs DELETE /api/osquery/packs/{id} API requests
+func (api *API) newOsqueryPacksDelete() func(context.Context, *OsqueryPacksDeleteRequest, ...RequestOption) (*OsqueryPacksDeleteResponse, error) {
+	return func(ctx context.Context, req *OsqueryPacksDeleteRequest, opts ...RequestOption) (*OsqueryPacksDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:   "osquery.packs.delete",
+			method: http.MethodDelete,
+			path:   fmt.Sprintf("/api/osquery/packs/%s", req.ID),
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &OsqueryPacksDeleteResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
@@ -0,0 +1,34 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// OsqueryPacksGetResponse wraps the response from a Osquery.Packs.Get call
+type OsqueryPacksGetResponse Response[OsqueryPacksGetResponseBody]
+
+type OsqueryPacksGetResponseBody struct {
+	Data OsqueryPack `json:"data"`
+}
+
+type OsqueryPacksGetRequest struct {
+	ID string
+}
+
+// newOsqueryPacksGet returns a function that performs GET /api/osquery/packs/{id} API requests
+func (api *API) newOsqueryPacksGet() func(context.Context, *OsqueryPacksGetRequest, ...RequestOption) (*OsqueryPacksGetResponse, error) {
+	return func(ctx context.Context, req *OsqueryPacksGetRequest, opts ...RequestOption) (*OsqueryPacksGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, OsqueryPacksGetResponseBody](ctx, api, operation{
+			name:   "osquery.packs.get",
+			method: http.MethodGet,
+			path:   fmt.Sprintf("/api/osquery/packs/%s", req.ID),
+		}, nil, opts)
+		return (*OsqueryPacksGetResponse)(res), err
+	}
+}
@@ -0,0 +1,56 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// OsqueryPacksListResponse wraps the response from a Osquery.Packs.List call
+type OsqueryPacksListResponse Response[OsqueryPacksListResponseBody]
+
+type OsqueryPacksListResponseBody struct {
+	Data    []OsqueryPack `json:"data"`
+	Page    int           `json:"page"`
+	PerPage int           `json:"per_page"`
+	Total   int           `json:"total"`
+}
+
+type OsqueryPacksListRequest struct {
+	Params OsqueryFindPacksParams
+}
+
+// newOsqueryPacksList returns a function that performs GET /api/osquery/packs API requests
+func (api *API) newOsqueryPacksList() func(context.Context, *OsqueryPacksListRequest, ...RequestOption) (*OsqueryPacksListResponse, error) {
+	return func(ctx context.Context, req *OsqueryPacksListRequest, opts ...RequestOption) (*OsqueryPacksListResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Page != nil {
+			params.Set("page", strconv.Itoa(*req.Params.Page))
+		}
+		if req.Params.PageSize != nil {
+			params.Set("pageSize", strconv.Itoa(*req.Params.PageSize))
+		}
+		if req.Params.Sort != nil {
+			params.Set("sort", *req.Params.Sort)
+		}
+		if req.Params.SortOrder != nil {
+			params.Set("sortOrder", *req.Params.SortOrder)
+		}
+
+		res, err := do[noBody, OsqueryPacksListResponseBody](ctx, api, operation{
+			name:   "osquery.packs.list",
+			method: http.MethodGet,
+			path:   "/api/osquery/packs",
+			query:  params,
+		}, nil, opts)
+		return (*OsqueryPacksListResponse)(res), err
+	}
+}
@@ -0,0 +1,35 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// OsqueryPacksUpdateResponse wraps the response from a Osquery.Packs.Update call
+type OsqueryPacksUpdateResponse Response[OsqueryPacksUpdateResponseBody]
+
+type OsqueryPacksUpdateResponseBody struct {
+	Data OsqueryPack `json:"data"`
+}
+
+type OsqueryPacksUpdateRequest struct {
+	ID   string
+	Body SecurityOsqueryAPIUpdatePacksRequestBody
+}
+
+// newOsqueryPacksUpdate returns a function that performs PUT /api/osquery/packs/{id} API requests
+func (api *API) newOsqueryPacksUpdate() func(context.Context, *OsqueryPacksUpdateRequest, ...RequestOption) (*OsqueryPacksUpdateResponse, error) {
+	return func(ctx context.Context, req *OsqueryPacksUpdateRequest, opts ...RequestOption) (*OsqueryPacksUpdateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SecurityOsqueryAPIUpdatePacksRequestBody, OsqueryPacksUpdateResponseBody](ctx, api, operation{
+			name:   "osquery.packs.update",
+			method: http.MethodPut,
+			path:   fmt.Sprintf("/api/osquery/packs/%s", req.ID),
+		}, &req.Body, opts)
+		return (*OsqueryPacksUpdateResponse)(res), err
+	}
+}
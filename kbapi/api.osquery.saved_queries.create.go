@@ -0,0 +1,34 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// OsquerySavedQueriesCreateResponse wraps the response from a Osquery.SavedQueries.Create call
+type OsquerySavedQueriesCreateResponse Response[OsquerySavedQueriesCreateResponseBody]
+
+type OsquerySavedQueriesCreateResponseBody struct {
+	Data OsquerySavedQuery `json:"data"`
+}
+
+type OsquerySavedQueriesCreateRequest struct {
+	Body SecurityOsqueryAPICreateSavedQueryRequestBody
+}
+
+// newOsquerySavedQueriesCreate returns a function that performs POST /api/osquery/saved_queries API requests
+func (api *API) newOsquerySavedQueriesCreate() func(context.Context, *OsquerySavedQueriesCreateRequest, ...RequestOption) (*OsquerySavedQueriesCreateResponse, error) {
+	return func(ctx context.Context, req *OsquerySavedQueriesCreateRequest, opts ...RequestOption) (*OsquerySavedQueriesCreateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SecurityOsqueryAPICreateSavedQueryRequestBody, OsquerySavedQueriesCreateResponseBody](ctx, api, operation{
+			name:   "osquery.saved_queries.create",
+			method: http.MethodPost,
+			path:   "/api/osquery/saved_queries",
+		}, &req.Body, opts)
+		return (*OsquerySavedQueriesCreateResponse)(res), err
+	}
+}
@@ -0,0 +1,43 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// OsquerySavedQueriesDeleteResponse wraps the response from a Osquery.SavedQueries.Delete call
+type OsquerySavedQueriesDeleteResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type OsquerySavedQueriesDeleteRequest struct {
+	ID string
+}
+
+// newOsquerySavedQueriesDelete returns a function that performs DELETE /api/osquery/saved_queries/{id} API requests
+func (api *API) newOsquerySavedQueriesDelete() func(context.Context, *OsquerySavedQueriesDeleteRequest, ...RequestOption) (*OsquerySavedQueriesDeleteResponse, error) {
+	return func(ctx context.Context, req *OsquerySavedQueriesDeleteRequest, opts ...RequestOption) (*OsquerySavedQueriesDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:   "osquery.saved_queries.delete",
+			method: http.MethodDelete,
+			path:   fmt.Sprintf("/api/osquery/saved_queries/%s", req.ID),
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &OsquerySavedQueriesDeleteResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
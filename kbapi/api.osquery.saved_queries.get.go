@@ -0,0 +1,34 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// OsquerySavedQueriesGetResponse wraps the response from a Osquery.SavedQueries.Get call
+type OsquerySavedQueriesGetResponse Response[OsquerySavedQueriesGetResponseBody]
+
+type OsquerySavedQueriesGetResponseBody struct {
+	Data OsquerySavedQuery `json:"data"`
+}
+
+type OsquerySavedQueriesGetRequest struct {
+	ID string
+}
+
+// newOsquerySavedQueriesGet returns a function that performs GET /api/osquery/saved_queries/{id} API requests
+func (api *API) newOsquerySavedQueriesGet() func(context.Context, *OsquerySavedQueriesGetRequest, ...RequestOption) (*OsquerySavedQueriesGetResponse, error) {
+	return func(ctx context.Context, req *OsquerySavedQueriesGetRequest, opts ...RequestOption) (*OsquerySavedQueriesGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, OsquerySavedQueriesGetResponseBody](ctx, api, operation{
+			name:   "osquery.saved_queries.get",
+			method: http.MethodGet,
+			path:   fmt.Sprintf("/api/osquery/saved_queries/%s", req.ID),
+		}, nil, opts)
+		return (*OsquerySavedQueriesGetResponse)(res), err
+	}
+}
@@ -0,0 +1,56 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// OsquerySavedQueriesListResponse wraps the response from a Osquery.SavedQueries.List call
+type OsquerySavedQueriesListResponse Response[OsquerySavedQueriesListResponseBody]
+
+type OsquerySavedQueriesListResponseBody struct {
+	Data    []OsquerySavedQuery `json:"data"`
+	Page    int                 `json:"page"`
+	PerPage int                 `json:"per_page"`
+	Total   int                 `json:"total"`
+}
+
+type OsquerySavedQueriesListRequest struct {
+	Params OsqueryFindSavedQueriesParams
+}
+
+// newOsquerySavedQueriesList returns a function that performs GET /api/osquery/saved_queries API requests
+func (api *API) newOsquerySavedQueriesList() func(context.Context, *OsquerySavedQueriesListRequest, ...RequestOption) (*OsquerySavedQueriesListResponse, error) {
+	return func(ctx context.Context, req *OsquerySavedQueriesListRequest, opts ...RequestOption) (*OsquerySavedQueriesListResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Page != nil {
+			params.Set("page", strconv.Itoa(*req.Params.Page))
+		}
+		if req.Params.PageSize != nil {
+			params.Set("pageSize", strconv.Itoa(*req.Params.PageSize))
+		}
+		if req.Params.Sort != nil {
+			params.Set("sort", *req.Params.Sort)
+		}
+		if req.Params.SortOrder != nil {
+			params.Set("sortOrder", *req.Params.SortOrder)
+		}
+
+		res, err := do[noBody, OsquerySavedQueriesListResponseBody](ctx, api, operation{
+			name:   "osquery.saved_queries.list",
+			method: http.MethodGet,
+			path:   "/api/osquery/saved_queries",
+			query:  params,
+		}, nil, opts)
+		return (*OsquerySavedQueriesListResponse)(res), err
+	}
+}
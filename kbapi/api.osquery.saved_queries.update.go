@@ -0,0 +1,35 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// OsquerySavedQueriesUpdateResponse wraps the response from a Osquery.SavedQueries.Update call
+type OsquerySavedQueriesUpdateResponse Response[OsquerySavedQueriesUpdateResponseBody]
+
+type OsquerySavedQueriesUpdateResponseBody struct {
+	Data OsquerySavedQuery `json:"data"`
+}
+
+type OsquerySavedQueriesUpdateRequest struct {
+	ID   string
+	Body SecurityOsqueryAPIUpdateSavedQueryRequestBody
+}
+
+// newOsquerySavedQueriesUpdate returns a function that performs PUT /api/osquery/saved_queries/{id} API requests
+func (api *API) newOsquerySavedQueriesUpdate() func(context.Context, *OsquerySavedQueriesUpdateRequest, ...RequestOption) (*OsquerySavedQueriesUpdateResponse, error) {
+	return func(ctx context.Context, req *OsquerySavedQueriesUpdateRequest, opts ...RequestOption) (*OsquerySavedQueriesUpdateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SecurityOsqueryAPIUpdateSavedQueryRequestBody, OsquerySavedQueriesUpdateResponseBody](ctx, api, operation{
+			name:   "osquery.saved_queries.update",
+			method: http.MethodPut,
+			path:   fmt.Sprintf("/api/osquery/saved_queries/%s", req.ID),
+		}, &req.Body, opts)
+		return (*OsquerySavedQueriesUpdateResponse)(res), err
+	}
+}
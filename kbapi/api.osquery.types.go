@@ -0,0 +1,100 @@
+package kbapi
+
+// OsqueryLiveQuery is a live query action as returned by Kibana.
+type OsqueryLiveQuery struct {
+	// ActionID The ID of the live query action.
+	ActionID       string   `json:"action_id"`
+	Timestamp      string   `json:"@timestamp"`
+	Expiration     string   `json:"expiration"`
+	AgentAll       *bool    `json:"agent_all,omitempty"`
+	AgentIDs       []string `json:"agent_ids,omitempty"`
+	AgentPlatforms []string `json:"agent_platforms,omitempty"`
+	AgentPolicyIDs []string `json:"agent_policy_ids,omitempty"`
+	// Agents The IDs of all agents the live query was sent to.
+	Agents   []string               `json:"agents"`
+	AlertIDs []string               `json:"alert_ids,omitempty"`
+	CaseIDs  []string               `json:"case_ids,omitempty"`
+	EventIDs []string               `json:"event_ids,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	PackID   *string                `json:"pack_id,omitempty"`
+	PackName *string                `json:"pack_name,omitempty"`
+	// Queries The query actions of the live query, one per query.
+	Queries []OsqueryLiveQueryAction `json:"queries"`
+	// Status The status of the live query, running or completed. Only set when getting the live query details.
+	Status *string `json:"status,omitempty"`
+	UserID string  `json:"user_id"`
+}
+
+// OsqueryLiveQueryAction is a single query of a live query, each query is its own action.
+type OsqueryLiveQueryAction struct {
+	// ActionID The ID of the query action, used to retrieve its results.
+	ActionID     string                        `json:"action_id"`
+	ID           string                        `json:"id"`
+	Query        string                        `json:"query"`
+	Agents       []string                      `json:"agents"`
+	EcsMapping   *SecurityOsqueryAPIECSMapping `json:"ecs_mapping,omitempty"`
+	Platform     *string                       `json:"platform,omitempty"`
+	SavedQueryID *string                       `json:"saved_query_id,omitempty"`
+	Timeout      *int                          `json:"timeout,omitempty"`
+	Version      *string                       `json:"version,omitempty"`
+	// The fields below are only set when getting the live query details.
+	// Docs The number of result documents.
+	Docs *int `json:"docs,omitempty"`
+	// Failed The number of agents that failed to run the query.
+	Failed *int `json:"failed,omitempty"`
+	// Pending The number of agents that did not respond yet.
+	Pending *int `json:"pending,omitempty"`
+	// Responded The number of agents that responded.
+	Responded *int `json:"responded,omitempty"`
+	// Successful The number of agents that ran the query successfully.
+	Successful *int `json:"successful,omitempty"`
+	// Status The status of the query action, running or completed.
+	Status *string `json:"status,omitempty"`
+}
+
+// OsqueryLiveQueryResult is a single row returned by an agent for a query action.
+type OsqueryLiveQueryResult struct {
+	ID     string                 `json:"_id"`
+	Index  string                 `json:"_index"`
+	Source map[string]interface{} `json:"_source"`
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+// OsquerySavedQuery is a saved query as returned by Kibana.
+type OsquerySavedQuery struct {
+	// SavedObjectID The saved object ID of the saved query, used to get, update or delete it.
+	SavedObjectID string                        `json:"saved_object_id"`
+	ID            string                        `json:"id"`
+	Description   *string                       `json:"description,omitempty"`
+	EcsMapping    *SecurityOsqueryAPIECSMapping `json:"ecs_mapping,omitempty"`
+	Interval      interface{}                   `json:"interval,omitempty"`
+	Platform      *string                       `json:"platform,omitempty"`
+	Prebuilt      *bool                         `json:"prebuilt,omitempty"`
+	Query         string                        `json:"query"`
+	Removed       *bool                         `json:"removed,omitempty"`
+	Snapshot      *bool                         `json:"snapshot,omitempty"`
+	Timeout       *int                          `json:"timeout,omitempty"`
+	Version       *string                       `json:"version,omitempty"`
+	CreatedAt     string                        `json:"created_at"`
+	CreatedBy     string                        `json:"created_by"`
+	UpdatedAt     string                        `json:"updated_at"`
+	UpdatedBy     string                        `json:"updated_by"`
+}
+
+// OsqueryPack is a query pack as returned by Kibana.
+type OsqueryPack struct {
+	// SavedObjectID The saved object ID of the pack, used to get, update or delete it.
+	SavedObjectID string                          `json:"saved_object_id"`
+	Name          string                          `json:"name"`
+	Description   *string                         `json:"description,omitempty"`
+	Enabled       bool                            `json:"enabled"`
+	PolicyIDs     []string                        `json:"policy_ids,omitempty"`
+	Queries       SecurityOsqueryAPIObjectQueries `json:"queries,omitempty"`
+	ReadOnly      *bool                           `json:"read_only,omitempty"`
+	Shards        SecurityOsqueryAPIShards        `json:"shards,omitempty"`
+	Version       *int                            `json:"version,omitempty"`
+	CreatedAt     string                          `json:"created_at"`
+	CreatedBy     string                          `json:"created_by"`
+	UpdatedAt     string                          `json:"updated_at"`
+	UpdatedBy     string                          `json:"updated_by"`
+}
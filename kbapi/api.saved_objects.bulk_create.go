@@ -0,0 +1,53 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SavedObjectBulkCreateResponse wraps the response from a SavedObjects.BulkCreate call
+type SavedObjectBulkCreateResponse Response[SavedObjectBulkResponseBody]
+
+type SavedObjectBulkCreateObject struct {
+	Type string `json:"type"`
+	// ID The ID of the object, generated when empty.
+	ID         string                 `json:"id,omitempty"`
+	Attributes any                    `json:"attributes"`
+	References []SavedObjectReference `json:"references,omitempty"`
+	// InitialNamespaces The spaces to create a multi-space object in, the current space when unset.
+	InitialNamespaces []string `json:"initialNamespaces,omitempty"`
+	// Version The version of the object to overwrite, the write fails when the object has changed.
+	Version *string `json:"version,omitempty"`
+}
+
+type SavedObjectBulkCreateRequest struct {
+	Params SavedObjectCreateRequestParams
+	Body   []SavedObjectBulkCreateObject
+}
+
+// newSavedObjectBulkCreate returns a function that performs POST /api/saved_objects/_bulk_create API requests
+func (api *API) newSavedObjectBulkCreate() func(context.Context, *SavedObjectBulkCreateRequest, ...RequestOption) (*SavedObjectBulkCreateResponse, error) {
+	return func(ctx context.Context, req *SavedObjectBulkCreateRequest, opts ...RequestOption) (*SavedObjectBulkCreateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Overwrite != nil {
+			params.Set("overwrite", strconv.FormatBool(*req.Params.Overwrite))
+		}
+
+		res, err := do[[]SavedObjectBulkCreateObject, SavedObjectBulkResponseBody](ctx, api, operation{
+			name:   "saved_objects.bulk_create",
+			method: http.MethodPost,
+			path:   "/api/saved_objects/_bulk_create",
+			query:  params,
+		}, &req.Body, opts)
+		return (*SavedObjectBulkCreateResponse)(res), err
+	}
+}
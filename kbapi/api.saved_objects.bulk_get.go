@@ -0,0 +1,45 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SavedObjectBulkGetResponse wraps the response from a SavedObjects.BulkGet call
+type SavedObjectBulkGetResponse Response[SavedObjectBulkResponseBody]
+
+// SavedObjectBulkResponseBody is returned by the bulk endpoints. Objects that
+// could not be read or written have their Error set.
+type SavedObjectBulkResponseBody struct {
+	SavedObjects []RawSavedObject `json:"saved_objects"`
+}
+
+type SavedObjectBulkGetObject struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	// Fields The attributes to return, all attributes when unset.
+	Fields []string `json:"fields,omitempty"`
+	// Namespaces The spaces to look for the object in, the current space when unset.
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+type SavedObjectBulkGetRequest struct {
+	Body []SavedObjectBulkGetObject
+}
+
+// newSavedObjectBulkGet returns a function that performs POST /api/saved_objects/_bulk_get API requests
+func (api *API) newSavedObjectBulkGet() func(context.Context, *SavedObjectBulkGetRequest, ...RequestOption) (*SavedObjectBulkGetResponse, error) {
+	return func(ctx context.Context, req *SavedObjectBulkGetRequest, opts ...RequestOption) (*SavedObjectBulkGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[[]SavedObjectBulkGetObject, SavedObjectBulkResponseBody](ctx, api, operation{
+			name:   "saved_objects.bulk_get",
+			method: http.MethodPost,
+			path:   "/api/saved_objects/_bulk_get",
+		}, &req.Body, opts)
+		return (*SavedObjectBulkGetResponse)(res), err
+	}
+}
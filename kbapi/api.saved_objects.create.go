@@ -0,0 +1,62 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SavedObjectCreateResponse wraps the response from a SavedObjects.Create call
+type SavedObjectCreateResponse Response[RawSavedObject]
+
+type SavedObjectCreateRequestParams struct {
+	// Overwrite Overwrites the object when it already exists.
+	Overwrite *bool
+}
+
+type SavedObjectCreateRequestBody struct {
+	// Attributes The attributes of the object, encoded to JSON.
+	Attributes any                    `json:"attributes"`
+	References []SavedObjectReference `json:"references,omitempty"`
+	// InitialNamespaces The spaces to create a multi-space object in, the current space when unset.
+	InitialNamespaces []string `json:"initialNamespaces,omitempty"`
+}
+
+type SavedObjectCreateRequest struct {
+	Type string
+	// ID The ID of the object, generated when empty.
+	ID     string
+	Params SavedObjectCreateRequestParams
+	Body   SavedObjectCreateRequestBody
+}
+
+// newSavedObjectCreate returns a function that performs POST /api/saved_objects/{type}/{id} API requests
+func (api *API) newSavedObjectCreate() func(context.Context, *SavedObjectCreateRequest, ...RequestOption) (*SavedObjectCreateResponse, error) {
+	return func(ctx context.Context, req *SavedObjectCreateRequest, opts ...RequestOption) (*SavedObjectCreateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Overwrite != nil {
+			params.Set("overwrite", strconv.FormatBool(*req.Params.Overwrite))
+		}
+
+		path := fmt.Sprintf("/api/saved_objects/%s", req.Type)
+		if req.ID != "" {
+			path = fmt.Sprintf("/api/saved_objects/%s/%s", req.Type, req.ID)
+		}
+
+		res, err := do[SavedObjectCreateRequestBody, RawSavedObject](ctx, api, operation{
+			name:   "saved_objects.create",
+			method: http.MethodPost,
+			path:   path,
+			query:  params,
+		}, &req.Body, opts)
+		return (*SavedObjectCreateResponse)(res), err
+	}
+}
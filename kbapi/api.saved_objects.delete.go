@@ -0,0 +1,60 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SavedObjectDeleteResponse wraps the response from a SavedObjects.Delete call
+type SavedObjectDeleteResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type SavedObjectDeleteRequestParams struct {
+	// Force Deletes a multi-space object from all of its spaces.
+	Force *bool
+}
+
+type SavedObjectDeleteRequest struct {
+	Type   string
+	ID     string
+	Params SavedObjectDeleteRequestParams
+}
+
+// newSavedObjectDelete returns a function that performs DELETE /api/saved_objects/{type}/{id} API requests
+func (api *API) newSavedObjectDelete() func(context.Context, *SavedObjectDeleteRequest, ...RequestOption) (*SavedObjectDeleteResponse, error) {
+	return func(ctx context.Context, req *SavedObjectDeleteRequest, opts ...RequestOption) (*SavedObjectDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Force != nil {
+			params.Set("force", strconv.FormatBool(*req.Params.Force))
+		}
+
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:   "saved_objects.delete",
+			method: http.MethodDelete,
+			path:   fmt.Sprintf("/api/saved_objects/%s/%s", req.Type, req.ID),
+			query:  params,
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &SavedObjectDeleteResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
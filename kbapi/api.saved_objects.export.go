@@ -1,7 +1,6 @@
 package kbapi
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -14,7 +13,7 @@ import (
 type SavedObjectExportResponse struct {
 	StatusCode int
 	Body       []json.RawMessage
-	Error      interface{}
+	Error      *Error
 	RawBody    io.ReadCloser
 }
 
@@ -88,114 +87,24 @@ func (api *API) newSavedObjectExport() func(context.Context, *SavedObjectExportR
 			return nil, fmt.Errorf("Request body is not defined")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "saved_objects.export")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/saved_objects/_export"
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		jsonBody, err := json.Marshal(req.Body)
-		if err != nil {
-			return nil, err
-		}
-
-		httpReq.Body = io.NopCloser(bytes.NewReader(jsonBody))
-		httpReq.Header.Set("Content-Type", "application/json")
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "saved_objects.export")
-			if reader := instrument.RecordRequestBody(ctx, "connector.post", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
+		res, err := do[SavedObjectExportRequestBody, []byte](ctx, api, operation{
+			name:   "saved_objects.export",
+			method: http.MethodPost,
+			path:   "/api/saved_objects/_export",
+		}, &req.Body, opts)
+		if res == nil {
 			return nil, err
 		}
 
-		// Prepare response
 		resp := &SavedObjectExportResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
 		}
-
-		bodyBytes, err := io.ReadAll(httpResp.Body)
-		httpResp.Body.Close()
-		if err != nil {
-			return nil, fmt.Errorf("failed to read response body: %v", err)
+		if res.Body != nil {
+			resp.Body = splitNDJSON(*res.Body)
 		}
-
-		resp.RawBody = io.NopCloser(bytes.NewReader(bodyBytes))
-
-		if httpResp.StatusCode == http.StatusOK {
-			var objects []json.RawMessage
-			lines := bytes.Split(bodyBytes, []byte("\n"))
-
-			for _, line := range lines {
-				if len(bytes.TrimSpace(line)) == 0 {
-					continue
-				}
-
-				if bytes.HasPrefix(line, []byte(",")) {
-					line = line[1:]
-				}
-
-				objects = append(objects, json.RawMessage(line))
-			}
-
-			resp.Body = objects
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
-
+		return resp, err
 	}
 }
 
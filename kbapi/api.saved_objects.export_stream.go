@@ -0,0 +1,47 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SavedObjectExportStreamResponse wraps the response from a SavedObjects.ExportStream call. The export is read from Stream, which must be closed.
+type SavedObjectExportStreamResponse struct {
+	StatusCode int
+	Stream     *NDJSONStream[RawSavedObject, SavedObjectExportDetails]
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+// newSavedObjectExportStream returns a function that performs POST /api/saved_objects/_export API requests
+// and streams the exported objects
+func (api *API) newSavedObjectExportStream() func(context.Context, *SavedObjectExportRequest, ...RequestOption) (*SavedObjectExportStreamResponse, error) {
+	return func(ctx context.Context, req *SavedObjectExportRequest, opts ...RequestOption) (*SavedObjectExportStreamResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SavedObjectExportRequestBody, noBody](ctx, api, operation{
+			name:   "saved_objects.export_stream",
+			method: http.MethodPost,
+			path:   "/api/saved_objects/_export",
+			stream: true,
+		}, &req.Body, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		resp := &SavedObjectExportStreamResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+		}
+		if res.Error != nil {
+			resp.RawBody = res.RawBody
+		} else {
+			resp.Stream = newNDJSONStream[RawSavedObject, SavedObjectExportDetails](res.RawBody, "exportedCount")
+		}
+		return resp, err
+	}
+}
@@ -0,0 +1,59 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SavedObjectExportToResponse wraps the response from a SavedObjects.ExportTo call
+type SavedObjectExportToResponse struct {
+	StatusCode int
+	// Details The export details, nil when they were excluded from the export.
+	Details *SavedObjectExportDetails
+	Error   *Error
+	RawBody io.ReadCloser
+}
+
+type SavedObjectExportToRequest struct {
+	Body SavedObjectExportRequestBody
+	// Output receives the exported objects as NDJSON as they are read, the
+	// export details are returned in Details instead.
+	Output io.Writer
+}
+
+// newSavedObjectExportTo returns a function that performs POST /api/saved_objects/_export API requests
+// and writes the export to an io.Writer
+func (api *API) newSavedObjectExportTo() func(context.Context, *SavedObjectExportToRequest, ...RequestOption) (*SavedObjectExportToResponse, error) {
+	return func(ctx context.Context, req *SavedObjectExportToRequest, opts ...RequestOption) (*SavedObjectExportToResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+		if req.Output == nil {
+			return nil, fmt.Errorf("Output cannot be nil")
+		}
+
+		output := &exportWriter{w: req.Output}
+		res, err := do[SavedObjectExportRequestBody, noBody](ctx, api, operation{
+			name:   "saved_objects.export_to",
+			method: http.MethodPost,
+			path:   "/api/saved_objects/_export",
+			output: output,
+		}, &req.Body, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		resp := &SavedObjectExportToResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}
+		if err != nil {
+			return resp, err
+		}
+		resp.Details, err = exportWriterDetails[SavedObjectExportDetails](output, "exportedCount")
+		return resp, err
+	}
+}
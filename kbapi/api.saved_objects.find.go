@@ -0,0 +1,164 @@
+package kbapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SavedObjectFindResponse wraps the response from a SavedObjects.Find call
+type SavedObjectFindResponse Response[SavedObjectFindResponseBody]
+
+type SavedObjectFindResponseBody struct {
+	Page         int              `json:"page"`
+	PerPage      int              `json:"per_page"`
+	Total        int              `json:"total"`
+	SavedObjects []RawSavedObject `json:"saved_objects"`
+}
+
+type SavedObjectFindRequestParams struct {
+	// Types The saved object types to search for, at least one is required.
+	Types   []string
+	Page    *int
+	PerPage *int
+	// Search A simple_query_string query matched against SearchFields, e.g. my-dashboard*.
+	Search                *string
+	DefaultSearchOperator *string
+	// SearchFields The attributes Search is matched against, e.g. title.
+	SearchFields []string
+	// Fields The attributes to return, all attributes when unset.
+	Fields    []string
+	SortField *string
+	// HasReference Only objects referencing one of these objects are returned, e.g. {Type: "index-pattern", ID: "logs-*"}.
+	HasReference []Object
+	// HasReferenceOperator Whether objects must reference any (OR) or all (AND) of HasReference.
+	HasReferenceOperator *string
+	// HasNoReference Only objects not referencing these objects are returned.
+	HasNoReference         []Object
+	HasNoReferenceOperator *string
+	// Filter A KQL filter on the attributes, e.g. dashboard.attributes.title: "My dashboard".
+	Filter *string
+	// Namespaces The spaces to search in, the current space when unset. Use * for all spaces.
+	Namespaces []string
+}
+
+type SavedObjectFindRequest struct {
+	Params SavedObjectFindRequestParams
+}
+
+// newSavedObjectFind returns a function that performs GET /api/saved_objects/_find API requests
+func (api *API) newSavedObjectFind() func(context.Context, *SavedObjectFindRequest, ...RequestOption) (*SavedObjectFindResponse, error) {
+	return func(ctx context.Context, req *SavedObjectFindRequest, opts ...RequestOption) (*SavedObjectFindResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		for _, v := range req.Params.Types {
+			params.Add("type", v)
+		}
+		if req.Params.Page != nil {
+			params.Set("page", strconv.Itoa(*req.Params.Page))
+		}
+		if req.Params.PerPage != nil {
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
+		}
+		if req.Params.Search != nil {
+			params.Set("search", *req.Params.Search)
+		}
+		if req.Params.DefaultSearchOperator != nil {
+			params.Set("default_search_operator", *req.Params.DefaultSearchOperator)
+		}
+		for _, v := range req.Params.SearchFields {
+			params.Add("search_fields", v)
+		}
+		for _, v := range req.Params.Fields {
+			params.Add("fields", v)
+		}
+		if req.Params.SortField != nil {
+			params.Set("sort_field", *req.Params.SortField)
+		}
+		if req.Params.HasReferenceOperator != nil {
+			params.Set("has_reference_operator", *req.Params.HasReferenceOperator)
+		}
+		if req.Params.HasNoReferenceOperator != nil {
+			params.Set("has_no_reference_operator", *req.Params.HasNoReferenceOperator)
+		}
+		if req.Params.Filter != nil {
+			params.Set("filter", *req.Params.Filter)
+		}
+		for _, v := range req.Params.Namespaces {
+			params.Add("namespaces", v)
+		}
+
+		if len(req.Params.HasReference) > 0 {
+			hasReference, err := json.Marshal(req.Params.HasReference)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal has_reference: %w", err)
+			}
+			params.Set("has_reference", string(hasReference))
+		}
+		if len(req.Params.HasNoReference) > 0 {
+			hasNoReference, err := json.Marshal(req.Params.HasNoReference)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal has_no_reference: %w", err)
+			}
+			params.Set("has_no_reference", string(hasNoReference))
+		}
+
+		res, err := do[noBody, SavedObjectFindResponseBody](ctx, api, operation{
+			name:   "saved_objects.find",
+			method: http.MethodGet,
+			path:   "/api/saved_objects/_find",
+			query:  params,
+		}, nil, opts)
+		return (*SavedObjectFindResponse)(res), err
+	}
+}
+
+// SavedObjectsResultWindow is the number of saved objects that Find can page
+// through, page * per_page cannot exceed it.
+const SavedObjectsResultWindow = 10000
+
+// ErrResultWindowExceeded is returned by SavedObjects.Pages and All instead of
+// requesting a page past SavedObjectsResultWindow, which Kibana rejects.
+var ErrResultWindowExceeded = errors.New("the saved objects find API cannot page past 10000 objects, narrow the search or use ExportTo")
+
+// All returns an iterator over all saved objects matching req. Pages of
+// req.Params.PerPage items, or DefaultPerPage when unset, are fetched on demand.
+// Use DecodeSavedObjects to decode their attributes. The iteration fails with
+// ErrResultWindowExceeded past SavedObjectsResultWindow objects.
+func (s SavedObjects) All(ctx context.Context, req *SavedObjectFindRequest, opts ...RequestOption) iter.Seq2[RawSavedObject, error] {
+	return items(s.Pages(ctx, req, opts...))
+}
+
+// Pages returns an iterator over the pages of saved objects matching req.
+// The find API has no cursor, so the iteration fails with
+// ErrResultWindowExceeded when more than SavedObjectsResultWindow objects
+// match. Export streams every object instead, see ExportTo.
+func (s SavedObjects) Pages(ctx context.Context, req *SavedObjectFindRequest, opts ...RequestOption) iter.Seq2[*Page[RawSavedObject], error] {
+	var params SavedObjectFindRequestParams
+	if req != nil {
+		params = req.Params
+	}
+
+	return pages(ctx, intValue(params.Page, 1), intValue(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[RawSavedObject], error) {
+		if page*perPage > SavedObjectsResultWindow {
+			return nil, fmt.Errorf("page %d of %d objects: %w", page, perPage, ErrResultWindowExceeded)
+		}
+		params.Page = IntPtr(page)
+		params.PerPage = IntPtr(perPage)
+		resp, err := s.Find(ctx, &SavedObjectFindRequest{Params: params}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &Page[RawSavedObject]{Items: resp.Body.SavedObjects, Page: resp.Body.Page, PerPage: resp.Body.PerPage, Total: resp.Body.Total}, nil
+	})
+}
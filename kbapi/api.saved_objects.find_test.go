@@ -0,0 +1,130 @@
+package kbapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testVisualization struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+func TestSavedObjects_Find(t *testing.T) {
+	mockTransport := NewMockTransportWithRawResponse(200, `{
+		"page": 1,
+		"per_page": 20,
+		"total": 1,
+		"saved_objects": [{
+			"id": "vis-1",
+			"type": "visualization",
+			"attributes": {"title": "Requests", "description": "Requests per host"},
+			"references": [{"id": "logs-*", "name": "kibanaSavedObjectMeta.searchSourceJSON.index", "type": "index-pattern"}],
+			"namespaces": ["default"],
+			"version": "WzEsMV0="
+		}]
+	}`, nil)
+	api := New(mockTransport)
+
+	resp, err := api.SavedObjects.Find(context.Background(), &SavedObjectFindRequest{
+		Params: SavedObjectFindRequestParams{
+			Types:        []string{"visualization"},
+			Search:       StrPtr("Req*"),
+			SearchFields: []string{"title", "description"},
+			HasReference: []Object{{Type: "index-pattern", ID: "logs-*"}},
+			Namespaces:   []string{"default", "ops"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Body.SavedObjects, 1)
+
+	req := mockTransport.LastRequest()
+	AssertRequestMethod(t, req, "GET")
+	AssertRequestPath(t, req, "/api/saved_objects/_find")
+	AssertRequestParam(t, req, "type", "visualization")
+	AssertRequestParam(t, req, "has_reference", `[{"id":"logs-*","type":"index-pattern"}]`)
+	assert.Equal(t, []string{"title", "description"}, req.URL.Query()["search_fields"])
+	assert.Equal(t, []string{"default", "ops"}, req.URL.Query()["namespaces"])
+
+	vis, err := DecodeSavedObject[testVisualization](resp.Body.SavedObjects[0])
+	require.NoError(t, err)
+	assert.Equal(t, "vis-1", vis.ID)
+	assert.Equal(t, testVisualization{Title: "Requests", Description: "Requests per host"}, vis.Attributes)
+	assert.Equal(t, "index-pattern", vis.References[0].Type)
+	assert.Equal(t, "WzEsMV0=", *vis.Version)
+}
+
+func TestSavedObjects_All(t *testing.T) {
+	objects := []RawSavedObject{
+		{ID: "1", Type: "visualization", Attributes: json.RawMessage(`{"title":"one"}`)},
+		{ID: "2", Type: "visualization", Attributes: json.RawMessage(`{"title":"two"}`)},
+		{ID: "3", Type: "visualization", Attributes: json.RawMessage(`{"title":"three"}`)},
+	}
+
+	var requestedPages []string
+	api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+		page := req.URL.Query().Get("page")
+		requestedPages = append(requestedPages, page)
+		body := SavedObjectFindResponseBody{PerPage: 2, Total: len(objects)}
+		if page == "1" {
+			body.Page, body.SavedObjects = 1, objects[:2]
+		} else {
+			body.Page, body.SavedObjects = 2, objects[2:]
+		}
+		return jsonResponse(t, 200, body), nil
+	}))
+
+	var titles []string
+	seq := api.SavedObjects.All(context.Background(), &SavedObjectFindRequest{
+		Params: SavedObjectFindRequestParams{Types: []string{"visualization"}, PerPage: IntPtr(2)},
+	})
+	for vis, err := range DecodeSavedObjects[testVisualization](seq) {
+		require.NoError(t, err)
+		titles = append(titles, vis.Attributes.Title)
+	}
+	assert.Equal(t, []string{"one", "two", "three"}, titles)
+	assert.Equal(t, []string{"1", "2"}, requestedPages)
+}
+
+func TestSavedObjects_AllResultWindow(t *testing.T) {
+	var requests int
+	api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+		requests++
+		objects := make([]RawSavedObject, 5000)
+		return jsonResponse(t, 200, SavedObjectFindResponseBody{PerPage: 5000, Total: 12000, SavedObjects: objects}), nil
+	}))
+
+	var count int
+	var err error
+	for _, err = range api.SavedObjects.All(context.Background(), &SavedObjectFindRequest{
+		Params: SavedObjectFindRequestParams{Types: []string{"dashboard"}, PerPage: IntPtr(5000)},
+	}) {
+		if err != nil {
+			break
+		}
+		count++
+	}
+	require.ErrorIs(t, err, ErrResultWindowExceeded)
+	assert.Equal(t, 10000, count)
+	assert.Equal(t, 2, requests)
+}
+
+func TestSavedObjects_BulkGetError(t *testing.T) {
+	mockTransport := NewMockTransportWithRawResponse(200, `{"saved_objects": [
+		{"id": "missing", "type": "dashboard", "error": {"statusCode": 404, "error": "Not Found", "message": "Saved object [dashboard/missing] not found"}}
+	]}`, nil)
+	api := New(mockTransport)
+
+	resp, err := api.SavedObjects.BulkGet(context.Background(), &SavedObjectBulkGetRequest{
+		Body: []SavedObjectBulkGetObject{{Type: "dashboard", ID: "missing"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Body.SavedObjects, 1)
+	assert.True(t, IsNotFound(resp.Body.SavedObjects[0].Error))
+	AssertRequestBodyJSON(t, mockTransport.LastRequest(), []interface{}{map[string]interface{}{"type": "dashboard", "id": "missing"}})
+}
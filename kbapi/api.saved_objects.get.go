@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SavedObjectGetResponse wraps the response from a SavedObjects.Get call
+type SavedObjectGetResponse Response[RawSavedObject]
+
+type SavedObjectGetRequest struct {
+	Type string
+	ID   string
+}
+
+// newSavedObjectGet returns a function that performs GET /api/saved_objects/{type}/{id} API requests
+func (api *API) newSavedObjectGet() func(context.Context, *SavedObjectGetRequest, ...RequestOption) (*SavedObjectGetResponse, error) {
+	return func(ctx context.Context, req *SavedObjectGetRequest, opts ...RequestOption) (*SavedObjectGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, RawSavedObject](ctx, api, operation{
+			name:   "saved_objects.get",
+			method: http.MethodGet,
+			path:   fmt.Sprintf("/api/saved_objects/%s/%s", req.Type, req.ID),
+		}, nil, opts)
+		return (*SavedObjectGetResponse)(res), err
+	}
+}
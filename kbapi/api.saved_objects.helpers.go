@@ -0,0 +1,332 @@
+package kbapi
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+)
+
+// Policies applied by ImportWithResolution to objects that conflict with existing objects.
+const (
+	// SavedObjectImportSkip leaves the existing objects unchanged and does not import the conflicting objects.
+	SavedObjectImportSkip = "skip"
+	// SavedObjectImportOverwrite overwrites the existing objects.
+	SavedObjectImportOverwrite = "overwrite"
+	// SavedObjectImportCreateNewCopy imports the conflicting objects as new copies with a new ID.
+	SavedObjectImportCreateNewCopy = "create_new_copy"
+)
+
+// Outcomes of an object imported with ImportWithResolution.
+const (
+	SavedObjectImportStatusCreated     = "created"
+	SavedObjectImportStatusOverwritten = "overwritten"
+	SavedObjectImportStatusCopied      = "copied"
+	SavedObjectImportStatusSkipped     = "skipped"
+	SavedObjectImportStatusFailed      = "failed"
+)
+
+// maxImportResolutionRounds bounds the number of resolve import errors calls
+// made by ImportWithResolution. Every round resolves at least one more error.
+const maxImportResolutionRounds = 10
+
+type SavedObjectImportWithResolutionRequest struct {
+	// File A file exported using the export API.
+	File []byte
+	// OnConflict One of the SavedObjectImport* policies, SavedObjectImportSkip when empty.
+	OnConflict string
+	// ReplaceReferences Remaps missing references to existing objects. The key is
+	// the missing object, the value the ID of the object to reference instead.
+	ReplaceReferences map[Object]string
+	// IgnoreMissingReferences Imports objects with missing references that are not
+	// remapped by ReplaceReferences. They fail otherwise.
+	IgnoreMissingReferences bool
+	// CompatibilityMode Applies adjustments to objects exported from other Kibana versions.
+	CompatibilityMode *bool
+	// ResolveAmbiguousConflict Picks the existing object to overwrite when an object
+	// conflicts with several existing objects, and reports false to leave it unresolved.
+	// Ambiguous conflicts are reported as failed when it is nil.
+	ResolveAmbiguousConflict func(obj Object, destinations []SavedObjectImportDestination) (string, bool)
+}
+
+// SavedObjectImportReport is the outcome of ImportWithResolution for every object of the file.
+type SavedObjectImportReport struct {
+	Objects []SavedObjectImportResult
+}
+
+// SavedObjectImportResult is the outcome of importing one object.
+type SavedObjectImportResult struct {
+	ID    string
+	Type  string
+	Title string
+	// Status One of the SavedObjectImportStatus* values.
+	Status string
+	// DestinationID The ID of the object in Kibana, when it differs from ID.
+	DestinationID string
+	// Errors The errors that were not resolved, set when the object was skipped or failed.
+	Errors []SavedObjectImportCause
+}
+
+// Count returns the number of objects with the given status.
+func (r *SavedObjectImportReport) Count(status string) int {
+	var n int
+	for _, obj := range r.Objects {
+		if obj.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// ImportWithResolution imports the objects of req.File and resolves the import
+// errors according to req. Conflicts are resolved with req.OnConflict, except
+// that overwriting an object that conflicts with several existing objects
+// requires req.ResolveAmbiguousConflict to pick one of them. Missing
+// references are remapped with req.ReplaceReferences or skipped with
+// req.IgnoreMissingReferences.
+// Resolve import errors is called until no resolvable error is left, and the
+// outcome of every object is returned. Objects that cannot be imported are
+// reported as skipped or failed, an error is only returned when a request fails.
+func (s SavedObjects) ImportWithResolution(ctx context.Context, req *SavedObjectImportWithResolutionRequest, opts ...RequestOption) (*SavedObjectImportReport, error) {
+	if req == nil {
+		return nil, fmt.Errorf("Request cannot be nil")
+	}
+
+	policy := req.OnConflict
+	switch policy {
+	case "":
+		policy = SavedObjectImportSkip
+	case SavedObjectImportSkip, SavedObjectImportOverwrite, SavedObjectImportCreateNewCopy:
+	default:
+		return nil, fmt.Errorf("unknown conflict policy %q", policy)
+	}
+
+	params := PostSavedObjectImportResponseParams{CompatibilityMode: req.CompatibilityMode}
+	if policy == SavedObjectImportOverwrite {
+		params.Overwrite = BoolPtr(true)
+	}
+	imported, err := s.Import(ctx, &SavedObjectImportRequest{Params: params, Body: SavedObjectImportRequestBody{File: req.File}}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	report := newImportReport()
+	report.addSuccesses(imported.Body.SuccessResults)
+	errs := imported.Body.Errors
+
+	retries := map[Object]*SavedObjectRetryOperationItem{}
+	// givenUp holds the objects that were skipped or failed
+	givenUp := map[Object]bool{}
+	for round := 0; len(errs) > 0; round++ {
+		changed := false
+		for _, importErr := range errs {
+			key := Object{Type: importErr.Type, ID: importErr.ID}
+			if givenUp[key] {
+				report.fail(importErr, SavedObjectImportStatusSkipped)
+				continue
+			}
+			retry := retries[key]
+			if retry == nil {
+				retry = &SavedObjectRetryOperationItem{ID: importErr.ID, Type: importErr.Type}
+			}
+
+			status, ok, err := resolveImportError(retry, importErr.Error, policy, req)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				delete(retries, key)
+				givenUp[key] = true
+				report.fail(importErr, status)
+				continue
+			}
+			if retries[key] == nil {
+				retries[key] = retry
+			}
+			changed = true
+		}
+
+		if !changed || len(retries) == 0 {
+			break
+		}
+		if round == maxImportResolutionRounds {
+			return nil, fmt.Errorf("import errors were not resolved after %d attempts", maxImportResolutionRounds)
+		}
+
+		items := make([]SavedObjectRetryOperationItem, 0, len(retries))
+		for _, retry := range retries {
+			items = append(items, *retry)
+		}
+		resolved, err := s.ResolveImport(ctx, &SavedObjectResolveImportsRequest{
+			Params: SavedObjectResolveImportsRequestParams{CompatibilityMode: req.CompatibilityMode},
+			Body:   SavedObjectResolveImportsRequestBody{File: req.File, Retries: items},
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		report.addSuccesses(resolved.Body.SuccessResults)
+		errs = resolved.Body.Errors
+		for _, success := range resolved.Body.SuccessResults {
+			delete(retries, Object{Type: success.Type, ID: success.ID})
+		}
+	}
+
+	return report.build(), nil
+}
+
+// resolveImportError updates retry to resolve cause. It reports false with
+// the status of the object when cause cannot be resolved, and true when
+// retry changed and the object must be retried.
+func resolveImportError(retry *SavedObjectRetryOperationItem, cause SavedObjectImportCause, policy string, req *SavedObjectImportWithResolutionRequest) (string, bool, error) {
+	switch cause.Type {
+	case SavedObjectImportErrorConflict, SavedObjectImportErrorAmbiguousConflict:
+		if retry.Overwrite != nil || retry.CreateNewCopy != nil {
+			// Already retried with the policy, Kibana reported the conflict again
+			return SavedObjectImportStatusFailed, false, nil
+		}
+		switch policy {
+		case SavedObjectImportSkip:
+			return SavedObjectImportStatusSkipped, false, nil
+		case SavedObjectImportOverwrite:
+			if cause.Type == SavedObjectImportErrorAmbiguousConflict {
+				if req.ResolveAmbiguousConflict == nil {
+					return SavedObjectImportStatusFailed, false, nil
+				}
+				id, ok := req.ResolveAmbiguousConflict(Object{Type: retry.Type, ID: retry.ID}, cause.Destinations)
+				if !ok {
+					return SavedObjectImportStatusFailed, false, nil
+				}
+				retry.DestinationID = StrPtr(id)
+			} else if cause.DestinationID != nil {
+				retry.DestinationID = cause.DestinationID
+			}
+			retry.Overwrite = BoolPtr(true)
+		case SavedObjectImportCreateNewCopy:
+			id, err := newUUID()
+			if err != nil {
+				return "", false, err
+			}
+			retry.CreateNewCopy = BoolPtr(true)
+			retry.DestinationID = StrPtr(id)
+		}
+		return "", true, nil
+
+	case SavedObjectImportErrorMissingReferences:
+		replaced := map[Object]bool{}
+		if retry.ReplaceReferences != nil {
+			for _, r := range *retry.ReplaceReferences {
+				replaced[Object{Type: *r.Type, ID: *r.From}] = true
+			}
+		}
+
+		var replace []SavedObjectReplaceReference
+		missing := false
+		for _, ref := range cause.References {
+			if replaced[ref] {
+				// Already remapped, the destination does not exist either
+				missing = true
+				continue
+			}
+			to, ok := req.ReplaceReferences[ref]
+			if !ok {
+				missing = true
+				continue
+			}
+			replace = append(replace, SavedObjectReplaceReference{Type: StrPtr(ref.Type), From: StrPtr(ref.ID), To: StrPtr(to)})
+		}
+
+		if missing && !req.IgnoreMissingReferences {
+			return SavedObjectImportStatusFailed, false, nil
+		}
+		if len(replace) == 0 && (!missing || retry.IgnoreMissingReferences != nil) {
+			return SavedObjectImportStatusFailed, false, nil
+		}
+		if len(replace) > 0 {
+			if retry.ReplaceReferences != nil {
+				replace = append(*retry.ReplaceReferences, replace...)
+			}
+			retry.ReplaceReferences = &replace
+		}
+		if missing {
+			retry.IgnoreMissingReferences = BoolPtr(true)
+		}
+		return "", true, nil
+	}
+
+	return SavedObjectImportStatusFailed, false, nil
+}
+
+// importReport collects the outcome of every object across the import and
+// resolve import errors calls, in the order the objects were first seen.
+type importReport struct {
+	order   []Object
+	results map[Object]*SavedObjectImportResult
+}
+
+func newImportReport() *importReport {
+	return &importReport{results: map[Object]*SavedObjectImportResult{}}
+}
+
+func (r *importReport) get(key Object) *SavedObjectImportResult {
+	result, ok := r.results[key]
+	if !ok {
+		result = &SavedObjectImportResult{ID: key.ID, Type: key.Type}
+		r.results[key] = result
+		r.order = append(r.order, key)
+	}
+	return result
+}
+
+func (r *importReport) addSuccesses(successes []SavedObjectImportSuccess) {
+	for _, success := range successes {
+		result := r.get(Object{Type: success.Type, ID: success.ID})
+		if success.Meta.Title != nil {
+			result.Title = *success.Meta.Title
+		}
+		result.Errors = nil
+		result.Status = SavedObjectImportStatusCreated
+		switch {
+		case success.CreateNewCopy != nil && *success.CreateNewCopy:
+			result.Status = SavedObjectImportStatusCopied
+		case success.Overwrite != nil && *success.Overwrite:
+			result.Status = SavedObjectImportStatusOverwritten
+		}
+		if success.DestinationID != nil && *success.DestinationID != success.ID {
+			result.DestinationID = *success.DestinationID
+		}
+	}
+}
+
+func (r *importReport) fail(importErr SavedObjectImportError, status string) {
+	result := r.get(Object{Type: importErr.Type, ID: importErr.ID})
+	switch {
+	case importErr.Meta.Title != nil:
+		result.Title = *importErr.Meta.Title
+	case importErr.Title != nil:
+		result.Title = *importErr.Title
+	}
+	result.Errors = append(result.Errors, importErr.Error)
+	// A failure takes precedence over a skip when an object has both
+	if result.Status != SavedObjectImportStatusFailed {
+		result.Status = status
+	}
+}
+
+func (r *importReport) build() *SavedObjectImportReport {
+	report := &SavedObjectImportReport{Objects: make([]SavedObjectImportResult, 0, len(r.order))}
+	for _, key := range r.order {
+		report.Objects = append(report.Objects, *r.results[key])
+	}
+	return report
+}
+
+// newUUID returns a random version 4 UUID.
+func newUUID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("failed to generate ID: %w", err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,172 @@
+package kbapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedObjects_ImportWithResolution(t *testing.T) {
+	file := []byte(`{"id":"dash-1","type":"dashboard"}` + "\n" + `{"id":"vis-1","type":"visualization"}` + "\n" + `{"id":"vis-2","type":"visualization"}` + "\n" + `{"id":"x-1","type":"unknown-type"}`)
+
+	var retries [][]SavedObjectRetryOperationItem
+	api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+		require.NoError(t, req.ParseMultipartForm(1<<20))
+		uploaded, _, err := req.FormFile("file")
+		require.NoError(t, err)
+		uploaded.Close()
+
+		switch req.URL.Path {
+		case "/api/saved_objects/_import":
+			assert.Empty(t, req.URL.Query().Get("overwrite"))
+			return jsonResponse(t, 200, map[string]interface{}{
+				"success":      false,
+				"successCount": 1,
+				"successResults": []map[string]interface{}{
+					{"id": "vis-2", "type": "visualization", "meta": map[string]interface{}{"title": "Hosts"}},
+				},
+				"errors": []map[string]interface{}{
+					{"id": "dash-1", "type": "dashboard", "meta": map[string]interface{}{"title": "Overview"}, "error": map[string]interface{}{"type": "conflict", "destinationId": "dash-1"}},
+					{"id": "vis-1", "type": "visualization", "meta": map[string]interface{}{"title": "Requests"}, "error": map[string]interface{}{
+						"type": "missing_references", "references": []map[string]interface{}{{"type": "index-pattern", "id": "old-logs"}},
+					}},
+					{"id": "x-1", "type": "unknown-type", "error": map[string]interface{}{"type": "unsupported_type"}},
+				},
+			}), nil
+		case "/api/saved_objects/_resolve_import_errors":
+			var items []SavedObjectRetryOperationItem
+			require.NoError(t, json.Unmarshal([]byte(req.FormValue("retries")), &items))
+			retries = append(retries, items)
+			return jsonResponse(t, 200, map[string]interface{}{
+				"success":      true,
+				"successCount": 1,
+				"successResults": []map[string]interface{}{
+					{"id": "vis-1", "type": "visualization", "meta": map[string]interface{}{"title": "Requests"}},
+				},
+			}), nil
+		}
+		t.Fatalf("unexpected request %s", req.URL.Path)
+		return nil, nil
+	}))
+
+	report, err := api.SavedObjects.ImportWithResolution(context.Background(), &SavedObjectImportWithResolutionRequest{
+		File:              file,
+		OnConflict:        SavedObjectImportSkip,
+		ReplaceReferences: map[Object]string{{Type: "index-pattern", ID: "old-logs"}: "logs-*"},
+	})
+	require.NoError(t, err)
+
+	// The skipped conflict is not retried
+	require.Len(t, retries, 1)
+	require.Len(t, retries[0], 1)
+	retry := retries[0][0]
+	assert.Equal(t, "vis-1", retry.ID)
+	require.NotNil(t, retry.ReplaceReferences)
+	assert.Equal(t, "logs-*", *(*retry.ReplaceReferences)[0].To)
+	assert.Nil(t, retry.IgnoreMissingReferences)
+
+	statuses := map[string]string{}
+	for _, obj := range report.Objects {
+		statuses[obj.Type+"/"+obj.ID] = obj.Status
+	}
+	assert.Equal(t, map[string]string{
+		"visualization/vis-2": SavedObjectImportStatusCreated,
+		"dashboard/dash-1":    SavedObjectImportStatusSkipped,
+		"visualization/vis-1": SavedObjectImportStatusCreated,
+		"unknown-type/x-1":    SavedObjectImportStatusFailed,
+	}, statuses)
+	assert.Equal(t, 2, report.Count(SavedObjectImportStatusCreated))
+	assert.Equal(t, "Hosts", report.Objects[0].Title)
+}
+
+func TestSavedObjects_ImportWithResolutionCreateNewCopy(t *testing.T) {
+	var retries []SavedObjectRetryOperationItem
+	api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+		require.NoError(t, req.ParseMultipartForm(1<<20))
+		if req.URL.Path == "/api/saved_objects/_import" {
+			return jsonResponse(t, 200, map[string]interface{}{
+				"errors": []map[string]interface{}{
+					{"id": "dash-1", "type": "dashboard", "error": map[string]interface{}{
+						"type": "ambiguous_conflict", "destinations": []map[string]interface{}{{"id": "a"}, {"id": "b"}},
+					}},
+				},
+			}), nil
+		}
+		require.NoError(t, json.Unmarshal([]byte(req.FormValue("retries")), &retries))
+		return jsonResponse(t, 200, map[string]interface{}{
+			"successResults": []map[string]interface{}{
+				{"id": "dash-1", "type": "dashboard", "createNewCopy": true, "destinationId": *retries[0].DestinationID},
+			},
+		}), nil
+	}))
+
+	report, err := api.SavedObjects.ImportWithResolution(context.Background(), &SavedObjectImportWithResolutionRequest{
+		File:       []byte(`{"id":"dash-1","type":"dashboard"}`),
+		OnConflict: SavedObjectImportCreateNewCopy,
+	})
+	require.NoError(t, err)
+	require.Len(t, retries, 1)
+	assert.True(t, *retries[0].CreateNewCopy)
+	assert.Len(t, *retries[0].DestinationID, 36)
+
+	require.Len(t, report.Objects, 1)
+	assert.Equal(t, SavedObjectImportStatusCopied, report.Objects[0].Status)
+	assert.Equal(t, *retries[0].DestinationID, report.Objects[0].DestinationID)
+}
+
+func TestSavedObjects_ImportWithResolutionAmbiguousOverwrite(t *testing.T) {
+	newAPI := func(retries *[]SavedObjectRetryOperationItem) *API {
+		return New(funcTransport(func(req *http.Request) (*http.Response, error) {
+			require.NoError(t, req.ParseMultipartForm(1<<20))
+			if req.URL.Path == "/api/saved_objects/_import" {
+				return jsonResponse(t, 200, map[string]interface{}{
+					"errors": []map[string]interface{}{
+						{"id": "dash-1", "type": "dashboard", "error": map[string]interface{}{
+							"type": "ambiguous_conflict", "destinations": []map[string]interface{}{{"id": "a"}, {"id": "b"}},
+						}},
+					},
+				}), nil
+			}
+			require.NoError(t, json.Unmarshal([]byte(req.FormValue("retries")), retries))
+			return jsonResponse(t, 200, map[string]interface{}{
+				"successResults": []map[string]interface{}{
+					{"id": "dash-1", "type": "dashboard", "overwrite": true, "destinationId": *(*retries)[0].DestinationID},
+				},
+			}), nil
+		}))
+	}
+
+	// Without a resolver, the conflict is not resolved
+	var retries []SavedObjectRetryOperationItem
+	report, err := newAPI(&retries).SavedObjects.ImportWithResolution(context.Background(), &SavedObjectImportWithResolutionRequest{
+		File:       []byte(`{"id":"dash-1","type":"dashboard"}`),
+		OnConflict: SavedObjectImportOverwrite,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, retries)
+	require.Len(t, report.Objects, 1)
+	assert.Equal(t, SavedObjectImportStatusFailed, report.Objects[0].Status)
+	require.Len(t, report.Objects[0].Errors, 1)
+	assert.Equal(t, SavedObjectImportErrorAmbiguousConflict, report.Objects[0].Errors[0].Type)
+
+	// The resolver picks the object to overwrite
+	report, err = newAPI(&retries).SavedObjects.ImportWithResolution(context.Background(), &SavedObjectImportWithResolutionRequest{
+		File:       []byte(`{"id":"dash-1","type":"dashboard"}`),
+		OnConflict: SavedObjectImportOverwrite,
+		ResolveAmbiguousConflict: func(obj Object, destinations []SavedObjectImportDestination) (string, bool) {
+			assert.Equal(t, Object{Type: "dashboard", ID: "dash-1"}, obj)
+			return destinations[1].ID, true
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, retries, 1)
+	assert.True(t, *retries[0].Overwrite)
+	assert.Equal(t, "b", *retries[0].DestinationID)
+	require.Len(t, report.Objects, 1)
+	assert.Equal(t, SavedObjectImportStatusOverwritten, report.Objects[0].Status)
+	assert.Equal(t, "b", report.Objects[0].DestinationID)
+}
@@ -3,27 +3,21 @@ package kbapi
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // SavedObjectImportResponse wraps the response from a SavedObjectImport call
-type SavedObjectImportResponse struct {
-	StatusCode int
-	Body       *SavedObjectImportResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type SavedObjectImportResponse Response[SavedObjectImportResponseBody]
 
 type SavedObjectImportResponseBody struct {
 	// Errors Indicates the import was unsuccessful and specifies the objects that failed to import.
 	//
 	// NOTE: One object may result in multiple errors, which requires separate steps to resolve. For instance, a `missing_references` error and conflict error.
-	Errors *[]map[string]interface{} `json:"errors,omitempty"`
+	Errors []SavedObjectImportError `json:"errors,omitempty"`
 	// Success Indicates when the import was successfully completed. When set to false, some objects may not have been created. For additional information, refer to the `errors` and `successResults` properties.
 	Success *bool `json:"success,omitempty"`
 
@@ -33,7 +27,7 @@ type SavedObjectImportResponseBody struct {
 	// SuccessResults Indicates the objects that are successfully imported, with any metadata if applicable.
 	//
 	// NOTE: Objects are created only when all resolvable errors are addressed, including conflicts and missing references. If objects are created as new copies, each entry in the `successResults` array includes a `destinationId` attribute.
-	SuccessResults *[]map[string]interface{} `json:"successResults,omitempty"`
+	SuccessResults []SavedObjectImportSuccess `json:"successResults,omitempty"`
 }
 
 // SavedObjectExportRequest   is the request for newFleetBulkGetAgentPolicies
@@ -67,31 +61,17 @@ func (api *API) newSavedObjectImport() func(context.Context, *SavedObjectImportR
 			return nil, fmt.Errorf("File is not defined")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "saved_objects.import")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.CreateNewCopies != nil {
-			params["createNewCopies"] = strconv.FormatBool(*req.Params.CreateNewCopies)
+			params.Set("createNewCopies", strconv.FormatBool(*req.Params.CreateNewCopies))
 		}
 		if req.Params.Overwrite != nil {
-			params["overwrite"] = strconv.FormatBool(*req.Params.Overwrite)
+			params.Set("overwrite", strconv.FormatBool(*req.Params.Overwrite))
 		}
 		if req.Params.CompatibilityMode != nil {
-			params["compatibilityMode"] = strconv.FormatBool(*req.Params.CompatibilityMode)
+			params.Set("compatibilityMode", strconv.FormatBool(*req.Params.CompatibilityMode))
 		}
 
 		// Set up multipart form data
@@ -114,92 +94,14 @@ func (api *API) newSavedObjectImport() func(context.Context, *SavedObjectImportR
 
 		path := "/api/saved_objects/_import"
 
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, body)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Set the content type for multipart form data
-		httpReq.Header.Set("Content-Type", writer.FormDataContentType())
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "saved_objects.import")
-			if reader := instrument.RecordRequestBody(ctx, "saved_objects.import", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &SavedObjectImportResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result SavedObjectImportResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, SavedObjectImportResponseBody](ctx, api, operation{
+			name:        "saved_objects.import",
+			method:      http.MethodPost,
+			path:        path,
+			query:       params,
+			body:        body,
+			contentType: writer.FormDataContentType(),
+		}, nil, opts)
+		return (*SavedObjectImportResponse)(res), err
 	}
 }
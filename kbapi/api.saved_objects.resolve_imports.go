@@ -5,26 +5,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
-// TODO: Update the call
-// SavedObjectResolveImportsResponse wraps the response from a <todo> call
-type SavedObjectResolveImportsResponse struct {
-	StatusCode int
-	Body       *SavedObjectResolveImportsResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+// SavedObjectResolveImportsResponse wraps the response from a SavedObjects.ResolveImport call
+type SavedObjectResolveImportsResponse Response[SavedObjectResolveImportsResponseBody]
 
 type SavedObjectResolveImportsResponseBody struct {
 	// Errors Specifies the objects that failed to resolve.
 	// NOTE: One object can result in multiple errors, which requires separate steps to resolve.
 	// For instance, a missing_references error and a conflict error.
-	Errors []any `json:"errors,omitempty"`
+	Errors []SavedObjectImportError `json:"errors,omitempty"`
 	// Success Indicates a successful import. When set to false, some objects may not have been created.
 	// For additional information, refer to the errors and successResults properties.
 	Success bool `json:"success"`
@@ -32,14 +26,7 @@ type SavedObjectResolveImportsResponseBody struct {
 	SuccessCount int `json:"successCount"`
 	// SuccessResults Indicates the objects that are successfully imported, with any metadata if applicable.
 	// NOTE: Objects are only created when all resolvable errors are addressed, including conflict and missing references.
-	SuccessResults []struct {
-		ID   string `json:"id,omitempty"`
-		Meta struct {
-			Icon  string `json:"icon,omitempty"`
-			Title string `json:"title,omitempty"`
-		} `json:"meta,omitempty"`
-		Type string `json:"type,omitempty"`
-	} `json:"successResults,omitempty"`
+	SuccessResults []SavedObjectImportSuccess `json:"successResults,omitempty"`
 }
 
 type SavedObjectResolveImportsRequest struct {
@@ -79,11 +66,10 @@ type SavedObjectRetryOperationItem struct {
 	Overwrite *bool `json:"overwrite,omitempty"`
 
 	// ReplaceReferences A list of `type`, `from`, and `to` used to change the object references.
-	ReplaceReferences *[]struct {
-		From *string `json:"from,omitempty"`
-		To   *string `json:"to,omitempty"`
-		Type *string `json:"type,omitempty"`
-	} `json:"replaceReferences,omitempty"`
+	ReplaceReferences *[]SavedObjectReplaceReference `json:"replaceReferences,omitempty"`
+
+	// CreateNewCopy When set to `true`, the object is imported as a new copy with DestinationID as its ID.
+	CreateNewCopy *bool `json:"createNewCopy,omitempty"`
 
 	// Type The saved object type.
 	Type string `json:"type"`
@@ -96,28 +82,14 @@ func (api *API) newSavedObjectResolveImports() func(context.Context, *SavedObjec
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "saved_objects.resolve_imports")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.CreateNewCopies != nil {
-			params["createNewCopies"] = strconv.FormatBool(*req.Params.CreateNewCopies)
+			params.Set("createNewCopies", strconv.FormatBool(*req.Params.CreateNewCopies))
 		}
 		if req.Params.CompatibilityMode != nil {
-			params["compatibilityMode"] = strconv.FormatBool(*req.Params.CompatibilityMode)
+			params.Set("compatibilityMode", strconv.FormatBool(*req.Params.CompatibilityMode))
 		}
 
 		// Set up multipart form data
@@ -133,21 +105,17 @@ func (api *API) newSavedObjectResolveImports() func(context.Context, *SavedObjec
 			return nil, fmt.Errorf("failed to write data to form: %w", err)
 		}
 
-		for i, retry := range req.Body.Retries {
-			retryJSON, err := json.Marshal(retry)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal retry data: %w", err)
-			}
-
-			fieldName := fmt.Sprintf("retries[%d]", i)
-			retryField, err := writer.CreateFormField(fieldName)
-			if err != nil {
-				return nil, fmt.Errorf("failed to create retries field: %w", err)
-			}
-
-			if _, err := retryField.Write(retryJSON); err != nil {
-				return nil, fmt.Errorf("failed to write retry data to form: %w", err)
-			}
+		// Kibana expects all retries as a single JSON array field
+		retries := req.Body.Retries
+		if retries == nil {
+			retries = []SavedObjectRetryOperationItem{}
+		}
+		retriesJSON, err := json.Marshal(retries)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal retry data: %w", err)
+		}
+		if err := writer.WriteField("retries", string(retriesJSON)); err != nil {
+			return nil, fmt.Errorf("failed to write retry data to form: %w", err)
 		}
 
 		// Close the multipart writer
@@ -157,107 +125,14 @@ func (api *API) newSavedObjectResolveImports() func(context.Context, *SavedObjec
 
 		path := "/api/saved_objects/_resolve_import_errors"
 
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, body)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Set the content type for multipart form data
-		httpReq.Header.Set("Content-Type", writer.FormDataContentType())
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "saved_objects.resolve_imports")
-			if reader := instrument.RecordRequestBody(ctx, "saved_objects.resolve_imports", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &SavedObjectResolveImportsResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result SavedObjectResolveImportsResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, SavedObjectResolveImportsResponseBody](ctx, api, operation{
+			name:        "saved_objects.resolve_imports",
+			method:      http.MethodPost,
+			path:        path,
+			query:       params,
+			body:        body,
+			contentType: writer.FormDataContentType(),
+		}, nil, opts)
+		return (*SavedObjectResolveImportsResponse)(res), err
 	}
 }
@@ -0,0 +1,164 @@
+package kbapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+)
+
+// SavedObject is a saved object with attributes of type T. The saved objects
+// endpoints return RawSavedObject values, use DecodeSavedObject to decode their
+// attributes into a user-defined struct.
+type SavedObject[T any] struct {
+	ID         string                 `json:"id"`
+	Type       string                 `json:"type"`
+	Attributes T                      `json:"attributes"`
+	References []SavedObjectReference `json:"references"`
+	// Namespaces The spaces the object exists in.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Version The version of the object, used for optimistic concurrency control on update.
+	Version  *string `json:"version,omitempty"`
+	OriginID *string `json:"originId,omitempty"`
+	Managed  *bool   `json:"managed,omitempty"`
+	// CoreMigrationVersion The version of Kibana the object was last migrated with.
+	CoreMigrationVersion *string `json:"coreMigrationVersion,omitempty"`
+	TypeMigrationVersion *string `json:"typeMigrationVersion,omitempty"`
+	CreatedAt            *string `json:"created_at,omitempty"`
+	CreatedBy            *string `json:"created_by,omitempty"`
+	UpdatedAt            *string `json:"updated_at,omitempty"`
+	UpdatedBy            *string `json:"updated_by,omitempty"`
+	// Error Set instead of the attributes when the object could not be read or written in a bulk request.
+	Error *Error `json:"error,omitempty"`
+}
+
+// RawSavedObject is a saved object with undecoded attributes.
+type RawSavedObject = SavedObject[json.RawMessage]
+
+// DecodeSavedObject decodes the attributes of obj into T.
+func DecodeSavedObject[T any](obj RawSavedObject) (SavedObject[T], error) {
+	decoded := SavedObject[T]{
+		ID:                   obj.ID,
+		Type:                 obj.Type,
+		References:           obj.References,
+		Namespaces:           obj.Namespaces,
+		Version:              obj.Version,
+		OriginID:             obj.OriginID,
+		Managed:              obj.Managed,
+		CoreMigrationVersion: obj.CoreMigrationVersion,
+		TypeMigrationVersion: obj.TypeMigrationVersion,
+		CreatedAt:            obj.CreatedAt,
+		CreatedBy:            obj.CreatedBy,
+		UpdatedAt:            obj.UpdatedAt,
+		UpdatedBy:            obj.UpdatedBy,
+		Error:                obj.Error,
+	}
+	if len(obj.Attributes) == 0 {
+		return decoded, nil
+	}
+	if err := json.Unmarshal(obj.Attributes, &decoded.Attributes); err != nil {
+		return decoded, fmt.Errorf("failed to decode attributes of %s %s: %w", obj.Type, obj.ID, err)
+	}
+	return decoded, nil
+}
+
+// DecodeSavedObjects decodes the attributes of every object of seq into T,
+// e.g. the objects returned by SavedObjects.All.
+func DecodeSavedObjects[T any](seq iter.Seq2[RawSavedObject, error]) iter.Seq2[SavedObject[T], error] {
+	return func(yield func(SavedObject[T], error) bool) {
+		for obj, err := range seq {
+			if err != nil {
+				yield(SavedObject[T]{}, err)
+				return
+			}
+			if !yield(DecodeSavedObject[T](obj)) {
+				return
+			}
+		}
+	}
+}
+
+// SavedObjectExportDetails is the summary Kibana appends to a saved objects export.
+type SavedObjectExportDetails struct {
+	ExportedCount int `json:"exportedCount"`
+	// MissingRefCount The number of MissingReferences.
+	MissingRefCount int `json:"missingRefCount"`
+	// MissingReferences The referenced objects that could not be found and were not exported.
+	MissingReferences []Object `json:"missingReferences"`
+	// ExcludedObjectsCount The number of ExcludedObjects.
+	ExcludedObjectsCount int `json:"excludedObjectsCount"`
+	// ExcludedObjects The objects that were excluded from the export by their type, e.g. because they are hidden.
+	ExcludedObjects []SavedObjectExcludedObject `json:"excludedObjects"`
+}
+
+type SavedObjectExcludedObject struct {
+	ID     string  `json:"id"`
+	Type   string  `json:"type"`
+	Reason *string `json:"reason,omitempty"`
+}
+
+// Types of the errors returned by the import and resolve import errors APIs.
+const (
+	SavedObjectImportErrorConflict          = "conflict"
+	SavedObjectImportErrorAmbiguousConflict = "ambiguous_conflict"
+	SavedObjectImportErrorMissingReferences = "missing_references"
+	SavedObjectImportErrorUnsupportedType   = "unsupported_type"
+	SavedObjectImportErrorUnknown           = "unknown"
+)
+
+// SavedObjectImportMeta describes an imported object for display.
+type SavedObjectImportMeta struct {
+	Title *string `json:"title,omitempty"`
+	Icon  *string `json:"icon,omitempty"`
+}
+
+// SavedObjectImportError is an object that could not be imported. One object
+// can have several errors, e.g. a conflict and missing references.
+type SavedObjectImportError struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	Title     *string                `json:"title,omitempty"`
+	Meta      SavedObjectImportMeta  `json:"meta"`
+	Overwrite *bool                  `json:"overwrite,omitempty"`
+	Error     SavedObjectImportCause `json:"error"`
+}
+
+// SavedObjectImportCause is the reason an object could not be imported. The
+// fields set depend on Type, one of the SavedObjectImportError* values.
+type SavedObjectImportCause struct {
+	Type string `json:"type"`
+	// DestinationID The ID of the existing object in conflict, set for conflict errors.
+	DestinationID *string `json:"destinationId,omitempty"`
+	// Destinations The existing objects in conflict, set for ambiguous_conflict errors.
+	Destinations []SavedObjectImportDestination `json:"destinations,omitempty"`
+	// References The referenced objects that do not exist, set for missing_references errors.
+	References []Object `json:"references,omitempty"`
+	// Message The error message, set for unknown errors.
+	Message    *string `json:"message,omitempty"`
+	StatusCode *int    `json:"statusCode,omitempty"`
+}
+
+// SavedObjectImportDestination is an existing object an imported object is in conflict with.
+type SavedObjectImportDestination struct {
+	ID        string  `json:"id"`
+	Title     *string `json:"title,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// SavedObjectImportSuccess is an object that was imported.
+type SavedObjectImportSuccess struct {
+	ID   string                `json:"id"`
+	Type string                `json:"type"`
+	Meta SavedObjectImportMeta `json:"meta"`
+	// DestinationID The ID the object was imported with, when it differs from ID.
+	DestinationID *string `json:"destinationId,omitempty"`
+	Overwrite     *bool   `json:"overwrite,omitempty"`
+	CreateNewCopy *bool   `json:"createNewCopy,omitempty"`
+}
+
+// SavedObjectReplaceReference replaces the references of an imported object
+// to the object of type Type with ID From by references to To.
+type SavedObjectReplaceReference struct {
+	From *string `json:"from,omitempty"`
+	To   *string `json:"to,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
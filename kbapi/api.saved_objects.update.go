@@ -0,0 +1,42 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SavedObjectUpdateResponse wraps the response from a SavedObjects.Update call
+type SavedObjectUpdateResponse Response[RawSavedObject]
+
+type SavedObjectUpdateRequestBody struct {
+	// Attributes The attributes to update, encoded to JSON. Attributes that are not set are left unchanged.
+	Attributes any                    `json:"attributes"`
+	References []SavedObjectReference `json:"references,omitempty"`
+	// Upsert The attributes of the object to create when it does not exist.
+	Upsert any `json:"upsert,omitempty"`
+	// Version The version the object must have, the update fails with a conflict when the object has changed.
+	Version *string `json:"version,omitempty"`
+}
+
+type SavedObjectUpdateRequest struct {
+	Type string
+	ID   string
+	Body SavedObjectUpdateRequestBody
+}
+
+// newSavedObjectUpdate returns a function that performs PUT /api/saved_objects/{type}/{id} API requests
+func (api *API) newSavedObjectUpdate() func(context.Context, *SavedObjectUpdateRequest, ...RequestOption) (*SavedObjectUpdateResponse, error) {
+	return func(ctx context.Context, req *SavedObjectUpdateRequest, opts ...RequestOption) (*SavedObjectUpdateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SavedObjectUpdateRequestBody, RawSavedObject](ctx, api, operation{
+			name:   "saved_objects.update",
+			method: http.MethodPut,
+			path:   fmt.Sprintf("/api/saved_objects/%s/%s", req.Type, req.ID),
+		}, &req.Body, opts)
+		return (*SavedObjectUpdateResponse)(res), err
+	}
+}
@@ -2,21 +2,15 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // TODO: Update the call
 // SecurityAIAssistantCreateKnowledgeBaseResponse wraps the response from a <todo> call
-type SecurityAIAssistantCreateKnowledgeBaseResponse struct {
-	StatusCode int
-	Body       *SecurityAIAssistantCreateKnowledgeBaseResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type SecurityAIAssistantCreateKnowledgeBaseResponse Response[SecurityAIAssistantCreateKnowledgeBaseResponseBody]
 
 type SecurityAIAssistantCreateKnowledgeBaseResponseBody struct {
 	Success bool `json:"success"`
@@ -47,130 +41,22 @@ func (api *API) newSecurityAIAssistantCreateKnowledgeBase() func(context.Context
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "security_ai_assistant.create_knowledge_base")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := fmt.Sprintf("/api/security_ai_assistant/knowledge_base/%s", req.Resource)
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.ModelID != nil {
-			params["modelId"] = *req.Params.ModelID
+			params.Set("modelId", *req.Params.ModelID)
 		}
 		if req.Params.IgnoreSecurityLabs != nil {
-			params["ignoreSecurityLabs"] = strconv.FormatBool(*req.Params.IgnoreSecurityLabs)
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "security_ai_assistant.create_knowledge_base")
-			if reader := instrument.RecordRequestBody(ctx, "security_ai_assistant.create_knowledge_base", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
+			params.Set("ignoreSecurityLabs", strconv.FormatBool(*req.Params.IgnoreSecurityLabs))
 		}
 
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &SecurityAIAssistantCreateKnowledgeBaseResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result SecurityAIAssistantCreateKnowledgeBaseResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, SecurityAIAssistantCreateKnowledgeBaseResponseBody](ctx, api, operation{
+			name:   "security_ai_assistant.create_knowledge_base",
+			method: http.MethodPost,
+			path:   fmt.Sprintf("/api/security_ai_assistant/knowledge_base/%s", req.Resource),
+			query:  params,
+		}, nil, opts)
+		return (*SecurityAIAssistantCreateKnowledgeBaseResponse)(res), err
 	}
 }
@@ -2,22 +2,16 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
+	"iter"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 )
 
 // TODO: Update the call
 // SecurityAIAssistantListAnonymizationResponse wraps the response from a <todo> call
-type SecurityAIAssistantListAnonymizationResponse struct {
-	StatusCode int
-	Body       *SecurityAIAssistantListAnonymizationResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type SecurityAIAssistantListAnonymizationResponse Response[SecurityAIAssistantListAnonymizationResponseBody]
 
 type SecurityAIAssistantListAnonymizationResponseBody struct {
 	Data    []SecurityAIAssistantAnonymizationFieldObject `json:"data"`
@@ -53,142 +47,58 @@ func (api *API) newSecurityAIAssistantListAnonymization() func(context.Context,
 			req = &SecurityAIAssistantListAnonymizationRequest{}
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "security_ai_assistant.list_anonymization")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/security_ai_assistant/anonymization_fields/_find"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Filter != nil {
-			params["filter"] = *req.Params.Filter
+			params.Set("filter", *req.Params.Filter)
 		}
 		if req.Params.Fields != nil {
-			params["fields"] = strings.Join(*req.Params.Fields, ",")
+			params.Set("fields", strings.Join(*req.Params.Fields, ","))
 		}
 		if req.Params.Page != nil {
-			params["page"] = strconv.Itoa(*req.Params.Page)
+			params.Set("page", strconv.Itoa(*req.Params.Page))
 		}
 		if req.Params.PerPage != nil {
-			params["per_page"] = strconv.Itoa(*req.Params.PerPage)
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
 		}
 		if req.Params.SortField != nil {
-			params["sort_field"] = *req.Params.SortField
+			params.Set("sort_field", *req.Params.SortField)
 		}
 		if req.Params.SortOrder != nil {
-			params["sort_order"] = *req.Params.SortOrder
+			params.Set("sort_order", *req.Params.SortOrder)
 		}
 
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "security_ai_assistant.list_anonymization")
-			if reader := instrument.RecordRequestBody(ctx, "security_ai_assistant.list_anonymization", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
+		res, err := do[noBody, SecurityAIAssistantListAnonymizationResponseBody](ctx, api, operation{
+			name:   "security_ai_assistant.list_anonymization",
+			method: http.MethodGet,
+			path:   "/api/security_ai_assistant/anonymization_fields/_find",
+			query:  params,
+		}, nil, opts)
+		return (*SecurityAIAssistantListAnonymizationResponse)(res), err
+	}
+}
 
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
+// AllAnonymizationFields returns an iterator over all anonymization fields matching req. Pages of
+// req.Params.PerPage items, or DefaultPerPage when unset, are fetched on demand.
+func (s SecurityAIAssistant) AllAnonymizationFields(ctx context.Context, req *SecurityAIAssistantListAnonymizationRequest, opts ...RequestOption) iter.Seq2[SecurityAIAssistantAnonymizationFieldObject, error] {
+	return items(s.AnonymizationFieldPages(ctx, req, opts...))
+}
 
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
+// AnonymizationFieldPages returns an iterator over the pages of anonymization fields matching req.
+func (s SecurityAIAssistant) AnonymizationFieldPages(ctx context.Context, req *SecurityAIAssistantListAnonymizationRequest, opts ...RequestOption) iter.Seq2[*Page[SecurityAIAssistantAnonymizationFieldObject], error] {
+	var params SecurityAIAssistantListAnonymizationRequestParams
+	if req != nil {
+		params = req.Params
+	}
 
+	return pages(ctx, intValue(params.Page, 1), intValue(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[SecurityAIAssistantAnonymizationFieldObject], error) {
+		params.Page = &page
+		params.PerPage = &perPage
+		resp, err := s.ListAnonymizationFields(ctx, &SecurityAIAssistantListAnonymizationRequest{Params: params}, opts...)
 		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
 			return nil, err
 		}
-
-		// Prepare response
-		resp := &SecurityAIAssistantListAnonymizationResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result SecurityAIAssistantListAnonymizationResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
-	}
+		return &Page[SecurityAIAssistantAnonymizationFieldObject]{Items: resp.Body.Data, Page: resp.Body.Page, PerPage: resp.Body.PerPage, Total: resp.Body.Total}, nil
+	})
 }
@@ -4,20 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 )
 
 // TODO: Update the call
 // SecurityAIAssistantListKnowledgeBaseEntryResponse wraps the response from a <todo> call
-type SecurityAIAssistantListKnowledgeBaseEntryResponse struct {
-	StatusCode int
-	Body       *SecurityAIAssistantListKnowledgeBaseEntryResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type SecurityAIAssistantListKnowledgeBaseEntryResponse Response[SecurityAIAssistantListKnowledgeBaseEntryResponseBody]
 
 type SecurityAIAssistantListKnowledgeBaseEntryResponseBody struct {
 	Data    []json.RawMessage `json:"data"`
@@ -111,142 +107,58 @@ func (api *API) newSecurityAIAssistantListKnowledgeBaseEntry() func(context.Cont
 			req = &SecurityAIAssistantListKnowledgeBaseEntryRequest{}
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "security_ai_assistant.list_knowledge_base_entry")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/security_ai_assistant/knowledge_base/entries/_find"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Filter != nil {
-			params["filter"] = *req.Params.Filter
+			params.Set("filter", *req.Params.Filter)
 		}
 		if req.Params.Fields != nil {
-			params["fields"] = strings.Join(*req.Params.Fields, ",")
+			params.Set("fields", strings.Join(*req.Params.Fields, ","))
 		}
 		if req.Params.Page != nil {
-			params["page"] = strconv.Itoa(*req.Params.Page)
+			params.Set("page", strconv.Itoa(*req.Params.Page))
 		}
 		if req.Params.PerPage != nil {
-			params["per_page"] = strconv.Itoa(*req.Params.PerPage)
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
 		}
 		if req.Params.SortField != nil {
-			params["sort_field"] = *req.Params.SortField
+			params.Set("sort_field", *req.Params.SortField)
 		}
 		if req.Params.SortOrder != nil {
-			params["sort_order"] = *req.Params.SortOrder
+			params.Set("sort_order", *req.Params.SortOrder)
 		}
 
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "security_ai_assistant.list_knowledge_base_entry")
-			if reader := instrument.RecordRequestBody(ctx, "security_ai_assistant.list_knowledge_base_entry", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
+		res, err := do[noBody, SecurityAIAssistantListKnowledgeBaseEntryResponseBody](ctx, api, operation{
+			name:   "security_ai_assistant.list_knowledge_base_entry",
+			method: http.MethodGet,
+			path:   "/api/security_ai_assistant/knowledge_base/entries/_find",
+			query:  params,
+		}, nil, opts)
+		return (*SecurityAIAssistantListKnowledgeBaseEntryResponse)(res), err
+	}
+}
 
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
+// AllKnowledgeBaseEntries returns an iterator over all Knowledge Base entries matching req. Pages of
+// req.Params.PerPage items, or DefaultPerPage when unset, are fetched on demand.
+func (s SecurityAIAssistant) AllKnowledgeBaseEntries(ctx context.Context, req *SecurityAIAssistantListKnowledgeBaseEntryRequest, opts ...RequestOption) iter.Seq2[json.RawMessage, error] {
+	return items(s.KnowledgeBaseEntryPages(ctx, req, opts...))
+}
 
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
+// KnowledgeBaseEntryPages returns an iterator over the pages of Knowledge Base entries matching req.
+func (s SecurityAIAssistant) KnowledgeBaseEntryPages(ctx context.Context, req *SecurityAIAssistantListKnowledgeBaseEntryRequest, opts ...RequestOption) iter.Seq2[*Page[json.RawMessage], error] {
+	var params SecurityAIAssistantListKnowledgeBaseEntryRequestParams
+	if req != nil {
+		params = req.Params
+	}
 
+	return pages(ctx, intValue(params.Page, 1), intValue(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[json.RawMessage], error) {
+		params.Page = &page
+		params.PerPage = &perPage
+		resp, err := s.ListKnowledgeBaseEntries(ctx, &SecurityAIAssistantListKnowledgeBaseEntryRequest{Params: params}, opts...)
 		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
 			return nil, err
 		}
-
-		// Prepare response
-		resp := &SecurityAIAssistantListKnowledgeBaseEntryResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result SecurityAIAssistantListKnowledgeBaseEntryResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
-	}
+		return &Page[json.RawMessage]{Items: resp.Body.Data, Page: resp.Body.Page, PerPage: resp.Body.PerPage, Total: resp.Body.Total}, nil
+	})
 }
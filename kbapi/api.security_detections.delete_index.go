@@ -2,20 +2,12 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
 )
 
 // TODO: Update the call
 // SecurityDetectionsDeleteIndexResponse wraps the response from a <todo> call
-type SecurityDetectionsDeleteIndexResponse struct {
-	StatusCode int
-	Body       *SecurityDetectionsDeleteIndexResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type SecurityDetectionsDeleteIndexResponse Response[SecurityDetectionsDeleteIndexResponseBody]
 
 type SecurityDetectionsDeleteIndexResponseBody struct {
 	Acknowledge bool `json:"acknowledge"`
@@ -24,112 +16,11 @@ type SecurityDetectionsDeleteIndexResponseBody struct {
 // newSecurityDetectionsDeleteIndex returns a function that performs DELETE /api/detection_engine/index API requests
 func (api *API) newSecurityDetectionsDeleteIndex() func(context.Context, ...RequestOption) (*SecurityDetectionsDeleteIndexResponse, error) {
 	return func(ctx context.Context, opts ...RequestOption) (*SecurityDetectionsDeleteIndexResponse, error) {
-
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "security_detections.delete_index")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/detection_engine/index"
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodDelete, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "security_detections.delete_index")
-			if reader := instrument.RecordRequestBody(ctx, "security_detections.delete_index", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &SecurityDetectionsDeleteIndexResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result SecurityDetectionsDeleteIndexResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, SecurityDetectionsDeleteIndexResponseBody](ctx, api, operation{
+			name:   "security_detections.delete_index",
+			method: http.MethodDelete,
+			path:   "/api/detection_engine/index",
+		}, nil, opts)
+		return (*SecurityDetectionsDeleteIndexResponse)(res), err
 	}
 }
@@ -0,0 +1,79 @@
+package kbapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SecurityDetectionsExportRulesDetails is the summary Kibana appends to a rules export.
+type SecurityDetectionsExportRulesDetails struct {
+	ExportedCount      int `json:"exported_count"`
+	ExportedRulesCount int `json:"exported_rules_count"`
+	MissingRulesCount  int `json:"missing_rules_count"`
+	MissingRules       []struct {
+		RuleID string `json:"rule_id"`
+	} `json:"missing_rules"`
+	ExportedExceptionListCount     int                      `json:"exported_exception_list_count"`
+	ExportedExceptionListItemCount int                      `json:"exported_exception_list_item_count"`
+	MissingExceptionListsCount     int                      `json:"missing_exception_lists_count"`
+	MissingExceptionLists          []map[string]interface{} `json:"missing_exception_lists"`
+	MissingExceptionListItemCount  int                      `json:"missing_exception_list_item_count"`
+	MissingExceptionListItems      []map[string]interface{} `json:"missing_exception_list_items"`
+	ExportedActionConnectorCount   int                      `json:"exported_action_connector_count"`
+	MissingActionConnectionCount   int                      `json:"missing_action_connection_count"`
+	MissingActionConnections       []map[string]interface{} `json:"missing_action_connections"`
+	ExcludedActionConnectionCount  int                      `json:"excluded_action_connection_count"`
+	ExcludedActionConnections      []map[string]interface{} `json:"excluded_action_connections"`
+}
+
+// SecurityDetectionsExportRulesStreamResponse wraps the response from a SecurityDetections.ExportRulesStream call. The export is read from Stream, which must be closed.
+type SecurityDetectionsExportRulesStreamResponse struct {
+	StatusCode int
+	Stream     *NDJSONStream[json.RawMessage, SecurityDetectionsExportRulesDetails]
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+// newSecurityDetectionsExportRulesStream returns a function that performs POST /api/detection_engine/rules/_export API requests
+// and streams the exported objects
+func (api *API) newSecurityDetectionsExportRulesStream() func(context.Context, *SecurityDetectionsExportRulesRequest, ...RequestOption) (*SecurityDetectionsExportRulesStreamResponse, error) {
+	return func(ctx context.Context, req *SecurityDetectionsExportRulesRequest, opts ...RequestOption) (*SecurityDetectionsExportRulesStreamResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.ExcludeExportDetails != nil {
+			params.Set("exclude_export_details", strconv.FormatBool(*req.Params.ExcludeExportDetails))
+		}
+
+		res, err := do[SecurityDetectionsExportRulesRequestBody, noBody](ctx, api, operation{
+			name:   "security_detections.export_rules_stream",
+			method: http.MethodPost,
+			path:   "/api/detection_engine/rules/_export",
+			query:  params,
+			stream: true,
+		}, &req.Body, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		resp := &SecurityDetectionsExportRulesStreamResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+		}
+		if res.Error != nil {
+			resp.RawBody = res.RawBody
+		} else {
+			resp.Stream = newNDJSONStream[json.RawMessage, SecurityDetectionsExportRulesDetails](res.RawBody, "exported_rules_count")
+		}
+		return resp, err
+	}
+}
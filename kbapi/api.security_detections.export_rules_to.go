@@ -0,0 +1,70 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SecurityDetectionsExportRulesToResponse wraps the response from a SecurityDetections.ExportRulesTo call
+type SecurityDetectionsExportRulesToResponse struct {
+	StatusCode int
+	// Details The export details, nil when they were excluded from the export.
+	Details *SecurityDetectionsExportRulesDetails
+	Error   *Error
+	RawBody io.ReadCloser
+}
+
+type SecurityDetectionsExportRulesToRequest struct {
+	Params SecurityDetectionsExportRulesRequestParams
+	Body   SecurityDetectionsExportRulesRequestBody
+	// Output receives the exported objects as NDJSON as they are read, the
+	// export details are returned in Details instead.
+	Output io.Writer
+}
+
+// newSecurityDetectionsExportRulesTo returns a function that performs POST /api/detection_engine/rules/_export API requests
+// and writes the export to an io.Writer
+func (api *API) newSecurityDetectionsExportRulesTo() func(context.Context, *SecurityDetectionsExportRulesToRequest, ...RequestOption) (*SecurityDetectionsExportRulesToResponse, error) {
+	return func(ctx context.Context, req *SecurityDetectionsExportRulesToRequest, opts ...RequestOption) (*SecurityDetectionsExportRulesToResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+		if req.Output == nil {
+			return nil, fmt.Errorf("Output cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.ExcludeExportDetails != nil {
+			params.Set("exclude_export_details", strconv.FormatBool(*req.Params.ExcludeExportDetails))
+		}
+
+		output := &exportWriter{w: req.Output}
+		res, err := do[SecurityDetectionsExportRulesRequestBody, noBody](ctx, api, operation{
+			name:   "security_detections.export_rules_to",
+			method: http.MethodPost,
+			path:   "/api/detection_engine/rules/_export",
+			query:  params,
+			output: output,
+		}, &req.Body, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		resp := &SecurityDetectionsExportRulesToResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}
+		if err != nil {
+			return resp, err
+		}
+		resp.Details, err = exportWriterDetails[SecurityDetectionsExportRulesDetails](output, "exported_rules_count")
+		return resp, err
+	}
+}
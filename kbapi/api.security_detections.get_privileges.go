@@ -2,20 +2,12 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
 )
 
 // TODO: Update the call
 // SecurityDetectionsGetPrivilegesSpaceResponse wraps the response from a <todo> call
-type SecurityDetectionsGetPrivilegesSpaceResponse struct {
-	StatusCode int
-	Body       *SecurityDetectionsGetPrivilegesSpaceResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type SecurityDetectionsGetPrivilegesSpaceResponse Response[SecurityDetectionsGetPrivilegesSpaceResponseBody]
 
 type SecurityDetectionsGetPrivilegesSpaceResponseBody struct {
 	Index struct {
@@ -59,112 +51,11 @@ type SecurityDetectionsGetPrivilegesSpaceResponseBody struct {
 // newSecurityDetectionsGetPrivilegesSpace returns a function that performs GET /api/detection_engine/privileges API requests
 func (api *API) newSecurityDetectionsGetPrivilegesSpace() func(context.Context, ...RequestOption) (*SecurityDetectionsGetPrivilegesSpaceResponse, error) {
 	return func(ctx context.Context, opts ...RequestOption) (*SecurityDetectionsGetPrivilegesSpaceResponse, error) {
-
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "security_detections.get_privileges_space")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/detection_engine/privileges"
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "security_detections.get_privileges_space")
-			if reader := instrument.RecordRequestBody(ctx, "security_detections.get_privileges_space", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &SecurityDetectionsGetPrivilegesSpaceResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result SecurityDetectionsGetPrivilegesSpaceResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, SecurityDetectionsGetPrivilegesSpaceResponseBody](ctx, api, operation{
+			name:   "security_detections.get_privileges_space",
+			method: http.MethodGet,
+			path:   "/api/detection_engine/privileges",
+		}, nil, opts)
+		return (*SecurityDetectionsGetPrivilegesSpaceResponse)(res), err
 	}
 }
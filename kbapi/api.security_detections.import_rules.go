@@ -3,23 +3,42 @@ package kbapi
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // SecurityDetectionsImportRulesResponse wraps the response from a ImportRules call
-type SecurityDetectionsImportRulesResponse struct {
-	StatusCode int
-	Body       *SecurityDetectionsImportRulesResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type SecurityDetectionsImportRulesResponse Response[SecurityDetectionsImportRulesResponseBody]
 
 type SecurityDetectionsImportRulesResponseBody struct {
+	Success      bool                            `json:"success"`
+	SuccessCount int                             `json:"success_count"`
+	RulesCount   int                             `json:"rules_count"`
+	Errors       []SecurityDetectionsImportError `json:"errors"`
+	// ExceptionsSuccess Whether all the exception lists of the file were imported.
+	ExceptionsSuccess      bool                            `json:"exceptions_success"`
+	ExceptionsSuccessCount int                             `json:"exceptions_success_count"`
+	ExceptionsErrors       []SecurityDetectionsImportError `json:"exceptions_errors"`
+	// ActionConnectorsSuccess Whether all the connectors of the file were imported.
+	ActionConnectorsSuccess      bool                            `json:"action_connectors_success"`
+	ActionConnectorsSuccessCount int                             `json:"action_connectors_success_count"`
+	ActionConnectorsErrors       []SecurityDetectionsImportError `json:"action_connectors_errors"`
+	ActionConnectorsWarnings     []map[string]interface{}        `json:"action_connectors_warnings"`
+}
+
+// SecurityDetectionsImportError describes a rule, exception list or connector that could not be imported.
+type SecurityDetectionsImportError struct {
+	ID     *string `json:"id,omitempty"`
+	RuleID *string `json:"rule_id,omitempty"`
+	ListID *string `json:"list_id,omitempty"`
+	ItemID *string `json:"item_id,omitempty"`
+	Error  struct {
+		StatusCode int    `json:"status_code"`
+		Message    string `json:"message"`
+	} `json:"error"`
 }
 
 type SecurityDetectionsImportRulesRequest struct {
@@ -49,34 +68,20 @@ func (api *API) newSecurityDetectionsImportRules() func(context.Context, *Securi
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "security_detections.import_rules")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Overwrite != nil {
-			params["overwrite"] = strconv.FormatBool(*req.Params.Overwrite)
+			params.Set("overwrite", strconv.FormatBool(*req.Params.Overwrite))
 		}
 		if req.Params.OverwriteExceptions != nil {
-			params["overwrite_exceptions"] = strconv.FormatBool(*req.Params.OverwriteExceptions)
+			params.Set("overwrite_exceptions", strconv.FormatBool(*req.Params.OverwriteExceptions))
 		}
 		if req.Params.OverwriteActionConnectors != nil {
-			params["overwrite_action_connectors"] = strconv.FormatBool(*req.Params.OverwriteActionConnectors)
+			params.Set("overwrite_action_connectors", strconv.FormatBool(*req.Params.OverwriteActionConnectors))
 		}
 		if req.Params.AsNewList != nil {
-			params["as_new_list"] = strconv.FormatBool(*req.Params.AsNewList)
+			params.Set("as_new_list", strconv.FormatBool(*req.Params.AsNewList))
 		}
 
 		// Set up multipart form data
@@ -99,107 +104,14 @@ func (api *API) newSecurityDetectionsImportRules() func(context.Context, *Securi
 
 		path := "/api/detection_engine/rules/_import"
 
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, body)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Set the content type for multipart form data
-		httpReq.Header.Set("Content-Type", writer.FormDataContentType())
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "security_detections.import_rules")
-			if reader := instrument.RecordRequestBody(ctx, "security_detections.import_rules", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &SecurityDetectionsImportRulesResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result SecurityDetectionsImportRulesResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, SecurityDetectionsImportRulesResponseBody](ctx, api, operation{
+			name:        "security_detections.import_rules",
+			method:      http.MethodPost,
+			path:        path,
+			query:       params,
+			body:        body,
+			contentType: writer.FormDataContentType(),
+		}, nil, opts)
+		return (*SecurityDetectionsImportRulesResponse)(res), err
 	}
 }
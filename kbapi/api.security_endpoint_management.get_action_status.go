@@ -4,18 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 )
 
 // TODO: Update the call
 // SecurityEndpointManagementGetActionStatusResponse wraps the response from a <todo> call
-type SecurityEndpointManagementGetActionStatusResponse struct {
-	StatusCode int
-	Body       *SecurityEndpointManagementGetActionStatusResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type SecurityEndpointManagementGetActionStatusResponse Response[SecurityEndpointManagementGetActionStatusResponseBody]
 
 type SecurityEndpointManagementGetActionStatusResponseBody struct {
 	Body struct {
@@ -51,134 +46,23 @@ func (api *API) newSecurityEndpointManagementGetActionStatus() func(context.Cont
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "security_endpoint_management.get_action_status")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/endpoint/action_status"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Query != nil {
 			queryJSON, err := json.Marshal(req.Params.Query)
 			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
 				return nil, err
 			}
-			params["query"] = string(queryJSON)
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "security_endpoint_management.get_action_status")
-			if reader := instrument.RecordRequestBody(ctx, "security_endpoint_management.get_action_status", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
+			params.Set("query", string(queryJSON))
 		}
 
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &SecurityEndpointManagementGetActionStatusResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result SecurityEndpointManagementGetActionStatusResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, SecurityEndpointManagementGetActionStatusResponseBody](ctx, api, operation{
+			name:   "security_endpoint_management.get_action_status",
+			method: http.MethodGet,
+			path:   "/api/endpoint/action_status",
+			query:  params,
+		}, nil, opts)
+		return (*SecurityEndpointManagementGetActionStatusResponse)(res), err
 	}
 }
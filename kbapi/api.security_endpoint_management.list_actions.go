@@ -2,21 +2,15 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
+	"iter"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 )
 
 // SecurityEndpointManagementListActionsResponse wraps the response from a List call
-type SecurityEndpointManagementListActionsResponse struct {
-	StatusCode int
-	Body       *SecurityEndpointManagementListActionsResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type SecurityEndpointManagementListActionsResponse Response[SecurityEndpointManagementListActionsResponseBody]
 
 type SecurityEndpointManagementListActionsResponseBody struct {
 	Data            []SecurityEndpointManagementAction `json:"data"`
@@ -63,154 +57,70 @@ func (api *API) newSecurityEndpointManagementListActions() func(context.Context,
 			req = &SecurityEndpointManagementListActionsRequest{}
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "security_endpoint_management.list_actions")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/endpoint/action"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Commands != nil {
-			params["commands"] = strings.Join(*req.Params.Commands, ",")
+			params.Set("commands", strings.Join(*req.Params.Commands, ","))
 		}
 		if req.Params.AgentIDs != nil {
-			params["agentIds"] = strings.Join(*req.Params.AgentIDs, ",")
+			params.Set("agentIds", strings.Join(*req.Params.AgentIDs, ","))
 		}
 		if req.Params.UserIDs != nil {
-			params["userIds"] = strings.Join(*req.Params.UserIDs, ",")
+			params.Set("userIds", strings.Join(*req.Params.UserIDs, ","))
 		}
 		if req.Params.StartDate != nil {
-			params["startDate"] = *req.Params.StartDate
+			params.Set("startDate", *req.Params.StartDate)
 		}
 		if req.Params.EndDate != nil {
-			params["endDate"] = *req.Params.EndDate
+			params.Set("endDate", *req.Params.EndDate)
 		}
 		if req.Params.AgentTypes != nil {
-			params["agentTypes"] = *req.Params.AgentTypes
+			params.Set("agentTypes", *req.Params.AgentTypes)
 		}
 		if req.Params.WithOutputs != nil {
-			params["withOutputs"] = strings.Join(*req.Params.WithOutputs, ",")
+			params.Set("withOutputs", strings.Join(*req.Params.WithOutputs, ","))
 		}
 		if req.Params.Types != nil {
-			params["types"] = strings.Join(*req.Params.Types, ",")
+			params.Set("types", strings.Join(*req.Params.Types, ","))
 		}
 		if req.Params.Page != nil {
-			params["page"] = strconv.Itoa(*req.Params.Page)
+			params.Set("page", strconv.Itoa(*req.Params.Page))
 		}
 		if req.Params.PageSize != nil {
-			params["pageSize"] = strconv.Itoa(*req.Params.PageSize)
+			params.Set("pageSize", strconv.Itoa(*req.Params.PageSize))
 		}
 
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "security_endpoint_management.list_actions")
-			if reader := instrument.RecordRequestBody(ctx, "security_endpoint_management.list_actions", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
+		res, err := do[noBody, SecurityEndpointManagementListActionsResponseBody](ctx, api, operation{
+			name:   "security_endpoint_management.list_actions",
+			method: http.MethodGet,
+			path:   "/api/endpoint/action",
+			query:  params,
+		}, nil, opts)
+		return (*SecurityEndpointManagementListActionsResponse)(res), err
+	}
+}
 
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
+// AllActions returns an iterator over all response actions matching req. Pages of
+// req.Params.PageSize items, or DefaultPerPage when unset, are fetched on demand.
+func (s SecurityEndpointManagement) AllActions(ctx context.Context, req *SecurityEndpointManagementListActionsRequest, opts ...RequestOption) iter.Seq2[SecurityEndpointManagementAction, error] {
+	return items(s.ActionPages(ctx, req, opts...))
+}
 
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
+// ActionPages returns an iterator over the pages of response actions matching req.
+func (s SecurityEndpointManagement) ActionPages(ctx context.Context, req *SecurityEndpointManagementListActionsRequest, opts ...RequestOption) iter.Seq2[*Page[SecurityEndpointManagementAction], error] {
+	var params SecurityEndpointManagementListActionsRequestParams
+	if req != nil {
+		params = req.Params
+	}
 
+	return pages(ctx, intValue(params.Page, 1), intValue(params.PageSize, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[SecurityEndpointManagementAction], error) {
+		params.Page = &page
+		params.PageSize = &perPage
+		resp, err := s.ListActions(ctx, &SecurityEndpointManagementListActionsRequest{Params: params}, opts...)
 		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
 			return nil, err
 		}
-
-		// Prepare response
-		resp := &SecurityEndpointManagementListActionsResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result SecurityEndpointManagementListActionsResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
-	}
+		return &Page[SecurityEndpointManagementAction]{Items: resp.Body.Data, Page: resp.Body.Page, PerPage: resp.Body.PageSize, Total: resp.Body.Total}, nil
+	})
 }
@@ -1,12 +1,12 @@
 package kbapi
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 )
 
@@ -14,7 +14,7 @@ import (
 type SecurityExceptionsExportListResponse struct {
 	StatusCode int
 	Body       []json.RawMessage
-	Error      interface{}
+	Error      *Error
 	RawBody    io.ReadCloser
 }
 
@@ -46,142 +46,41 @@ func (api *API) newSecurityExceptionsExportList() func(context.Context, *Securit
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "security_exceptions.export_list")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.IncludeExpiredExceptions != nil {
-			params["include_expired_exceptions"] = *req.Params.IncludeExpiredExceptions
+			params.Set("include_expired_exceptions", *req.Params.IncludeExpiredExceptions)
 		}
 		if req.Params.ListID != nil {
-			params["list_id"] = *req.Params.ListID
+			params.Set("list_id", *req.Params.ListID)
 		}
 		if req.Params.NamespaceType != nil {
-			params["namespace_type"] = *req.Params.NamespaceType
+			params.Set("namespace_type", *req.Params.NamespaceType)
 		}
 		if req.Params.ID != nil {
-			params["id"] = *req.Params.ID
+			params.Set("id", *req.Params.ID)
 		}
 
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
+		res, err := do[noBody, []byte](ctx, api, operation{
+			name:   "security_exceptions.export_list",
+			method: http.MethodPost,
+			path:   "/api/exception_lists/_export",
+			query:  params,
+		}, nil, opts)
+		if res == nil {
 			return nil, err
 		}
 
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "security_exceptions.export_list")
-			if reader := instrument.RecordRequestBody(ctx, "security_exceptions.export_list", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
 		resp := &SecurityExceptionsExportListResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
 		}
-
-		bodyBytes, err := io.ReadAll(httpResp.Body)
-		httpResp.Body.Close()
-		if err != nil {
-			return nil, fmt.Errorf("failed to read response body: %v", err)
-		}
-
-		resp.RawBody = io.NopCloser(bytes.NewReader(bodyBytes))
-
-		if httpResp.StatusCode < 299 {
-			var objects []json.RawMessage
-			lines := bytes.Split(bodyBytes, []byte("\n"))
-
-			for _, line := range lines {
-				if len(bytes.TrimSpace(line)) == 0 {
-					continue
-				}
-
-				if bytes.HasPrefix(line, []byte(",")) {
-					line = line[1:]
-				}
-
-				objects = append(objects, json.RawMessage(line))
-			}
-
-			resp.Body = objects
-			return resp, nil
-		} else {
-			// For all non-success responses
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
+		if res.Body != nil {
+			resp.Body = splitNDJSON(*res.Body)
 		}
+		return resp, err
 	}
 }
 
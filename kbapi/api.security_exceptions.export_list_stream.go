@@ -0,0 +1,76 @@
+package kbapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// SecurityExceptionsExportListDetails is the summary Kibana appends to an exception list export.
+type SecurityExceptionsExportListDetails struct {
+	ExportedExceptionListCount     int                      `json:"exported_exception_list_count"`
+	ExportedExceptionListItemCount int                      `json:"exported_exception_list_item_count"`
+	MissingExceptionListsCount     int                      `json:"missing_exception_lists_count"`
+	MissingExceptionLists          []map[string]interface{} `json:"missing_exception_lists"`
+	MissingExceptionListItemCount  int                      `json:"missing_exception_list_item_count"`
+	MissingExceptionListItems      []map[string]interface{} `json:"missing_exception_list_items"`
+}
+
+// SecurityExceptionsExportListStreamResponse wraps the response from a SecurityExceptions.ExportListStream call. The export is read from Stream, which must be closed.
+type SecurityExceptionsExportListStreamResponse struct {
+	StatusCode int
+	Stream     *NDJSONStream[json.RawMessage, SecurityExceptionsExportListDetails]
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+// newSecurityExceptionsExportListStream returns a function that performs POST /api/exception_lists/_export API requests
+// and streams the exported objects
+func (api *API) newSecurityExceptionsExportListStream() func(context.Context, *SecurityExceptionsExportListRequest, ...RequestOption) (*SecurityExceptionsExportListStreamResponse, error) {
+	return func(ctx context.Context, req *SecurityExceptionsExportListRequest, opts ...RequestOption) (*SecurityExceptionsExportListStreamResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.IncludeExpiredExceptions != nil {
+			params.Set("include_expired_exceptions", *req.Params.IncludeExpiredExceptions)
+		}
+		if req.Params.ListID != nil {
+			params.Set("list_id", *req.Params.ListID)
+		}
+		if req.Params.NamespaceType != nil {
+			params.Set("namespace_type", *req.Params.NamespaceType)
+		}
+		if req.Params.ID != nil {
+			params.Set("id", *req.Params.ID)
+		}
+
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:   "security_exceptions.export_list_stream",
+			method: http.MethodPost,
+			path:   "/api/exception_lists/_export",
+			query:  params,
+			stream: true,
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		resp := &SecurityExceptionsExportListStreamResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+		}
+		if res.Error != nil {
+			resp.RawBody = res.RawBody
+		} else {
+			resp.Stream = newNDJSONStream[json.RawMessage, SecurityExceptionsExportListDetails](res.RawBody, "exported_exception_list_count")
+		}
+		return resp, err
+	}
+}
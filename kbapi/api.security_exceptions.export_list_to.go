@@ -0,0 +1,77 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// SecurityExceptionsExportListToResponse wraps the response from a SecurityExceptions.ExportListTo call
+type SecurityExceptionsExportListToResponse struct {
+	StatusCode int
+	// Details The export details, nil when they were excluded from the export.
+	Details *SecurityExceptionsExportListDetails
+	Error   *Error
+	RawBody io.ReadCloser
+}
+
+type SecurityExceptionsExportListToRequest struct {
+	Params SecurityExceptionsExportListRequestParams
+	// Output receives the exported objects as NDJSON as they are read, the
+	// export details are returned in Details instead.
+	Output io.Writer
+}
+
+// newSecurityExceptionsExportListTo returns a function that performs POST /api/exception_lists/_export API requests
+// and writes the export to an io.Writer
+func (api *API) newSecurityExceptionsExportListTo() func(context.Context, *SecurityExceptionsExportListToRequest, ...RequestOption) (*SecurityExceptionsExportListToResponse, error) {
+	return func(ctx context.Context, req *SecurityExceptionsExportListToRequest, opts ...RequestOption) (*SecurityExceptionsExportListToResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+		if req.Output == nil {
+			return nil, fmt.Errorf("Output cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.IncludeExpiredExceptions != nil {
+			params.Set("include_expired_exceptions", *req.Params.IncludeExpiredExceptions)
+		}
+		if req.Params.ListID != nil {
+			params.Set("list_id", *req.Params.ListID)
+		}
+		if req.Params.NamespaceType != nil {
+			params.Set("namespace_type", *req.Params.NamespaceType)
+		}
+		if req.Params.ID != nil {
+			params.Set("id", *req.Params.ID)
+		}
+
+		output := &exportWriter{w: req.Output}
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:   "security_exceptions.export_list_to",
+			method: http.MethodPost,
+			path:   "/api/exception_lists/_export",
+			query:  params,
+			output: output,
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		resp := &SecurityExceptionsExportListToResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}
+		if err != nil {
+			return resp, err
+		}
+		resp.Details, err = exportWriterDetails[SecurityExceptionsExportListDetails](output, "exported_exception_list_count")
+		return resp, err
+	}
+}
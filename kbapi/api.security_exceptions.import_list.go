@@ -3,21 +3,15 @@ package kbapi
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // SecurityExceptionsImportListResponse wraps the response from a ImportList call
-type SecurityExceptionsImportListResponse struct {
-	StatusCode int
-	Body       *SecurityExceptionsImportListResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type SecurityExceptionsImportListResponse Response[SecurityExceptionsImportListResponseBody]
 
 type SecurityExceptionsImportListResponseBody struct {
 	Errors                         []SecurityExceptionsErrorDetail `json:"errors"`
@@ -56,30 +50,14 @@ func (api *API) newSecurityExceptionsImportList() func(context.Context, *Securit
 			return nil, fmt.Errorf("Request cannot be nil")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "security_exceptions.import_list")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/exception_lists/_import"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.AsNewList != nil {
-			params["as_new_list"] = strconv.FormatBool(*req.Params.AsNewList)
+			params.Set("as_new_list", strconv.FormatBool(*req.Params.AsNewList))
 		}
 		if req.Params.Overwrite != nil {
-			params["overwrite"] = strconv.FormatBool(*req.Params.Overwrite)
+			params.Set("overwrite", strconv.FormatBool(*req.Params.Overwrite))
 		}
 
 		// Set up multipart form data
@@ -88,128 +66,26 @@ func (api *API) newSecurityExceptionsImportList() func(context.Context, *Securit
 
 		part, err := writer.CreateFormFile("file", "export.ndjson")
 		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
 			return nil, fmt.Errorf("failed to create form file: %w", err)
 		}
 
 		if _, err := part.Write(req.Body.File); err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
 			return nil, fmt.Errorf("failed to write data to form: %w", err)
 		}
 
 		// Close the multipart writer
 		if err := writer.Close(); err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
 			return nil, fmt.Errorf("failed to close writer: %w", err)
 		}
 
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, body)
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Set the content type for multipart form data
-		httpReq.Header.Set("Content-Type", writer.FormDataContentType())
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "security_exceptions.import_list")
-			if reader := instrument.RecordRequestBody(ctx, "security_exceptions.import_list", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &SecurityExceptionsImportListResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result SecurityExceptionsImportListResponseBody
-
-		if httpResp.StatusCode < 299 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-success responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				if instrument != nil {
-					instrument.RecordError(ctx, err)
-				}
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, SecurityExceptionsImportListResponseBody](ctx, api, operation{
+			name:        "security_exceptions.import_list",
+			method:      http.MethodPost,
+			path:        "/api/exception_lists/_import",
+			query:       params,
+			body:        body,
+			contentType: writer.FormDataContentType(),
+		}, nil, opts)
+		return (*SecurityExceptionsImportListResponse)(res), err
 	}
 }
@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SLOsCreateResponse wraps the response from a SLOs.Create call
+type SLOsCreateResponse Response[SLOsCreateSloResponse]
+
+type SLOsCreateRequest struct {
+	Body SLOsCreateSloRequest
+}
+
+// newSLOsCreate returns a function that performs POST /api/observability/slos API requests
+func (api *API) newSLOsCreate() func(context.Context, *SLOsCreateRequest, ...RequestOption) (*SLOsCreateResponse, error) {
+	return func(ctx context.Context, req *SLOsCreateRequest, opts ...RequestOption) (*SLOsCreateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SLOsCreateSloRequest, SLOsCreateSloResponse](ctx, api, operation{
+			name:    "slos.create",
+			feature: FeatureSLOs,
+			method:  http.MethodPost,
+			path:    "/api/observability/slos",
+		}, &req.Body, opts)
+		return (*SLOsCreateResponse)(res), err
+	}
+}
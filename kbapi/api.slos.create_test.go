@@ -0,0 +1,90 @@
+package kbapi
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSLOsCreate(t *testing.T) {
+	mockTransport := NewMockTransport(200, SLOsCreateSloResponse{Id: "slo-1"}, nil)
+	api := New(mockTransport).Space("ops")
+
+	indicator := SLOsIndicatorPropertiesCustomKql{}
+	indicator.Params.Index = "logs-*"
+	indicator.Params.TimestampField = "@timestamp"
+	require.NoError(t, indicator.Params.Good.SetQuery("http.response.status_code < 500"))
+	require.NoError(t, indicator.Params.Total.SetQuery("*"))
+
+	body := SLOsCreateSloRequest{
+		BudgetingMethod: "occurrences",
+		Description:     "Availability",
+		Name:            "availability",
+		Objective:       SLOsObjective{Target: 0.99},
+		TimeWindow:      SLOsTimeWindow{Duration: "30d", Type: "rolling"},
+		GroupBy:         &SLOsGroupBy{},
+	}
+	require.NoError(t, body.Indicator.SetIndicator(indicator))
+	require.NoError(t, body.GroupBy.SetFields("service.name"))
+
+	resp, err := api.SLOs.Create(context.Background(), &SLOsCreateRequest{Body: body})
+	require.NoError(t, err)
+	assert.Equal(t, "slo-1", resp.Body.Id)
+
+	req := mockTransport.LastRequest()
+	AssertRequestMethod(t, req, "POST")
+	AssertRequestPath(t, req, "/s/ops/api/observability/slos")
+	AssertRequestBodyJSON(t, req, map[string]interface{}{
+		"budgetingMethod": "occurrences",
+		"description":     "Availability",
+		"name":            "availability",
+		"groupBy":         "service.name",
+		"objective":       map[string]interface{}{"target": 0.99},
+		"timeWindow":      map[string]interface{}{"duration": "30d", "type": "rolling"},
+		"indicator": map[string]interface{}{
+			"type": "sli.kql.custom",
+			"params": map[string]interface{}{
+				"index":          "logs-*",
+				"timestampField": "@timestamp",
+				"good":           "http.response.status_code < 500",
+				"total":          "*",
+			},
+		},
+	})
+}
+
+func TestSLOsGet_Indicator(t *testing.T) {
+	mockTransport := NewMockTransportWithRawResponse(200, `{
+		"id": "slo-1",
+		"groupBy": ["service.name", "service.environment"],
+		"indicator": {
+			"type": "sli.apm.transactionDuration",
+			"params": {"environment": "production", "index": "metrics-apm*", "service": "checkout", "threshold": 500, "transactionName": "*", "transactionType": "request"}
+		}
+	}`, nil)
+	api := New(mockTransport)
+
+	resp, err := api.SLOs.Get(context.Background(), &SLOsGetRequest{SloID: "slo-1", Params: GetSloOpParams{InstanceId: StrPtr("*")}})
+	require.NoError(t, err)
+	AssertRequestPath(t, mockTransport.LastRequest(), "/api/observability/slos/slo-1")
+	AssertRequestParam(t, mockTransport.LastRequest(), "instanceId", "*")
+
+	indicator, err := resp.Body.Indicator.GetIndicator()
+	require.NoError(t, err)
+	latency, ok := indicator.(SLOsIndicatorPropertiesApmLatency)
+	require.True(t, ok, "unexpected indicator %T", indicator)
+	assert.Equal(t, "checkout", latency.Params.Service)
+	assert.Equal(t, float32(500), latency.Params.Threshold)
+
+	fields, err := resp.Body.GroupBy.GetFields()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"service.name", "service.environment"}, fields)
+
+	// The decoded indicator is sent back unchanged, e.g. on update
+	data, err := json.Marshal(resp.Body.Indicator)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"threshold":500`)
+}
@@ -0,0 +1,44 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SLOsDeleteResponse wraps the response from a SLOs.Delete call
+type SLOsDeleteResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type SLOsDeleteRequest struct {
+	SloID string
+}
+
+// newSLOsDelete returns a function that performs DELETE /api/observability/slos/{sloId} API requests
+func (api *API) newSLOsDelete() func(context.Context, *SLOsDeleteRequest, ...RequestOption) (*SLOsDeleteResponse, error) {
+	return func(ctx context.Context, req *SLOsDeleteRequest, opts ...RequestOption) (*SLOsDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:    "slos.delete",
+			feature: FeatureSLOs,
+			method:  http.MethodDelete,
+			path:    fmt.Sprintf("/api/observability/slos/%s", req.SloID),
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &SLOsDeleteResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
@@ -0,0 +1,44 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SLOsDeleteInstancesResponse wraps the response from a SLOs.DeleteInstances call
+type SLOsDeleteInstancesResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type SLOsDeleteInstancesRequest struct {
+	Body SLOsDeleteSloInstancesRequest
+}
+
+// newSLOsDeleteInstances returns a function that performs POST /api/observability/slos/_delete_instances API requests
+func (api *API) newSLOsDeleteInstances() func(context.Context, *SLOsDeleteInstancesRequest, ...RequestOption) (*SLOsDeleteInstancesResponse, error) {
+	return func(ctx context.Context, req *SLOsDeleteInstancesRequest, opts ...RequestOption) (*SLOsDeleteInstancesResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SLOsDeleteSloInstancesRequest, noBody](ctx, api, operation{
+			name:    "slos.delete_instances",
+			feature: FeatureSLOs,
+			method:  http.MethodPost,
+			path:    "/api/observability/slos/_delete_instances",
+		}, &req.Body, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &SLOsDeleteInstancesResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
@@ -0,0 +1,44 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SLOsDisableResponse wraps the response from a SLOs.Disable call
+type SLOsDisableResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type SLOsDisableRequest struct {
+	SloID string
+}
+
+// newSLOsDisable returns a function that performs POST /api/observability/slos/{sloId}/disable API requests
+func (api *API) newSLOsDisable() func(context.Context, *SLOsDisableRequest, ...RequestOption) (*SLOsDisableResponse, error) {
+	return func(ctx context.Context, req *SLOsDisableRequest, opts ...RequestOption) (*SLOsDisableResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:    "slos.disable",
+			feature: FeatureSLOs,
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/api/observability/slos/%s/disable", req.SloID),
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &SLOsDisableResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
@@ -0,0 +1,44 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SLOsEnableResponse wraps the response from a SLOs.Enable call
+type SLOsEnableResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type SLOsEnableRequest struct {
+	SloID string
+}
+
+// newSLOsEnable returns a function that performs POST /api/observability/slos/{sloId}/enable API requests
+func (api *API) newSLOsEnable() func(context.Context, *SLOsEnableRequest, ...RequestOption) (*SLOsEnableResponse, error) {
+	return func(ctx context.Context, req *SLOsEnableRequest, opts ...RequestOption) (*SLOsEnableResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:    "slos.enable",
+			feature: FeatureSLOs,
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/api/observability/slos/%s/enable", req.SloID),
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &SLOsEnableResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
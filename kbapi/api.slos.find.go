@@ -0,0 +1,64 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SLOsFindResponse wraps the response from a SLOs.Find call
+type SLOsFindResponse Response[SLOsFindSloResponse]
+
+type SLOsFindRequest struct {
+	Params FindSlosOpParams
+}
+
+// newSLOsFind returns a function that performs GET /api/observability/slos API requests
+func (api *API) newSLOsFind() func(context.Context, *SLOsFindRequest, ...RequestOption) (*SLOsFindResponse, error) {
+	return func(ctx context.Context, req *SLOsFindRequest, opts ...RequestOption) (*SLOsFindResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.KqlQuery != nil {
+			params.Set("kqlQuery", *req.Params.KqlQuery)
+		}
+		if req.Params.Size != nil {
+			params.Set("size", strconv.Itoa(*req.Params.Size))
+		}
+		if req.Params.SearchAfter != nil {
+			for _, v := range *req.Params.SearchAfter {
+				params.Add("searchAfter", v)
+			}
+		}
+		if req.Params.Page != nil {
+			params.Set("page", strconv.Itoa(*req.Params.Page))
+		}
+		if req.Params.PerPage != nil {
+			params.Set("perPage", strconv.Itoa(*req.Params.PerPage))
+		}
+		if req.Params.SortBy != nil {
+			params.Set("sortBy", *req.Params.SortBy)
+		}
+		if req.Params.SortDirection != nil {
+			params.Set("sortDirection", *req.Params.SortDirection)
+		}
+		if req.Params.HideStale != nil {
+			params.Set("hideStale", strconv.FormatBool(*req.Params.HideStale))
+		}
+
+		res, err := do[noBody, SLOsFindSloResponse](ctx, api, operation{
+			name:    "slos.find",
+			feature: FeatureSLOs,
+			method:  http.MethodGet,
+			path:    "/api/observability/slos",
+			query:   params,
+		}, nil, opts)
+		return (*SLOsFindResponse)(res), err
+	}
+}
@@ -0,0 +1,53 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SLOsFindDefinitionsResponse wraps the response from a SLOs.FindDefinitions call
+type SLOsFindDefinitionsResponse Response[SLOsFindSloDefinitionsResponse]
+
+type SLOsFindDefinitionsRequest struct {
+	Params GetDefinitionsOpParams
+}
+
+// newSLOsFindDefinitions returns a function that performs GET /internal/observability/slos/_definitions API requests
+func (api *API) newSLOsFindDefinitions() func(context.Context, *SLOsFindDefinitionsRequest, ...RequestOption) (*SLOsFindDefinitionsResponse, error) {
+	return func(ctx context.Context, req *SLOsFindDefinitionsRequest, opts ...RequestOption) (*SLOsFindDefinitionsResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.IncludeOutdatedOnly != nil {
+			params.Set("includeOutdatedOnly", strconv.FormatBool(*req.Params.IncludeOutdatedOnly))
+		}
+		if req.Params.Tags != nil {
+			params.Set("tags", *req.Params.Tags)
+		}
+		if req.Params.Search != nil {
+			params.Set("search", *req.Params.Search)
+		}
+		if req.Params.Page != nil {
+			params.Set("page", strconv.FormatFloat(float64(*req.Params.Page), 'f', -1, 32))
+		}
+		if req.Params.PerPage != nil {
+			params.Set("perPage", strconv.Itoa(*req.Params.PerPage))
+		}
+
+		res, err := do[noBody, SLOsFindSloDefinitionsResponse](ctx, api, operation{
+			name:    "slos.find_definitions",
+			feature: FeatureSLODefinitions,
+			method:  http.MethodGet,
+			path:    "/internal/observability/slos/_definitions",
+			query:   params,
+		}, nil, opts)
+		return (*SLOsFindDefinitionsResponse)(res), err
+	}
+}
@@ -0,0 +1,26 @@
+package kbapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSLOsFindDefinitions(t *testing.T) {
+	mockTransport := NewMockTransportWithRawResponse(200, `{"page": 1, "perPage": 25, "total": 0, "results": []}`, nil)
+	api := New(mockTransport)
+
+	_, err := api.SLOs.FindDefinitions(context.Background(), &SLOsFindDefinitionsRequest{
+		Params: GetDefinitionsOpParams{IncludeOutdatedOnly: BoolPtr(true)},
+	})
+	require.NoError(t, err)
+
+	req := mockTransport.LastRequest()
+	AssertRequestMethod(t, req, "GET")
+	AssertRequestPath(t, req, "/internal/observability/slos/_definitions")
+	assert.Equal(t, "true", req.URL.Query().Get("includeOutdatedOnly"))
+	// Kibana 9 rejects internal API requests without an internal origin
+	assert.Equal(t, "go-kibana", req.Header.Get("x-elastic-internal-origin"))
+}
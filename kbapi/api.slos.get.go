@@ -0,0 +1,41 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// SLOsGetResponse wraps the response from a SLOs.Get call
+type SLOsGetResponse Response[SLOsSloWithSummaryResponse]
+
+type SLOsGetRequest struct {
+	SloID  string
+	Params GetSloOpParams
+}
+
+// newSLOsGet returns a function that performs GET /api/observability/slos/{sloId} API requests
+func (api *API) newSLOsGet() func(context.Context, *SLOsGetRequest, ...RequestOption) (*SLOsGetResponse, error) {
+	return func(ctx context.Context, req *SLOsGetRequest, opts ...RequestOption) (*SLOsGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.InstanceId != nil {
+			params.Set("instanceId", *req.Params.InstanceId)
+		}
+
+		res, err := do[noBody, SLOsSloWithSummaryResponse](ctx, api, operation{
+			name:    "slos.get",
+			feature: FeatureSLOs,
+			method:  http.MethodGet,
+			path:    fmt.Sprintf("/api/observability/slos/%s", req.SloID),
+			query:   params,
+		}, nil, opts)
+		return (*SLOsGetResponse)(res), err
+	}
+}
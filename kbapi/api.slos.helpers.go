@@ -0,0 +1,457 @@
+package kbapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SLO indicator types, as set in the type field of an indicator.
+const (
+	SLOsIndicatorTypeApmAvailability = "sli.apm.transactionErrorRate"
+	SLOsIndicatorTypeApmLatency      = "sli.apm.transactionDuration"
+	SLOsIndicatorTypeCustomKql       = "sli.kql.custom"
+	SLOsIndicatorTypeCustomMetric    = "sli.metric.custom"
+	SLOsIndicatorTypeHistogram       = "sli.histogram.custom"
+	SLOsIndicatorTypeTimesliceMetric = "sli.metric.timeslice"
+)
+
+// SLOsIndicator is implemented by every SLO indicator type:
+// SLOsIndicatorPropertiesApmAvailability, SLOsIndicatorPropertiesApmLatency,
+// SLOsIndicatorPropertiesCustomKql, SLOsIndicatorPropertiesCustomMetric,
+// SLOsIndicatorPropertiesHistogram and SLOsIndicatorPropertiesTimesliceMetric.
+type SLOsIndicator interface {
+	GetType() string
+}
+
+func (i SLOsIndicatorPropertiesApmAvailability) GetType() string {
+	return SLOsIndicatorTypeApmAvailability
+}
+
+func (i SLOsIndicatorPropertiesApmLatency) GetType() string { return SLOsIndicatorTypeApmLatency }
+
+func (i SLOsIndicatorPropertiesCustomKql) GetType() string { return SLOsIndicatorTypeCustomKql }
+
+func (i SLOsIndicatorPropertiesCustomMetric) GetType() string { return SLOsIndicatorTypeCustomMetric }
+
+func (i SLOsIndicatorPropertiesHistogram) GetType() string { return SLOsIndicatorTypeHistogram }
+
+func (i SLOsIndicatorPropertiesTimesliceMetric) GetType() string {
+	return SLOsIndicatorTypeTimesliceMetric
+}
+
+// UnmarshalSLOIndicator decodes an SLO indicator into its concrete type based on its type field.
+func UnmarshalSLOIndicator(data []byte) (SLOsIndicator, error) {
+	var typeContainer struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &typeContainer); err != nil {
+		return nil, fmt.Errorf("error determining indicator type: %w", err)
+	}
+
+	switch typeContainer.Type {
+	case SLOsIndicatorTypeApmAvailability:
+		return unmarshalSLOIndicator[SLOsIndicatorPropertiesApmAvailability](data)
+	case SLOsIndicatorTypeApmLatency:
+		return unmarshalSLOIndicator[SLOsIndicatorPropertiesApmLatency](data)
+	case SLOsIndicatorTypeCustomKql:
+		return unmarshalSLOIndicator[SLOsIndicatorPropertiesCustomKql](data)
+	case SLOsIndicatorTypeCustomMetric:
+		return unmarshalSLOIndicator[SLOsIndicatorPropertiesCustomMetric](data)
+	case SLOsIndicatorTypeHistogram:
+		return unmarshalSLOIndicator[SLOsIndicatorPropertiesHistogram](data)
+	case SLOsIndicatorTypeTimesliceMetric:
+		return unmarshalSLOIndicator[SLOsIndicatorPropertiesTimesliceMetric](data)
+	default:
+		return nil, fmt.Errorf("unknown indicator type: %s", typeContainer.Type)
+	}
+}
+
+func unmarshalSLOIndicator[T SLOsIndicator](data []byte) (SLOsIndicator, error) {
+	var indicator T
+	if err := json.Unmarshal(data, &indicator); err != nil {
+		return nil, fmt.Errorf("error unmarshalling %s indicator: %w", indicator.GetType(), err)
+	}
+	return indicator, nil
+}
+
+// marshalSLOIndicator encodes indicator, filling in the type field from GetType
+// so callers do not have to set it themselves.
+func marshalSLOIndicator(indicator SLOsIndicator) (json.RawMessage, error) {
+	if indicator == nil {
+		return nil, fmt.Errorf("indicator cannot be nil")
+	}
+	return marshalWithType(indicator, indicator.GetType())
+}
+
+// marshalWithType encodes the JSON object v with its type field set to typ.
+func marshalWithType(v any, typ string) (json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	fields["type"], err = json.Marshal(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(fields)
+}
+
+// marshalUnion encodes the raw value of a union, which is null when it was never set.
+func marshalUnion(union json.RawMessage) ([]byte, error) {
+	if len(union) == 0 {
+		return []byte("null"), nil
+	}
+	return union, nil
+}
+
+// GetIndicator returns the concrete indicator of the SLO.
+func (t SLOsCreateSloRequest_Indicator) GetIndicator() (SLOsIndicator, error) {
+	return UnmarshalSLOIndicator(t.union)
+}
+
+// SetIndicator sets the indicator of the SLO.
+func (t *SLOsCreateSloRequest_Indicator) SetIndicator(indicator SLOsIndicator) error {
+	union, err := marshalSLOIndicator(indicator)
+	if err != nil {
+		return err
+	}
+	t.union = union
+	return nil
+}
+
+func (t SLOsCreateSloRequest_Indicator) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SLOsCreateSloRequest_Indicator) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+// GetIndicator returns the concrete indicator of the SLO.
+func (t SLOsUpdateSloRequest_Indicator) GetIndicator() (SLOsIndicator, error) {
+	return UnmarshalSLOIndicator(t.union)
+}
+
+// SetIndicator sets the indicator of the SLO.
+func (t *SLOsUpdateSloRequest_Indicator) SetIndicator(indicator SLOsIndicator) error {
+	union, err := marshalSLOIndicator(indicator)
+	if err != nil {
+		return err
+	}
+	t.union = union
+	return nil
+}
+
+func (t SLOsUpdateSloRequest_Indicator) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SLOsUpdateSloRequest_Indicator) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+// GetIndicator returns the concrete indicator of the SLO.
+func (t SLOsSloDefinitionResponse_Indicator) GetIndicator() (SLOsIndicator, error) {
+	return UnmarshalSLOIndicator(t.union)
+}
+
+func (t SLOsSloDefinitionResponse_Indicator) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SLOsSloDefinitionResponse_Indicator) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+// GetIndicator returns the concrete indicator of the SLO.
+func (t SLOsSloWithSummaryResponse_Indicator) GetIndicator() (SLOsIndicator, error) {
+	return UnmarshalSLOIndicator(t.union)
+}
+
+func (t SLOsSloWithSummaryResponse_Indicator) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SLOsSloWithSummaryResponse_Indicator) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+// GetFields returns the group by fields, whether they were set as a single field or a list.
+func (t SLOsGroupBy) GetFields() ([]string, error) {
+	if len(t.union) == 0 {
+		return nil, nil
+	}
+
+	var field string
+	if err := json.Unmarshal(t.union, &field); err == nil {
+		return []string{field}, nil
+	}
+
+	var fields []string
+	if err := json.Unmarshal(t.union, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// SetFields sets the group by fields. A single field is sent as a string.
+func (t *SLOsGroupBy) SetFields(fields ...string) error {
+	var value interface{} = fields
+	if len(fields) == 1 {
+		value = fields[0]
+	}
+
+	union, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	t.union = union
+	return nil
+}
+
+func (t SLOsGroupBy) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SLOsGroupBy) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+// getKqlWithFilters decodes a KQL query that is either a plain string or a query with filters.
+func getKqlWithFilters(union json.RawMessage) (SLOsKqlWithFilters1, error) {
+	var query SLOsKqlWithFilters1
+	if len(union) == 0 {
+		return query, nil
+	}
+
+	var kql string
+	if err := json.Unmarshal(union, &kql); err == nil {
+		query.KqlQuery = &kql
+		return query, nil
+	}
+
+	err := json.Unmarshal(union, &query)
+	return query, err
+}
+
+// setKqlWithFilters encodes a KQL query as a plain string unless filters are given.
+func setKqlWithFilters(kql string, filters []SLOsFilter) (json.RawMessage, error) {
+	if len(filters) == 0 {
+		return json.Marshal(kql)
+	}
+	return json.Marshal(SLOsKqlWithFilters1{KqlQuery: &kql, Filters: &filters})
+}
+
+// GetQuery returns the KQL query and its filters.
+func (t SLOsKqlWithFilters) GetQuery() (SLOsKqlWithFilters1, error) {
+	return getKqlWithFilters(t.union)
+}
+
+// SetQuery sets the KQL query and optional filters.
+func (t *SLOsKqlWithFilters) SetQuery(kql string, filters ...SLOsFilter) error {
+	union, err := setKqlWithFilters(kql, filters)
+	if err != nil {
+		return err
+	}
+	t.union = union
+	return nil
+}
+
+func (t SLOsKqlWithFilters) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SLOsKqlWithFilters) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+// GetQuery returns the KQL query and its filters.
+func (t SLOsKqlWithFiltersGood) GetQuery() (SLOsKqlWithFilters1, error) {
+	return getKqlWithFilters(t.union)
+}
+
+// SetQuery sets the KQL query and optional filters.
+func (t *SLOsKqlWithFiltersGood) SetQuery(kql string, filters ...SLOsFilter) error {
+	union, err := setKqlWithFilters(kql, filters)
+	if err != nil {
+		return err
+	}
+	t.union = union
+	return nil
+}
+
+func (t SLOsKqlWithFiltersGood) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SLOsKqlWithFiltersGood) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+// GetQuery returns the KQL query and its filters.
+func (t SLOsKqlWithFiltersTotal) GetQuery() (SLOsKqlWithFilters1, error) {
+	return getKqlWithFilters(t.union)
+}
+
+// SetQuery sets the KQL query and optional filters.
+func (t *SLOsKqlWithFiltersTotal) SetQuery(kql string, filters ...SLOsFilter) error {
+	union, err := setKqlWithFilters(kql, filters)
+	if err != nil {
+		return err
+	}
+	t.union = union
+	return nil
+}
+
+func (t SLOsKqlWithFiltersTotal) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SLOsKqlWithFiltersTotal) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+// GetMetric returns the metric. Field is empty for doc_count metrics.
+func (t SLOsIndicatorPropertiesCustomMetric_Params_Good_Metrics_Item) GetMetric() (SLOsIndicatorPropertiesCustomMetricParamsGoodMetrics0, error) {
+	var metric SLOsIndicatorPropertiesCustomMetricParamsGoodMetrics0
+	err := json.Unmarshal(t.union, &metric)
+	return metric, err
+}
+
+// SetMetric sets the metric, either a SLOsIndicatorPropertiesCustomMetricParamsGoodMetrics0
+// aggregating a field or a SLOsIndicatorPropertiesCustomMetricParamsGoodMetrics1 doc_count metric.
+func (t *SLOsIndicatorPropertiesCustomMetric_Params_Good_Metrics_Item) SetMetric(metric interface{}) error {
+	switch metric.(type) {
+	case SLOsIndicatorPropertiesCustomMetricParamsGoodMetrics0, SLOsIndicatorPropertiesCustomMetricParamsGoodMetrics1:
+	default:
+		return fmt.Errorf("unsupported good metric type %T", metric)
+	}
+
+	union, err := json.Marshal(metric)
+	if err != nil {
+		return err
+	}
+	t.union = union
+	return nil
+}
+
+func (t SLOsIndicatorPropertiesCustomMetric_Params_Good_Metrics_Item) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SLOsIndicatorPropertiesCustomMetric_Params_Good_Metrics_Item) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+// GetMetric returns the metric. Field is empty for doc_count metrics.
+func (t SLOsIndicatorPropertiesCustomMetric_Params_Total_Metrics_Item) GetMetric() (SLOsIndicatorPropertiesCustomMetricParamsTotalMetrics0, error) {
+	var metric SLOsIndicatorPropertiesCustomMetricParamsTotalMetrics0
+	err := json.Unmarshal(t.union, &metric)
+	return metric, err
+}
+
+// SetMetric sets the metric, either a SLOsIndicatorPropertiesCustomMetricParamsTotalMetrics0
+// aggregating a field or a SLOsIndicatorPropertiesCustomMetricParamsTotalMetrics1 doc_count metric.
+func (t *SLOsIndicatorPropertiesCustomMetric_Params_Total_Metrics_Item) SetMetric(metric interface{}) error {
+	switch metric.(type) {
+	case SLOsIndicatorPropertiesCustomMetricParamsTotalMetrics0, SLOsIndicatorPropertiesCustomMetricParamsTotalMetrics1:
+	default:
+		return fmt.Errorf("unsupported total metric type %T", metric)
+	}
+
+	union, err := json.Marshal(metric)
+	if err != nil {
+		return err
+	}
+	t.union = union
+	return nil
+}
+
+func (t SLOsIndicatorPropertiesCustomMetric_Params_Total_Metrics_Item) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SLOsIndicatorPropertiesCustomMetric_Params_Total_Metrics_Item) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+// GetMetric returns the timeslice metric as its concrete type based on its aggregation:
+// SLOsTimesliceMetricDocCountMetric, SLOsTimesliceMetricPercentileMetric or
+// SLOsTimesliceMetricBasicMetricWithField.
+func (t SLOsIndicatorPropertiesTimesliceMetric_Params_Metric_Metrics_Item) GetMetric() (interface{}, error) {
+	var aggregationContainer struct {
+		Aggregation string `json:"aggregation"`
+	}
+	if err := json.Unmarshal(t.union, &aggregationContainer); err != nil {
+		return nil, err
+	}
+
+	switch aggregationContainer.Aggregation {
+	case "doc_count":
+		var metric SLOsTimesliceMetricDocCountMetric
+		err := json.Unmarshal(t.union, &metric)
+		return metric, err
+	case "percentile":
+		var metric SLOsTimesliceMetricPercentileMetric
+		err := json.Unmarshal(t.union, &metric)
+		return metric, err
+	default:
+		var metric SLOsTimesliceMetricBasicMetricWithField
+		err := json.Unmarshal(t.union, &metric)
+		return metric, err
+	}
+}
+
+// SetMetric sets the timeslice metric, one of SLOsTimesliceMetricDocCountMetric,
+// SLOsTimesliceMetricPercentileMetric or SLOsTimesliceMetricBasicMetricWithField.
+func (t *SLOsIndicatorPropertiesTimesliceMetric_Params_Metric_Metrics_Item) SetMetric(metric interface{}) error {
+	switch metric.(type) {
+	case SLOsTimesliceMetricDocCountMetric, SLOsTimesliceMetricPercentileMetric, SLOsTimesliceMetricBasicMetricWithField:
+	default:
+		return fmt.Errorf("unsupported timeslice metric type %T", metric)
+	}
+
+	union, err := json.Marshal(metric)
+	if err != nil {
+		return err
+	}
+	t.union = union
+	return nil
+}
+
+func (t SLOsIndicatorPropertiesTimesliceMetric_Params_Metric_Metrics_Item) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SLOsIndicatorPropertiesTimesliceMetric_Params_Metric_Metrics_Item) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+// GetResults returns the SLO definitions of the page, regardless of the
+// pagination style Kibana answered with.
+func (t SLOsFindSloDefinitionsResponse) GetResults() ([]SLOsSloWithSummaryResponse, error) {
+	var page struct {
+		Results []SLOsSloWithSummaryResponse `json:"results"`
+	}
+	if len(t.union) == 0 {
+		return nil, nil
+	}
+	if err := json.Unmarshal(t.union, &page); err != nil {
+		return nil, err
+	}
+	return page.Results, nil
+}
+
+func (t SLOsFindSloDefinitionsResponse) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SLOsFindSloDefinitionsResponse) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
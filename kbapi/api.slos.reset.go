@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SLOsResetResponse wraps the response from a SLOs.Reset call
+type SLOsResetResponse Response[SLOsSloDefinitionResponse]
+
+type SLOsResetRequest struct {
+	SloID string
+}
+
+// newSLOsReset returns a function that performs POST /api/observability/slos/{sloId}/_reset API requests
+func (api *API) newSLOsReset() func(context.Context, *SLOsResetRequest, ...RequestOption) (*SLOsResetResponse, error) {
+	return func(ctx context.Context, req *SLOsResetRequest, opts ...RequestOption) (*SLOsResetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, SLOsSloDefinitionResponse](ctx, api, operation{
+			name:    "slos.reset",
+			feature: FeatureSLOs,
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/api/observability/slos/%s/_reset", req.SloID),
+		}, nil, opts)
+		return (*SLOsResetResponse)(res), err
+	}
+}
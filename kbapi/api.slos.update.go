@@ -0,0 +1,32 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SLOsUpdateResponse wraps the response from a SLOs.Update call
+type SLOsUpdateResponse Response[SLOsSloDefinitionResponse]
+
+type SLOsUpdateRequest struct {
+	SloID string
+	Body  SLOsUpdateSloRequest
+}
+
+// newSLOsUpdate returns a function that performs PUT /api/observability/slos/{sloId} API requests
+func (api *API) newSLOsUpdate() func(context.Context, *SLOsUpdateRequest, ...RequestOption) (*SLOsUpdateResponse, error) {
+	return func(ctx context.Context, req *SLOsUpdateRequest, opts ...RequestOption) (*SLOsUpdateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SLOsUpdateSloRequest, SLOsSloDefinitionResponse](ctx, api, operation{
+			name:    "slos.update",
+			feature: FeatureSLOs,
+			method:  http.MethodPut,
+			path:    fmt.Sprintf("/api/observability/slos/%s", req.SloID),
+		}, &req.Body, opts)
+		return (*SLOsUpdateResponse)(res), err
+	}
+}
@@ -0,0 +1,121 @@
+package kbapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpaces_RequestBodies(t *testing.T) {
+	ctx := context.Background()
+	objects := []Object{{Type: "dashboard", ID: "dash-1"}}
+
+	testCases := []struct {
+		name   string
+		call   func(api *API) error
+		method string
+		path   string
+		body   interface{}
+	}{
+		{
+			name: "create",
+			call: func(api *API) error {
+				_, err := api.Spaces.Create(ctx, &SpacesCreateRequest{
+					Body: SpacesCreateRequestBody{ID: "marketing", Name: "Marketing"},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/spaces/space",
+			body:   map[string]interface{}{"id": "marketing", "name": "Marketing"},
+		},
+		{
+			name: "copy objects",
+			call: func(api *API) error {
+				_, err := api.Spaces.CopyObjects(ctx, &SpacesCopyObjectsRequest{
+					Body: SpacesCopyObjectsRequestBody{Objects: objects, Spaces: []string{"marketing"}},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/spaces/_copy_saved_objects",
+			body: map[string]interface{}{
+				"objects": []interface{}{map[string]interface{}{"type": "dashboard", "id": "dash-1"}},
+				"spaces":  []string{"marketing"},
+			},
+		},
+		{
+			name: "update",
+			call: func(api *API) error {
+				_, err := api.Spaces.Update(ctx, &SpacesUpdateRequest{
+					ID:   "marketing",
+					Body: SpacesUpdateRequestBody{ID: "marketing", Name: "Marketing EMEA"},
+				})
+				return err
+			},
+			method: "PUT",
+			path:   "/api/spaces/space/marketing",
+			body:   map[string]interface{}{"id": "marketing", "name": "Marketing EMEA"},
+		},
+		{
+			name: "update objects",
+			call: func(api *API) error {
+				_, err := api.Spaces.UpdateObjects(ctx, &SpacesUpdateObjectsRequest{
+					Body: SpacesUpdateObjectsRequestBody{Objects: objects, SpacesToAdd: []string{"marketing"}, SpacesToRemove: []string{}},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/spaces/_update_objects_spaces",
+			body: map[string]interface{}{
+				"objects":        []interface{}{map[string]interface{}{"type": "dashboard", "id": "dash-1"}},
+				"spacesToAdd":    []string{"marketing"},
+				"spacesToRemove": []string{},
+			},
+		},
+		{
+			name: "disable legacy URL aliases",
+			call: func(api *API) error {
+				_, err := api.Spaces.SpacesDisableLegacyURLAliases(ctx, &SpacesDisableLegacyURLRequest{
+					ID:   "marketing",
+					Body: SpacesDisableLegacyURLRequestBody{Aliases: []SpacesAlias{{SourceId: "legacy-1", TargetSpace: "marketing", TargetType: "dashboard"}}},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/spaces/_disable_legacy_url_aliases",
+			body: map[string]interface{}{
+				"aliases": []interface{}{map[string]interface{}{"sourceId": "legacy-1", "targetSpace": "marketing", "targetType": "dashboard"}},
+			},
+		},
+		{
+			name: "get shareable references",
+			call: func(api *API) error {
+				_, err := api.Spaces.GetShareableReferences(ctx, &SpacesShareableReferencesRequest{
+					Body: SpacesShareableReferencesRequestBody{Objects: objects},
+				})
+				return err
+			},
+			method: "POST",
+			path:   "/api/spaces/_get_shareable_references",
+			body: map[string]interface{}{
+				"objects": []interface{}{map[string]interface{}{"type": "dashboard", "id": "dash-1"}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockTransport := NewMockTransportWithRawResponse(200, `{}`, nil)
+			api := New(mockTransport)
+
+			require.NoError(t, tc.call(api))
+
+			req := mockTransport.LastRequest()
+			AssertRequestMethod(t, req, tc.method)
+			AssertRequestPath(t, req, tc.path)
+			AssertRequestBodyJSON(t, req, tc.body)
+		})
+	}
+}
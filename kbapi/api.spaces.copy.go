@@ -1,24 +1,23 @@
 package kbapi
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 )
 
-// SpacesCopyObjectsResponse  wraps the response from a SavedObjectImport call
-type SpacesCopyObjectsResponse struct {
-	StatusCode int
-	Body       *SpacesCopyObjectsResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+// SpacesCopyObjectsResponse wraps the response from a Spaces.CopyObjects call
+type SpacesCopyObjectsResponse Response[SpacesCopyObjectsResponseBody]
 
+// SpacesCopyObjectsResponseBody holds the copy results keyed by destination space ID.
 type SpacesCopyObjectsResponseBody struct {
-	Spaces map[string]CopyResult `json:",inline"`
+	Spaces map[string]CopyResult
+}
+
+// UnmarshalJSON decodes the results Kibana returns as an object keyed by space ID.
+func (b *SpacesCopyObjectsResponseBody) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &b.Spaces)
 }
 
 // CopyResult represents the import results for a specific namespace
@@ -30,8 +29,10 @@ type CopyResult struct {
 	SuccessCount int `json:"successCount,omitempty"`
 
 	// SuccessResults contains the details of successfully imported objects
-	// Using the exact type you specified
-	SuccessResults *[]map[string]interface{} `json:"successResults,omitempty"`
+	SuccessResults []SavedObjectImportSuccess `json:"successResults,omitempty"`
+
+	// Errors contains the objects that could not be copied, resolve them with Spaces.ResolveCopyErrors
+	Errors []SavedObjectImportError `json:"errors,omitempty"`
 }
 
 // SavedObjectExportRequest is the request for newFleetBulkGetAgentPolicies
@@ -56,108 +57,18 @@ type SpacesCopyObjectsRequestBody struct {
 	Spaces    []string `json:"spaces"`
 }
 
-// newSavedObjectImport returns a function that performs POST /api/saved_objects/_import API requests
+// newSpacesCopyObjects returns a function that performs POST /api/spaces/_copy_saved_objects API requests
 func (api *API) newSpacesCopyObjects() func(context.Context, *SpacesCopyObjectsRequest, ...RequestOption) (*SpacesCopyObjectsResponse, error) {
 	return func(ctx context.Context, req *SpacesCopyObjectsRequest, opts ...RequestOption) (*SpacesCopyObjectsResponse, error) {
 		if req == nil {
 			return nil, fmt.Errorf("Objects is not defined")
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "spaces.copy")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/spaces/_copy_saved_objects"
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		jsonBody, err := json.Marshal(req.Body)
-		if err != nil {
-			return nil, err
-		}
-
-		httpReq.Body = io.NopCloser(bytes.NewReader(jsonBody))
-		httpReq.Header.Set("Content-Type", "application/json")
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "spaces.copy")
-			if reader := instrument.RecordRequestBody(ctx, "spaces.copy", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &SpacesCopyObjectsResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result SpacesCopyObjectsResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[SpacesCopyObjectsRequestBody, SpacesCopyObjectsResponseBody](ctx, api, operation{
+			name:   "spaces.copy",
+			method: http.MethodPost,
+			path:   "/api/spaces/_copy_saved_objects",
+		}, &req.Body, opts)
+		return (*SpacesCopyObjectsResponse)(res), err
 	}
 }
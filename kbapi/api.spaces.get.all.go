@@ -2,20 +2,13 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // SpacesGetAllResponse wraps the response from a SpacesGetAll call
-type SpacesGetAllResponse struct {
-	StatusCode int
-	Body       *SpacesGetAllResponseBody
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type SpacesGetAllResponse Response[SpacesGetAllResponseBody]
 
 type Space struct {
 	ID               string   `json:"id"`
@@ -51,113 +44,23 @@ func (api *API) newSpacesGetAll() func(context.Context, *SpacesGetAllRequest, ..
 		if req == nil {
 			req = &SpacesGetAllRequest{}
 		}
-
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "spaces.get_all")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/spaces/space"
 		//
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.Params.Purpose != nil {
-			params["purpose"] = *req.Params.Purpose
+			params.Set("purpose", *req.Params.Purpose)
 		}
 		if req.Params.IncludeAuthorizedPurposes != nil {
-			params["include_authorized_purposes"] = strconv.FormatBool(*req.Params.IncludeAuthorizedPurposes)
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "spaces.get_all")
-			if reader := instrument.RecordRequestBody(ctx, "spaces.get_all", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
+			params.Set("include_authorized_purposes", strconv.FormatBool(*req.Params.IncludeAuthorizedPurposes))
 		}
 
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &SpacesGetAllResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result SpacesGetAllResponseBody
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, SpacesGetAllResponseBody](ctx, api, operation{
+			name:   "spaces.get_all",
+			method: http.MethodGet,
+			path:   "/api/spaces/space",
+			query:  params,
+		}, nil, opts)
+		return (*SpacesGetAllResponse)(res), err
 	}
 }
@@ -124,7 +124,7 @@ func TestAPISpacesGetAll(t *testing.T) {
 					// For string error responses, verify error string was set
 					if test.name == "Error with 404 status code as string" {
 						require.NotNil(t, resp)
-						assert.Equal(t, "Not Found", resp.Error)
+						assert.Equal(t, "Not Found", resp.Error.Message)
 					}
 				}
 				return
@@ -114,7 +114,7 @@ func TestAPISpacesGet(t *testing.T) {
 					// For string error responses, verify error string was set
 					if test.name == "Error with 404 status code as string" {
 						require.NotNil(t, resp)
-						assert.Equal(t, "Not Found", resp.Error)
+						assert.Equal(t, "Not Found", resp.Error.Message)
 					}
 				}
 				return
@@ -0,0 +1,499 @@
+package kbapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// DefaultSpaceCloneTypes are the saved object types copied by CloneSpace when
+// SpaceCloneRequest.Types is empty. Connectors and rules are replicated
+// through their own APIs and are not part of the list.
+var DefaultSpaceCloneTypes = []string{
+	"canvas-element",
+	"canvas-workpad",
+	"dashboard",
+	"event-annotation-group",
+	"graph-workspace",
+	"index-pattern",
+	"lens",
+	"links",
+	"map",
+	"query",
+	"search",
+	"tag",
+	"visualization",
+}
+
+// Kinds of the items reported by CloneSpace.
+const (
+	SpaceCloneSavedObject   = "saved_object"
+	SpaceCloneConnector     = "connector"
+	SpaceCloneRule          = "rule"
+	SpaceCloneDetectionRule = "detection_rule"
+	SpaceCloneExceptionList = "exception_list"
+)
+
+// detectionRuleTypePrefix is the prefix of the alerting rule types of
+// detection rules, which are cloned with the detection engine API.
+const detectionRuleTypePrefix = "siem."
+
+type SpaceCloneRequest struct {
+	// SourceID The ID of the space to clone.
+	SourceID string
+	// ID The ID of the new space.
+	ID string
+	// Name The display name of the new space, the name of the source space when empty.
+	Name string
+	// Types The saved object types to copy, DefaultSpaceCloneTypes when empty.
+	Types []string
+	// ConnectorSecrets The secrets of the connectors keyed by connector ID. Kibana
+	// never returns secrets, so connectors that require them cannot be cloned without them.
+	ConnectorSecrets map[string]json.RawMessage
+}
+
+// SpaceCloneReport lists what CloneSpace cloned and what it could not clone.
+type SpaceCloneReport struct {
+	Cloned []SpaceCloneItem
+	Failed []SpaceCloneItem
+}
+
+// SpaceCloneItem is a saved object, connector, rule or exception list of the source space.
+type SpaceCloneItem struct {
+	// Kind One of the SpaceClone* kinds.
+	Kind string
+	// Type The saved object type, connector type or rule type.
+	Type string
+	// ID The ID in the source space, the rule_id or list_id for detection rules and exception lists.
+	ID   string
+	Name string
+	// DestinationID The ID in the new space, when it differs from ID.
+	DestinationID string
+	// Reason Why the item could not be cloned.
+	Reason string
+}
+
+// CloneSpace creates a space with the settings of req.SourceID, then copies
+// its saved objects with their references and replicates its connectors,
+// alerting rules and detection rules. Saved objects that conflict with
+// objects shared with the new space are copied with a new ID. Connectors and
+// alerting rules get IDs generated by Kibana, and the actions of the cloned
+// rules reference the cloned connectors.
+// Preconfigured connectors are available in every space and are not cloned.
+//
+// Items that cannot be cloned are listed in SpaceCloneReport.Failed. An error
+// is returned when a request fails, along with the report of what was cloned
+// so far once the space exists.
+func (api *API) CloneSpace(ctx context.Context, req *SpaceCloneRequest, opts ...RequestOption) (*SpaceCloneReport, error) {
+	if req == nil {
+		return nil, fmt.Errorf("Request cannot be nil")
+	}
+	if req.SourceID == "" || req.ID == "" {
+		return nil, fmt.Errorf("SourceID and ID must be set")
+	}
+
+	source, err := api.Spaces.Get(ctx, &SpacesGetRequest{ID: req.SourceID}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	name := req.Name
+	if name == "" {
+		name = source.Body.Name
+	}
+	body := SpacesCreateRequestBody{
+		ID:               req.ID,
+		Name:             name,
+		Color:            source.Body.Color,
+		DisabledFeatures: &source.Body.DisabledFeatures,
+	}
+	if source.Body.Description != "" {
+		body.Description = StrPtr(source.Body.Description)
+	}
+	if source.Body.ImageURL != "" {
+		body.ImageUrl = StrPtr(source.Body.ImageURL)
+	}
+	if source.Body.Initials != "" {
+		body.Initials = StrPtr(source.Body.Initials)
+	}
+	if source.Body.Solution != "" {
+		body.Solution = StrPtr(source.Body.Solution)
+	}
+	if _, err := api.Spaces.Create(ctx, &SpacesCreateRequest{Body: body}, opts...); err != nil {
+		return nil, err
+	}
+
+	c := spaceCloner{
+		src:          api.Space(req.SourceID),
+		dst:          api.Space(req.ID),
+		req:          req,
+		opts:         opts,
+		report:       &SpaceCloneReport{},
+		connectorIDs: map[string]string{},
+	}
+	for _, step := range []func(context.Context) error{c.savedObjects, c.connectors, c.rules, c.detectionRules} {
+		if err := step(ctx); err != nil {
+			return c.report, err
+		}
+	}
+	return c.report, nil
+}
+
+// spaceCloner replicates the content of a space into another one.
+type spaceCloner struct {
+	src    *API
+	dst    *API
+	req    *SpaceCloneRequest
+	opts   []RequestOption
+	report *SpaceCloneReport
+	// connectorIDs maps the IDs of the cloned connectors to their IDs in the new space
+	connectorIDs map[string]string
+}
+
+func (c *spaceCloner) cloned(item SpaceCloneItem) {
+	c.report.Cloned = append(c.report.Cloned, item)
+}
+
+func (c *spaceCloner) failed(item SpaceCloneItem, reason string) {
+	item.Reason = reason
+	c.report.Failed = append(c.report.Failed, item)
+}
+
+// savedObjects copies the saved objects of the source space. Conflicts are
+// resolved with new copies and missing references are ignored.
+func (c *spaceCloner) savedObjects(ctx context.Context) error {
+	types := c.req.Types
+	if len(types) == 0 {
+		types = DefaultSpaceCloneTypes
+	}
+
+	var objects []Object
+	for obj, err := range c.src.SavedObjects.All(ctx, &SavedObjectFindRequest{Params: SavedObjectFindRequestParams{Types: types, Fields: []string{"title"}}}, c.opts...) {
+		if err != nil {
+			return err
+		}
+		objects = append(objects, Object{Type: obj.Type, ID: obj.ID})
+	}
+	if len(objects) == 0 {
+		return nil
+	}
+
+	copied, err := c.src.Spaces.CopyObjects(ctx, &SpacesCopyObjectsRequest{Body: SpacesCopyObjectsRequestBody{
+		Objects:           objects,
+		IncludeReferences: BoolPtr(true),
+		Spaces:            []string{c.req.ID},
+	}}, c.opts...)
+	if err != nil {
+		return err
+	}
+	result := copied.Body.Spaces[c.req.ID]
+	c.copySuccesses(result.SuccessResults)
+	if len(result.Errors) == 0 {
+		return nil
+	}
+
+	var retries []SavedObjectRetryOperationItem
+	for _, copyErr := range result.Errors {
+		retry := SavedObjectRetryOperationItem{Type: copyErr.Type, ID: copyErr.ID}
+		switch copyErr.Error.Type {
+		case SavedObjectImportErrorConflict, SavedObjectImportErrorAmbiguousConflict:
+			id, err := newUUID()
+			if err != nil {
+				return err
+			}
+			retry.CreateNewCopy = BoolPtr(true)
+			retry.DestinationID = StrPtr(id)
+		case SavedObjectImportErrorMissingReferences:
+			retry.IgnoreMissingReferences = BoolPtr(true)
+		default:
+			c.copyFailed(copyErr)
+			continue
+		}
+		retries = append(retries, retry)
+	}
+	if len(retries) == 0 {
+		return nil
+	}
+
+	resolved, err := c.src.Spaces.ResolveCopyErrors(ctx, &SpacesResolveCopyErrorsRequest{Body: SpacesResolveCopyErrorsRequestBody{
+		Objects:           objects,
+		IncludeReferences: BoolPtr(true),
+		Retries:           map[string][]SavedObjectRetryOperationItem{c.req.ID: retries},
+	}}, c.opts...)
+	if err != nil {
+		return err
+	}
+	result = resolved.Body.Spaces[c.req.ID]
+	c.copySuccesses(result.SuccessResults)
+	for _, copyErr := range result.Errors {
+		c.copyFailed(copyErr)
+	}
+	return nil
+}
+
+func (c *spaceCloner) copySuccesses(successes []SavedObjectImportSuccess) {
+	for _, success := range successes {
+		item := SpaceCloneItem{Kind: SpaceCloneSavedObject, Type: success.Type, ID: success.ID}
+		if success.Meta.Title != nil {
+			item.Name = *success.Meta.Title
+		}
+		if success.DestinationID != nil && *success.DestinationID != success.ID {
+			item.DestinationID = *success.DestinationID
+		}
+		c.cloned(item)
+	}
+}
+
+func (c *spaceCloner) copyFailed(copyErr SavedObjectImportError) {
+	item := SpaceCloneItem{Kind: SpaceCloneSavedObject, Type: copyErr.Type, ID: copyErr.ID}
+	switch {
+	case copyErr.Meta.Title != nil:
+		item.Name = *copyErr.Meta.Title
+	case copyErr.Title != nil:
+		item.Name = *copyErr.Title
+	}
+	reason := copyErr.Error.Type
+	if copyErr.Error.Message != nil && *copyErr.Error.Message != "" {
+		reason = *copyErr.Error.Message
+	}
+	c.failed(item, reason)
+}
+
+// connectors creates the connectors of the source space in the new space and
+// records their new IDs, so that the actions of the cloned rules reference them.
+func (c *spaceCloner) connectors(ctx context.Context) error {
+	connectors, err := c.src.Connectors.List(ctx, c.opts...)
+	if err != nil {
+		return err
+	}
+
+	if connectors.Body == nil {
+		return nil
+	}
+
+	for _, connector := range *connectors.Body {
+		if connector.IsPreconfigured || connector.IsSystemActionType {
+			continue
+		}
+		item := SpaceCloneItem{Kind: SpaceCloneConnector, Type: connector.ConnectorTypeID, ID: connector.ID, Name: connector.Name}
+
+		config, err := json.Marshal(connector.Config)
+		if err != nil {
+			return fmt.Errorf("failed to encode the config of connector %s: %w", connector.ID, err)
+		}
+		created, err := c.dst.Connectors.Create(ctx, &ConnectorsCreateRequest{Body: ConnectorsCreateRequestBody{
+			Name:            connector.Name,
+			ConnectorTypeID: connector.ConnectorTypeID,
+			Config:          config,
+			Secrets:         c.req.ConnectorSecrets[connector.ID],
+		}}, c.opts...)
+		if err != nil {
+			if StatusCodeOf(err) == 0 {
+				return err
+			}
+			c.failed(item, err.Error())
+			continue
+		}
+		c.connectorIDs[connector.ID] = created.Body.ID
+		item.DestinationID = created.Body.ID
+		c.cloned(item)
+	}
+	return nil
+}
+
+// rules creates the alerting rules of the source space in the new space.
+// Rules that are disabled in the source space are disabled once created.
+func (c *spaceCloner) rules(ctx context.Context) error {
+	for rule, err := range c.src.Alerting.All(ctx, &AlertingListRequest{}, c.opts...) {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(rule.RuleTypeID, detectionRuleTypePrefix) {
+			continue
+		}
+		item := SpaceCloneItem{Kind: SpaceCloneRule, Type: rule.RuleTypeID, ID: rule.ID, Name: rule.Name}
+
+		actions := make([]ActionCreate, 0, len(rule.Actions))
+		for _, action := range rule.Actions {
+			clone := cloneRuleAction(action, rule.Throttle)
+			if id, ok := c.connectorIDs[action.ID]; ok {
+				clone.ID = id
+			}
+			actions = append(actions, clone)
+		}
+		created, err := c.dst.Alerting.Create(ctx, &AlertingCreateRequest{Body: AlertingCreateRequestBody{
+			Name:       rule.Name,
+			Tags:       rule.Tags,
+			Params:     rule.Params,
+			Actions:    actions,
+			Consumer:   rule.Consumer,
+			Schedule:   rule.Schedule,
+			RuleTypeID: rule.RuleTypeID,
+		}}, c.opts...)
+		if err != nil {
+			if StatusCodeOf(err) == 0 {
+				return err
+			}
+			c.failed(item, err.Error())
+			continue
+		}
+		item.DestinationID = created.Body.ID
+
+		if !rule.Enabled {
+			_, err := c.dst.Alerting.Disable(ctx, &AlertingDisableRequest{ID: created.Body.ID}, c.opts...)
+			if err != nil {
+				if StatusCodeOf(err) == 0 {
+					return err
+				}
+				c.failed(item, fmt.Sprintf("created but not disabled: %s", err))
+				continue
+			}
+		}
+		c.cloned(item)
+	}
+	return nil
+}
+
+// cloneRuleAction converts an action of a rule to the action of a new rule.
+// Actions created before per-action frequencies inherit the rule throttle.
+func cloneRuleAction(action ActionResponse, throttle *string) ActionCreate {
+	clone := ActionCreate{ID: action.ID, Params: action.Params}
+	if action.Group != nil {
+		clone.Group = *action.Group
+	}
+	switch {
+	case action.Frequency != nil:
+		clone.Frequency = *action.Frequency
+	case throttle != nil:
+		clone.Frequency = Frequency{NotifyWhen: "onThrottleInterval", Throttle: throttle}
+	default:
+		clone.Frequency = Frequency{NotifyWhen: "onActionGroupChange"}
+	}
+	return clone
+}
+
+// detectionRules exports the detection rules of the source space with their
+// exception lists and imports them in the new space. The connectors of the
+// export are left out, they were already cloned with their secrets, and the
+// actions of the rules are updated to reference the cloned connectors.
+func (c *spaceCloner) detectionRules(ctx context.Context) error {
+	names := map[string]string{}
+	var objects []SecurityDetectionsExportRulesRequestBodyItems
+	for raw, err := range c.src.SecurityDetections.AllRules(ctx, &SecurityDetectionsListRulesRequest{}, c.opts...) {
+		if err != nil {
+			return err
+		}
+		var rule struct {
+			RuleID string `json:"rule_id"`
+			Name   string `json:"name"`
+		}
+		if err := json.Unmarshal(raw, &rule); err != nil {
+			return fmt.Errorf("failed to decode detection rule: %w", err)
+		}
+		names[rule.RuleID] = rule.Name
+		objects = append(objects, SecurityDetectionsExportRulesRequestBodyItems{RuleID: rule.RuleID})
+	}
+	if len(objects) == 0 {
+		return nil
+	}
+
+	exported, err := c.src.SecurityDetections.ExportRulesStream(ctx, &SecurityDetectionsExportRulesRequest{Body: SecurityDetectionsExportRulesRequestBody{Objects: objects}}, c.opts...)
+	if err != nil {
+		return err
+	}
+	defer exported.Stream.Close()
+
+	var file bytes.Buffer
+	for line, err := range exported.Stream.Objects() {
+		if err != nil {
+			return err
+		}
+		var object struct {
+			Type       string          `json:"type"`
+			Attributes json.RawMessage `json:"attributes"`
+		}
+		if err := json.Unmarshal(line, &object); err != nil {
+			return fmt.Errorf("failed to decode exported object: %w", err)
+		}
+		if object.Type == "action" && object.Attributes != nil {
+			continue
+		}
+		line, err = c.replaceActionConnectors(line)
+		if err != nil {
+			return err
+		}
+		file.Write(line)
+		file.WriteByte('\n')
+	}
+
+	imported, err := c.dst.SecurityDetections.ImportRule(ctx, &SecurityDetectionsImportRulesRequest{Body: SecurityDetectionsImportRulesRequestBody{File: file.Bytes()}}, c.opts...)
+	if err != nil {
+		return err
+	}
+
+	failed := map[string]bool{}
+	for _, importErr := range imported.Body.Errors {
+		item := SpaceCloneItem{Kind: SpaceCloneDetectionRule}
+		switch {
+		case importErr.RuleID != nil:
+			item.ID = *importErr.RuleID
+		case importErr.ID != nil:
+			item.ID = *importErr.ID
+		}
+		item.Name = names[item.ID]
+		failed[item.ID] = true
+		c.failed(item, importErr.Error.Message)
+	}
+	for _, importErr := range imported.Body.ExceptionsErrors {
+		item := SpaceCloneItem{Kind: SpaceCloneExceptionList}
+		if importErr.ListID != nil {
+			item.ID = *importErr.ListID
+		}
+		c.failed(item, importErr.Error.Message)
+	}
+	for _, object := range objects {
+		if !failed[object.RuleID] {
+			c.cloned(SpaceCloneItem{Kind: SpaceCloneDetectionRule, ID: object.RuleID, Name: names[object.RuleID]})
+		}
+	}
+	return nil
+}
+
+// replaceActionConnectors returns the exported detection rule with the IDs of
+// the connectors of its actions replaced by the IDs of the cloned connectors.
+func (c *spaceCloner) replaceActionConnectors(line []byte) ([]byte, error) {
+	var rule map[string]json.RawMessage
+	if err := json.Unmarshal(line, &rule); err != nil {
+		return nil, fmt.Errorf("failed to decode exported object: %w", err)
+	}
+	if rule["actions"] == nil {
+		return line, nil
+	}
+	var actions []map[string]json.RawMessage
+	if err := json.Unmarshal(rule["actions"], &actions); err != nil {
+		return nil, fmt.Errorf("failed to decode the actions of detection rule: %w", err)
+	}
+
+	replaced := false
+	for _, action := range actions {
+		var id string
+		if err := json.Unmarshal(action["id"], &id); err != nil {
+			continue
+		}
+		if newID, ok := c.connectorIDs[id]; ok {
+			action["id"], _ = json.Marshal(newID)
+			replaced = true
+		}
+	}
+	if !replaced {
+		return line, nil
+	}
+
+	var err error
+	if rule["actions"], err = json.Marshal(actions); err != nil {
+		return nil, err
+	}
+	return json.Marshal(rule)
+}
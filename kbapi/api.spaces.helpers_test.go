@@ -0,0 +1,138 @@
+package kbapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPI_CloneSpace(t *testing.T) {
+	var created SpacesCreateRequestBody
+	var resolved SpacesResolveCopyErrorsRequestBody
+	var connectors, rules []string
+	var disabled []string
+	var importedFile string
+
+	api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+		switch req.Method + " " + req.URL.Path {
+		case "GET /api/spaces/space/marketing":
+			return jsonResponse(t, 200, Space{ID: "marketing", Name: "Marketing", Description: "Campaigns", DisabledFeatures: []string{"ml"}, Initials: "MK"}), nil
+		case "POST /api/spaces/space":
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&created))
+			return jsonResponse(t, 200, map[string]interface{}{}), nil
+		case "GET /s/marketing/api/saved_objects/_find":
+			return jsonResponse(t, 200, map[string]interface{}{
+				"page": 1, "per_page": 100, "total": 2,
+				"saved_objects": []map[string]interface{}{{"id": "dash-1", "type": "dashboard"}, {"id": "vis-1", "type": "visualization"}},
+			}), nil
+		case "POST /s/marketing/api/spaces/_copy_saved_objects":
+			return jsonResponse(t, 200, map[string]interface{}{
+				"marketing-copy": map[string]interface{}{
+					"success": false, "successCount": 1,
+					"successResults": []map[string]interface{}{{"id": "vis-1", "type": "visualization", "meta": map[string]interface{}{"title": "Visits"}}},
+					"errors": []map[string]interface{}{
+						{"id": "dash-1", "type": "dashboard", "meta": map[string]interface{}{"title": "Overview"}, "error": map[string]interface{}{"type": "conflict"}},
+						{"id": "ip-1", "type": "index-pattern", "meta": map[string]interface{}{"title": "logs-*"}, "error": map[string]interface{}{"type": "unknown", "message": "boom"}},
+					},
+				},
+			}), nil
+		case "POST /s/marketing/api/spaces/_resolve_copy_saved_objects_errors":
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&resolved))
+			destinationID := *resolved.Retries["marketing-copy"][0].DestinationID
+			return jsonResponse(t, 200, map[string]interface{}{
+				"marketing-copy": map[string]interface{}{
+					"success": true, "successCount": 1,
+					"successResults": []map[string]interface{}{{"id": "dash-1", "type": "dashboard", "destinationId": destinationID, "createNewCopy": true, "meta": map[string]interface{}{"title": "Overview"}}},
+				},
+			}), nil
+		case "GET /s/marketing/api/actions/connectors":
+			return jsonResponse(t, 200, []map[string]interface{}{
+				{"id": "slack", "name": "Slack", "connector_type_id": ".slack", "config": map[string]interface{}{}},
+				{"id": "email", "name": "Email", "connector_type_id": ".email", "config": map[string]interface{}{"from": "a@b.c"}},
+				{"id": "preconfigured", "name": "Preconfigured", "connector_type_id": ".index", "is_preconfigured": true},
+			}), nil
+		case "POST /s/marketing-copy/api/actions/connector":
+			var body ConnectorsCreateRequestBody
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			connectors = append(connectors, body.Name)
+			if body.Name == "Email" {
+				return jsonResponse(t, 400, map[string]interface{}{"statusCode": 400, "error": "Bad Request", "message": "secrets are required"}), nil
+			}
+			return jsonResponse(t, 200, map[string]interface{}{"id": "slack-copy"}), nil
+		case "GET /s/marketing/api/alerting/rules/_find":
+			return jsonResponse(t, 200, AlertingListResponseBody{Page: 1, PerPage: 100, Total: 2, Data: []AlertingResponseBase{
+				{ID: "rule-1", Name: "CPU", RuleTypeID: ".es-query", Consumer: "alerts", Actions: []ActionResponse{{ID: "slack", Group: StrPtr("query matched")}, {ID: "preconfigured", Group: StrPtr("query matched")}}},
+				{ID: "siem-1", Name: "Detection", RuleTypeID: "siem.queryRule", Consumer: "siem", Enabled: true},
+			}}), nil
+		case "POST /s/marketing-copy/api/alerting/rule":
+			var body AlertingCreateRequestBody
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, "onActionGroupChange", body.Actions[0].Frequency.NotifyWhen)
+			// The actions reference the cloned connectors
+			assert.Equal(t, "slack-copy", body.Actions[0].ID)
+			assert.Equal(t, "preconfigured", body.Actions[1].ID)
+			rules = append(rules, body.Name)
+			return jsonResponse(t, 200, map[string]interface{}{"id": "rule-1-copy"}), nil
+		case "POST /s/marketing-copy/api/alerting/rule/rule-1-copy/_disable":
+			disabled = append(disabled, "rule-1-copy")
+			return &http.Response{StatusCode: 204, Body: http.NoBody, Header: http.Header{}}, nil
+		case "GET /s/marketing/api/detection_engine/rules/_find":
+			return jsonResponse(t, 200, map[string]interface{}{
+				"page": 1, "per_page": 100, "total": 2,
+				"data": []map[string]interface{}{{"rule_id": "det-1", "name": "Malware"}, {"rule_id": "det-2", "name": "Phishing"}},
+			}), nil
+		case "POST /s/marketing/api/detection_engine/rules/_export":
+			export := `{"rule_id":"det-1","actions":[{"id":"slack","group":"default"}]}` + "\n" + `{"rule_id":"det-2"}` + "\n" +
+				`{"id":"slack","type":"action","attributes":{}}` + "\n" + `{"exported_rules_count":2}` + "\n"
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(export)), Header: http.Header{}}, nil
+		case "POST /s/marketing-copy/api/detection_engine/rules/_import":
+			require.NoError(t, req.ParseMultipartForm(1<<20))
+			file, _, err := req.FormFile("file")
+			require.NoError(t, err)
+			b, _ := io.ReadAll(file)
+			importedFile = string(b)
+			return jsonResponse(t, 200, map[string]interface{}{
+				"success": false, "success_count": 1, "rules_count": 2,
+				"errors": []map[string]interface{}{{"rule_id": "det-2", "error": map[string]interface{}{"status_code": 409, "message": "rule_id already exists"}}},
+			}), nil
+		}
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		return nil, nil
+	}))
+
+	report, err := api.CloneSpace(context.Background(), &SpaceCloneRequest{SourceID: "marketing", ID: "marketing-copy"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "marketing-copy", created.ID)
+	assert.Equal(t, "Marketing", created.Name)
+	assert.Equal(t, "Campaigns", *created.Description)
+	assert.Equal(t, []string{"ml"}, *created.DisabledFeatures)
+
+	require.Len(t, resolved.Retries["marketing-copy"], 1)
+	assert.True(t, *resolved.Retries["marketing-copy"][0].CreateNewCopy)
+	assert.Equal(t, []string{"Slack", "Email"}, connectors)
+	assert.Equal(t, []string{"CPU"}, rules)
+	assert.Equal(t, []string{"rule-1-copy"}, disabled)
+	assert.Equal(t, `{"actions":[{"group":"default","id":"slack-copy"}],"rule_id":"det-1"}`+"\n"+`{"rule_id":"det-2"}`+"\n", importedFile)
+
+	var cloned []string
+	for _, item := range report.Cloned {
+		cloned = append(cloned, item.Kind+":"+item.ID)
+	}
+	assert.Equal(t, []string{"saved_object:vis-1", "saved_object:dash-1", "connector:slack", "rule:rule-1", "detection_rule:det-1"}, cloned)
+	assert.NotEmpty(t, report.Cloned[1].DestinationID)
+	assert.Equal(t, "slack-copy", report.Cloned[2].DestinationID)
+	assert.Equal(t, "rule-1-copy", report.Cloned[3].DestinationID)
+
+	require.Len(t, report.Failed, 3)
+	assert.Equal(t, SpaceCloneItem{Kind: SpaceCloneSavedObject, Type: "index-pattern", ID: "ip-1", Name: "logs-*", Reason: "boom"}, report.Failed[0])
+	assert.Equal(t, "email", report.Failed[1].ID)
+	assert.Contains(t, report.Failed[1].Reason, "secrets are required")
+	assert.Equal(t, SpaceCloneItem{Kind: SpaceCloneDetectionRule, ID: "det-2", Name: "Phishing", Reason: "rule_id already exists"}, report.Failed[2])
+}
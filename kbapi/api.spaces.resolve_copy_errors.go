@@ -0,0 +1,47 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SpacesResolveCopyErrorsResponse wraps the response from a Spaces.ResolveCopyErrors call
+type SpacesResolveCopyErrorsResponse Response[SpacesCopyObjectsResponseBody]
+
+type SpacesResolveCopyErrorsRequest struct {
+	Body SpacesResolveCopyErrorsRequestBody
+}
+
+type SpacesResolveCopyErrorsRequestBody struct {
+	// CompatibilityMode Apply various adjustments to the saved objects that are being copied to maintain compatibility between different Kibana versions. This option cannot be used with the `createNewCopies` option.
+	CompatibilityMode *bool `json:"compatibilityMode,omitempty"`
+
+	// CreateNewCopies Create new copies of saved objects, regenerate each object identifier, and reset the origin. Use the same value as for the copy.
+	CreateNewCopies *bool `json:"createNewCopies,omitempty"`
+
+	// IncludeReferences When set to true, all saved objects related to the specified saved objects are also copied into the target spaces.
+	IncludeReferences *bool `json:"includeReferences,omitempty"`
+
+	// Objects The objects that were copied.
+	Objects []Object `json:"objects"`
+
+	// Retries The retry operations keyed by destination space ID. Only the objects with a retry are copied.
+	Retries map[string][]SavedObjectRetryOperationItem `json:"retries"`
+}
+
+// newSpacesResolveCopyErrors returns a function that performs POST /api/spaces/_resolve_copy_saved_objects_errors API requests
+func (api *API) newSpacesResolveCopyErrors() func(context.Context, *SpacesResolveCopyErrorsRequest, ...RequestOption) (*SpacesResolveCopyErrorsResponse, error) {
+	return func(ctx context.Context, req *SpacesResolveCopyErrorsRequest, opts ...RequestOption) (*SpacesResolveCopyErrorsResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SpacesResolveCopyErrorsRequestBody, SpacesCopyObjectsResponseBody](ctx, api, operation{
+			name:   "spaces.resolve_copy_errors",
+			method: http.MethodPost,
+			path:   "/api/spaces/_resolve_copy_saved_objects_errors",
+		}, &req.Body, opts)
+		return (*SpacesResolveCopyErrorsResponse)(res), err
+	}
+}
@@ -2,28 +2,16 @@ package kbapi
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // StatusResponse wraps the response from a StatusGet call
-type StatusResponse struct {
-	StatusCode int
-	Body       *KibanaStatusResponse
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type StatusResponse Response[KibanaStatusResponse]
 
 // StatusRedactedResponse wraps the response from a StatusGetRedacted call
-type StatusRedactedResponse struct {
-	StatusCode int
-	Body       *KibanaStatusRedactedResponse
-	Error      interface{}
-	RawBody    io.ReadCloser
-}
+type StatusRedactedResponse Response[KibanaStatusRedactedResponse]
 
 // newStatusFunc returns a function that performs status API requests
 func (api *API) newStatusFunc() func(context.Context, *GetStatusRequest, ...RequestOption) (*StatusResponse, error) {
@@ -32,115 +20,23 @@ func (api *API) newStatusFunc() func(context.Context, *GetStatusRequest, ...Requ
 			req = &GetStatusRequest{}
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "status")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/status"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 
 		if req.V7format != nil {
-			params["v7format"] = strconv.FormatBool(*req.V7format)
+			params.Set("v7format", strconv.FormatBool(*req.V7format))
 		}
 		if req.V8format != nil {
-			params["v8format"] = strconv.FormatBool(*req.V8format)
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "status")
-			if reader := instrument.RecordRequestBody(ctx, "status", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
-		}
-
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &StatusResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		// Parse body based on request type
-		var result KibanaStatusResponse
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
+			params.Set("v8format", strconv.FormatBool(*req.V8format))
 		}
 
+		res, err := do[noBody, KibanaStatusResponse](ctx, api, operation{
+			name:   "status",
+			method: http.MethodGet,
+			path:   "/api/status",
+			query:  params,
+		}, nil, opts)
+		return (*StatusResponse)(res), err
 	}
 }
 
@@ -151,112 +47,22 @@ func (api *API) newStatusRedactedFunc() func(context.Context, *GetStatusRequest,
 			req = &GetStatusRequest{}
 		}
 
-		// Get instrumentation if available
-		var instrument Instrumentation
-		if i, ok := api.transport.(Instrumented); ok {
-			instrument = i.InstrumentationEnabled()
-		}
-
-		// Start instrumentation span if available
-		if instrument != nil {
-			var newCtx context.Context
-			newCtx = instrument.Start(ctx, "status")
-			defer instrument.Close(newCtx)
-			ctx = newCtx
-		}
-
-		path := "/api/status"
-
 		// Build query parameters
-		params := make(map[string]string)
+		params := url.Values{}
 		if req.V7format != nil {
-			params["v7format"] = strconv.FormatBool(*req.V7format)
+			params.Set("v7format", strconv.FormatBool(*req.V7format))
 		}
 		if req.V8format != nil {
-			params["v8format"] = strconv.FormatBool(*req.V8format)
-		}
-
-		// Create HTTP request
-		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// Add query parameters
-		if len(params) > 0 {
-			q := httpReq.URL.Query()
-			for k, v := range params {
-				q.Set(k, v)
-			}
-			httpReq.URL.RawQuery = q.Encode()
-		}
-
-		// Apply all the functional options
-		for _, opt := range opts {
-			if err := opt(httpReq); err != nil {
-				return nil, err
-			}
-		}
-
-		// Pre-request instrumentation
-		if instrument != nil {
-			instrument.BeforeRequest(httpReq, "status")
-			if reader := instrument.RecordRequestBody(ctx, "status", httpReq.Body); reader != nil {
-				httpReq.Body = reader
-			}
-		}
-
-		// Execute request
-		httpResp, err := api.transport.Perform(httpReq)
-
-		if instrument != nil {
-			instrument.AfterRequest(httpReq, "kibana", path)
+			params.Set("v8format", strconv.FormatBool(*req.V8format))
 		}
 
-		if err != nil {
-			if instrument != nil {
-				instrument.RecordError(ctx, err)
-			}
-			return nil, err
-		}
-
-		// Prepare response
-		resp := &StatusRedactedResponse{
-			StatusCode: httpResp.StatusCode,
-			RawBody:    httpResp.Body,
-		}
-
-		var result KibanaStatusRedactedResponse
-
-		if httpResp.StatusCode == 200 {
-			if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
-				httpResp.Body.Close()
-				return nil, err
-			}
-			resp.Body = &result
-			return resp, nil
-		} else {
-			// For all non-200 responses
-			bodyBytes, err := io.ReadAll(httpResp.Body)
-			httpResp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %v", err)
-			}
-
-			// Try to decode as JSON
-			var errorObj interface{}
-			if err := json.Unmarshal(bodyBytes, &errorObj); err == nil {
-				resp.Error = errorObj
-
-				errorMessage, _ := json.Marshal(errorObj)
-
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, errorMessage)
-			} else {
-				// Not valid JSON
-				resp.Error = string(bodyBytes)
-				return resp, fmt.Errorf("HTTP Status Code %d: %s", httpResp.StatusCode, string(bodyBytes))
-			}
-		}
+		res, err := do[noBody, KibanaStatusRedactedResponse](ctx, api, operation{
+			name:   "status",
+			method: http.MethodGet,
+			path:   "/api/status",
+			query:  params,
+		}, nil, opts)
+		return (*StatusRedactedResponse)(res), err
 	}
 }
 
@@ -129,7 +129,7 @@ func TestAPI_StatusGet(t *testing.T) {
 					// For string error responses, verify error string was set
 					if test.name == "Error with 404 status code as string" {
 						require.NotNil(t, resp)
-						assert.Equal(t, "Not Found", resp.Error)
+						assert.Equal(t, "Not Found", resp.Error.Message)
 					}
 				}
 				return
@@ -276,7 +276,7 @@ func TestAPI_StatusGetRedacted(t *testing.T) {
 					// For string error responses, verify error string was set
 					if test.name == "Error with 404 status code as string" {
 						require.NotNil(t, resp)
-						assert.Equal(t, "Not Found", resp.Error)
+						assert.Equal(t, "Not Found", resp.Error.Message)
 					}
 				}
 				return
@@ -0,0 +1,46 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StreamsBulkDashboardsResponse wraps the response from a Streams.BulkDashboards call
+type StreamsBulkDashboardsResponse Response[StreamsAcknowledgedResponseBody]
+
+type StreamsBulkDashboardsRequestBody struct {
+	Operations []StreamsBulkDashboardsOperation `json:"operations"`
+}
+
+// StreamsBulkDashboardsOperation links (Index) or unlinks (Delete) a dashboard. Exactly one field is set.
+type StreamsBulkDashboardsOperation struct {
+	Index *struct {
+		ID string `json:"id"`
+	} `json:"index,omitempty"`
+	Delete *struct {
+		ID string `json:"id"`
+	} `json:"delete,omitempty"`
+}
+
+type StreamsBulkDashboardsRequest struct {
+	Name string
+	Body StreamsBulkDashboardsRequestBody
+}
+
+// newStreamsBulkDashboards returns a function that performs POST /api/streams/{name}/dashboards/_bulk API requests
+func (api *API) newStreamsBulkDashboards() func(context.Context, *StreamsBulkDashboardsRequest, ...RequestOption) (*StreamsBulkDashboardsResponse, error) {
+	return func(ctx context.Context, req *StreamsBulkDashboardsRequest, opts ...RequestOption) (*StreamsBulkDashboardsResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[StreamsBulkDashboardsRequestBody, StreamsAcknowledgedResponseBody](ctx, api, operation{
+			name:    "streams.bulk_dashboards",
+			feature: FeatureStreams,
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/api/streams/%s/dashboards/_bulk", req.Name),
+		}, &req.Body, opts)
+		return (*StreamsBulkDashboardsResponse)(res), err
+	}
+}
@@ -0,0 +1,44 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StreamsBulkQueriesResponse wraps the response from a Streams.BulkQueries call
+type StreamsBulkQueriesResponse Response[StreamsAcknowledgedResponseBody]
+
+type StreamsBulkQueriesRequestBody struct {
+	Operations []StreamsBulkQueriesOperation `json:"operations"`
+}
+
+// StreamsBulkQueriesOperation creates or updates (Index) or deletes (Delete) a query. Exactly one field is set.
+type StreamsBulkQueriesOperation struct {
+	Index  *StreamsQuery `json:"index,omitempty"`
+	Delete *struct {
+		ID string `json:"id"`
+	} `json:"delete,omitempty"`
+}
+
+type StreamsBulkQueriesRequest struct {
+	Name string
+	Body StreamsBulkQueriesRequestBody
+}
+
+// newStreamsBulkQueries returns a function that performs POST /api/streams/{name}/queries/_bulk API requests
+func (api *API) newStreamsBulkQueries() func(context.Context, *StreamsBulkQueriesRequest, ...RequestOption) (*StreamsBulkQueriesResponse, error) {
+	return func(ctx context.Context, req *StreamsBulkQueriesRequest, opts ...RequestOption) (*StreamsBulkQueriesResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[StreamsBulkQueriesRequestBody, StreamsAcknowledgedResponseBody](ctx, api, operation{
+			name:    "streams.bulk_queries",
+			feature: FeatureStreams,
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/api/streams/%s/queries/_bulk", req.Name),
+		}, &req.Body, opts)
+		return (*StreamsBulkQueriesResponse)(res), err
+	}
+}
@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StreamsDeleteResponse wraps the response from a Streams.Delete call
+type StreamsDeleteResponse Response[StreamsAcknowledgedResponseBody]
+
+type StreamsDeleteRequest struct {
+	Name string
+}
+
+// newStreamsDelete returns a function that performs DELETE /api/streams/{name} API requests
+func (api *API) newStreamsDelete() func(context.Context, *StreamsDeleteRequest, ...RequestOption) (*StreamsDeleteResponse, error) {
+	return func(ctx context.Context, req *StreamsDeleteRequest, opts ...RequestOption) (*StreamsDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, StreamsAcknowledgedResponseBody](ctx, api, operation{
+			name:    "streams.delete",
+			feature: FeatureStreams,
+			method:  http.MethodDelete,
+			path:    fmt.Sprintf("/api/streams/%s", req.Name),
+		}, nil, opts)
+		return (*StreamsDeleteResponse)(res), err
+	}
+}
@@ -0,0 +1,32 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StreamsDeleteQueryResponse wraps the response from a Streams.DeleteQuery call
+type StreamsDeleteQueryResponse Response[StreamsAcknowledgedResponseBody]
+
+type StreamsDeleteQueryRequest struct {
+	Name    string
+	QueryID string
+}
+
+// newStreamsDeleteQuery returns a function that performs DELETE /api/streams/{name}/queries/{queryId} API requests
+func (api *API) newStreamsDeleteQuery() func(context.Context, *StreamsDeleteQueryRequest, ...RequestOption) (*StreamsDeleteQueryResponse, error) {
+	return func(ctx context.Context, req *StreamsDeleteQueryRequest, opts ...RequestOption) (*StreamsDeleteQueryResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, StreamsAcknowledgedResponseBody](ctx, api, operation{
+			name:    "streams.delete_query",
+			feature: FeatureStreams,
+			method:  http.MethodDelete,
+			path:    fmt.Sprintf("/api/streams/%s/queries/%s", req.Name, req.QueryID),
+		}, nil, opts)
+		return (*StreamsDeleteQueryResponse)(res), err
+	}
+}
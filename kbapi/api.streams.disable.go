@@ -0,0 +1,22 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// StreamsDisableResponse wraps the response from a Streams.Disable call
+type StreamsDisableResponse Response[StreamsAcknowledgedResponseBody]
+
+// newStreamsDisable returns a function that performs POST /api/streams/_disable API requests
+func (api *API) newStreamsDisable() func(context.Context, ...RequestOption) (*StreamsDisableResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*StreamsDisableResponse, error) {
+		res, err := do[noBody, StreamsAcknowledgedResponseBody](ctx, api, operation{
+			name:    "streams.disable",
+			feature: FeatureStreams,
+			method:  http.MethodPost,
+			path:    "/api/streams/_disable",
+		}, nil, opts)
+		return (*StreamsDisableResponse)(res), err
+	}
+}
@@ -0,0 +1,22 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// StreamsEnableResponse wraps the response from a Streams.Enable call
+type StreamsEnableResponse Response[StreamsAcknowledgedResponseBody]
+
+// newStreamsEnable returns a function that performs POST /api/streams/_enable API requests
+func (api *API) newStreamsEnable() func(context.Context, ...RequestOption) (*StreamsEnableResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*StreamsEnableResponse, error) {
+		res, err := do[noBody, StreamsAcknowledgedResponseBody](ctx, api, operation{
+			name:    "streams.enable",
+			feature: FeatureStreams,
+			method:  http.MethodPost,
+			path:    "/api/streams/_enable",
+		}, nil, opts)
+		return (*StreamsEnableResponse)(res), err
+	}
+}
@@ -0,0 +1,41 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StreamsForkResponse wraps the response from a Streams.Fork call
+type StreamsForkResponse Response[StreamsAcknowledgedResponseBody]
+
+type StreamsForkRequestBody struct {
+	Stream struct {
+		// Name The name of the child stream, prefixed with the name of the forked stream.
+		Name string `json:"name"`
+	} `json:"stream"`
+	// If Documents matching the condition are routed to the child stream.
+	If StreamsCondition `json:"if"`
+}
+
+type StreamsForkRequest struct {
+	Name string
+	Body StreamsForkRequestBody
+}
+
+// newStreamsFork returns a function that performs POST /api/streams/{name}/_fork API requests
+func (api *API) newStreamsFork() func(context.Context, *StreamsForkRequest, ...RequestOption) (*StreamsForkResponse, error) {
+	return func(ctx context.Context, req *StreamsForkRequest, opts ...RequestOption) (*StreamsForkResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[StreamsForkRequestBody, StreamsAcknowledgedResponseBody](ctx, api, operation{
+			name:    "streams.fork",
+			feature: FeatureStreams,
+			method:  http.MethodPost,
+			path:    fmt.Sprintf("/api/streams/%s/_fork", req.Name),
+		}, &req.Body, opts)
+		return (*StreamsForkResponse)(res), err
+	}
+}
@@ -0,0 +1,57 @@
+package kbapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// StreamsGetResponse wraps the response from a Streams.Get call
+type StreamsGetResponse Response[StreamsGetResponseBody]
+
+type StreamsGetResponseBody struct {
+	Stream StreamsDefinition `json:"stream"`
+	// Dashboards The IDs of the dashboards linked to the stream.
+	Dashboards []string       `json:"dashboards"`
+	Queries    []StreamsQuery `json:"queries"`
+	// InheritedFields The fields a wired stream inherits from its ancestors.
+	InheritedFields map[string]StreamsInheritedField `json:"inherited_fields,omitempty"`
+	// EffectiveLifecycle The lifecycle applied to a wired stream, taking inheritance into account.
+	EffectiveLifecycle *StreamsEffectiveLifecycle `json:"effective_lifecycle,omitempty"`
+	// ElasticsearchAssets The Elasticsearch resources backing a classic stream.
+	ElasticsearchAssets json.RawMessage `json:"elasticsearch_assets,omitempty"`
+}
+
+// StreamsInheritedField is a field mapping inherited from the ancestor stream From.
+type StreamsInheritedField struct {
+	StreamsFieldDefinition
+	From string `json:"from"`
+}
+
+// StreamsEffectiveLifecycle is a lifecycle inherited from the ancestor stream From.
+type StreamsEffectiveLifecycle struct {
+	StreamsLifecycle
+	From *string `json:"from,omitempty"`
+}
+
+type StreamsGetRequest struct {
+	Name string
+}
+
+// newStreamsGet returns a function that performs GET /api/streams/{name} API requests
+func (api *API) newStreamsGet() func(context.Context, *StreamsGetRequest, ...RequestOption) (*StreamsGetResponse, error) {
+	return func(ctx context.Context, req *StreamsGetRequest, opts ...RequestOption) (*StreamsGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, StreamsGetResponseBody](ctx, api, operation{
+			name:    "streams.get",
+			feature: FeatureStreams,
+			method:  http.MethodGet,
+			path:    fmt.Sprintf("/api/streams/%s", req.Name),
+		}, nil, opts)
+		return (*StreamsGetResponse)(res), err
+	}
+}
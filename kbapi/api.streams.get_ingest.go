@@ -0,0 +1,36 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StreamsGetIngestResponse wraps the response from a Streams.GetIngest call
+type StreamsGetIngestResponse Response[StreamsIngestBody]
+
+// StreamsIngestBody holds the ingest settings of a stream.
+type StreamsIngestBody struct {
+	Ingest StreamsIngest `json:"ingest"`
+}
+
+type StreamsGetIngestRequest struct {
+	Name string
+}
+
+// newStreamsGetIngest returns a function that performs GET /api/streams/{name}/_ingest API requests
+func (api *API) newStreamsGetIngest() func(context.Context, *StreamsGetIngestRequest, ...RequestOption) (*StreamsGetIngestResponse, error) {
+	return func(ctx context.Context, req *StreamsGetIngestRequest, opts ...RequestOption) (*StreamsGetIngestResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, StreamsIngestBody](ctx, api, operation{
+			name:    "streams.get_ingest",
+			feature: FeatureStreams,
+			method:  http.MethodGet,
+			path:    fmt.Sprintf("/api/streams/%s/_ingest", req.Name),
+		}, nil, opts)
+		return (*StreamsGetIngestResponse)(res), err
+	}
+}
@@ -0,0 +1,32 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StreamsLinkDashboardResponse wraps the response from a Streams.LinkDashboard call
+type StreamsLinkDashboardResponse Response[StreamsAcknowledgedResponseBody]
+
+type StreamsLinkDashboardRequest struct {
+	Name        string
+	DashboardID string
+}
+
+// newStreamsLinkDashboard returns a function that performs PUT /api/streams/{name}/dashboards/{dashboardId} API requests
+func (api *API) newStreamsLinkDashboard() func(context.Context, *StreamsLinkDashboardRequest, ...RequestOption) (*StreamsLinkDashboardResponse, error) {
+	return func(ctx context.Context, req *StreamsLinkDashboardRequest, opts ...RequestOption) (*StreamsLinkDashboardResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, StreamsAcknowledgedResponseBody](ctx, api, operation{
+			name:    "streams.link_dashboard",
+			feature: FeatureStreams,
+			method:  http.MethodPut,
+			path:    fmt.Sprintf("/api/streams/%s/dashboards/%s", req.Name, req.DashboardID),
+		}, nil, opts)
+		return (*StreamsLinkDashboardResponse)(res), err
+	}
+}
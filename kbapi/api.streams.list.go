@@ -0,0 +1,26 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// StreamsListResponse wraps the response from a Streams.List call
+type StreamsListResponse Response[StreamsListResponseBody]
+
+type StreamsListResponseBody struct {
+	Streams []StreamsDefinition `json:"streams"`
+}
+
+// newStreamsList returns a function that performs GET /api/streams API requests
+func (api *API) newStreamsList() func(context.Context, ...RequestOption) (*StreamsListResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*StreamsListResponse, error) {
+		res, err := do[noBody, StreamsListResponseBody](ctx, api, operation{
+			name:    "streams.list",
+			feature: FeatureStreams,
+			method:  http.MethodGet,
+			path:    "/api/streams",
+		}, nil, opts)
+		return (*StreamsListResponse)(res), err
+	}
+}
@@ -0,0 +1,35 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StreamsListDashboardsResponse wraps the response from a Streams.ListDashboards call
+type StreamsListDashboardsResponse Response[StreamsListDashboardsResponseBody]
+
+type StreamsListDashboardsResponseBody struct {
+	Dashboards []StreamsDashboard `json:"dashboards"`
+}
+
+type StreamsListDashboardsRequest struct {
+	Name string
+}
+
+// newStreamsListDashboards returns a function that performs GET /api/streams/{name}/dashboards API requests
+func (api *API) newStreamsListDashboards() func(context.Context, *StreamsListDashboardsRequest, ...RequestOption) (*StreamsListDashboardsResponse, error) {
+	return func(ctx context.Context, req *StreamsListDashboardsRequest, opts ...RequestOption) (*StreamsListDashboardsResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, StreamsListDashboardsResponseBody](ctx, api, operation{
+			name:    "streams.list_dashboards",
+			feature: FeatureStreams,
+			method:  http.MethodGet,
+			path:    fmt.Sprintf("/api/streams/%s/dashboards", req.Name),
+		}, nil, opts)
+		return (*StreamsListDashboardsResponse)(res), err
+	}
+}
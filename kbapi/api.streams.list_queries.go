@@ -0,0 +1,35 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StreamsListQueriesResponse wraps the response from a Streams.ListQueries call
+type StreamsListQueriesResponse Response[StreamsListQueriesResponseBody]
+
+type StreamsListQueriesResponseBody struct {
+	Queries []StreamsQuery `json:"queries"`
+}
+
+type StreamsListQueriesRequest struct {
+	Name string
+}
+
+// newStreamsListQueries returns a function that performs GET /api/streams/{name}/queries API requests
+func (api *API) newStreamsListQueries() func(context.Context, *StreamsListQueriesRequest, ...RequestOption) (*StreamsListQueriesResponse, error) {
+	return func(ctx context.Context, req *StreamsListQueriesRequest, opts ...RequestOption) (*StreamsListQueriesResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, StreamsListQueriesResponseBody](ctx, api, operation{
+			name:    "streams.list_queries",
+			feature: FeatureStreams,
+			method:  http.MethodGet,
+			path:    fmt.Sprintf("/api/streams/%s/queries", req.Name),
+		}, nil, opts)
+		return (*StreamsListQueriesResponse)(res), err
+	}
+}
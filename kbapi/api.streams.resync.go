@@ -0,0 +1,22 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// StreamsResyncResponse wraps the response from a Streams.Resync call
+type StreamsResyncResponse Response[StreamsAcknowledgedResponseBody]
+
+// newStreamsResync returns a function that performs POST /api/streams/_resync API requests
+func (api *API) newStreamsResync() func(context.Context, ...RequestOption) (*StreamsResyncResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*StreamsResyncResponse, error) {
+		res, err := do[noBody, StreamsAcknowledgedResponseBody](ctx, api, operation{
+			name:    "streams.resync",
+			feature: FeatureStreams,
+			method:  http.MethodPost,
+			path:    "/api/streams/_resync",
+		}, nil, opts)
+		return (*StreamsResyncResponse)(res), err
+	}
+}
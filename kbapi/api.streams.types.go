@@ -0,0 +1,209 @@
+package kbapi
+
+import (
+	"encoding/json"
+)
+
+// Operators of a StreamsCondition filter.
+const (
+	StreamsOperatorEq         = "eq"
+	StreamsOperatorNeq        = "neq"
+	StreamsOperatorLt         = "lt"
+	StreamsOperatorLte        = "lte"
+	StreamsOperatorGt         = "gt"
+	StreamsOperatorGte        = "gte"
+	StreamsOperatorContains   = "contains"
+	StreamsOperatorStartsWith = "startsWith"
+	StreamsOperatorEndsWith   = "endsWith"
+	StreamsOperatorExists     = "exists"
+	StreamsOperatorNotExists  = "notExists"
+)
+
+// StreamsDefinition is the definition of a wired or classic stream.
+type StreamsDefinition struct {
+	// Name The name of the stream, child streams of a wired stream are named after their parent, e.g. logs.nginx.
+	Name        string        `json:"name"`
+	Description *string       `json:"description,omitempty"`
+	Ingest      StreamsIngest `json:"ingest"`
+}
+
+// StreamsIngest describes how documents are ingested into a stream. Exactly
+// one of Wired and Classic is set.
+type StreamsIngest struct {
+	Lifecycle  StreamsLifecycle   `json:"lifecycle"`
+	Processing []StreamsProcessor `json:"processing"`
+	// Wired The field mappings and routing of a wired stream.
+	Wired *StreamsWiredIngest `json:"wired,omitempty"`
+	// Classic The field overrides of a classic stream, backed by an existing data stream.
+	Classic *StreamsClassicIngest `json:"classic,omitempty"`
+	// Unwired is how Kibana 9.0 names Classic.
+	Unwired *StreamsClassicIngest `json:"unwired,omitempty"`
+}
+
+// StreamsWiredIngest holds the field mappings and routing rules of a wired stream.
+type StreamsWiredIngest struct {
+	Fields  map[string]StreamsFieldDefinition `json:"fields"`
+	Routing []StreamsRoutingDefinition        `json:"routing"`
+}
+
+// StreamsClassicIngest holds the field overrides of a classic stream.
+type StreamsClassicIngest struct {
+	FieldOverrides map[string]StreamsFieldDefinition `json:"field_overrides,omitempty"`
+}
+
+// StreamsFieldDefinition is the mapping of a stream field.
+type StreamsFieldDefinition struct {
+	// Type The mapping type of the field, e.g. keyword, match_only_text, long, double, date, boolean or ip.
+	Type   string  `json:"type"`
+	Format *string `json:"format,omitempty"`
+}
+
+// StreamsRoutingDefinition routes the documents matching If to the child stream Destination.
+type StreamsRoutingDefinition struct {
+	Destination string           `json:"destination"`
+	If          StreamsCondition `json:"if"`
+	// Status Whether the routing rule is enabled or disabled. Only supported from Kibana 9.1.
+	Status *string `json:"status,omitempty"`
+}
+
+// StreamsLifecycle is the retention of a stream. Exactly one field is set.
+type StreamsLifecycle struct {
+	// Inherit Inherits the lifecycle of the parent stream.
+	Inherit *struct{} `json:"inherit,omitempty"`
+	// DSL Manages the stream with a data stream lifecycle.
+	DSL *struct {
+		DataRetention *string `json:"data_retention,omitempty"`
+	} `json:"dsl,omitempty"`
+	// ILM Manages the stream with an index lifecycle policy.
+	ILM *struct {
+		Policy string `json:"policy"`
+	} `json:"ilm,omitempty"`
+}
+
+// StreamsCondition is a node of a condition tree. A node is either a filter
+// on Field, a combination of conditions with And or Or, a negation with Not,
+// or one of Always and Never. The zero value is encoded as an always
+// condition.
+type StreamsCondition struct {
+	Field    string `json:"field,omitempty"`
+	Operator string `json:"operator,omitempty"`
+	// Value The value compared to the field, unset for the exists and notExists operators.
+	Value  any                `json:"value,omitempty"`
+	And    []StreamsCondition `json:"and,omitempty"`
+	Or     []StreamsCondition `json:"or,omitempty"`
+	Not    *StreamsCondition  `json:"not,omitempty"`
+	Always *struct{}          `json:"always,omitempty"`
+	Never  *struct{}          `json:"never,omitempty"`
+}
+
+// StreamsFilter returns a condition comparing field to value with operator.
+func StreamsFilter(field, operator string, value any) StreamsCondition {
+	return StreamsCondition{Field: field, Operator: operator, Value: value}
+}
+
+// StreamsAnd returns a condition matching when all conditions match.
+func StreamsAnd(conditions ...StreamsCondition) StreamsCondition {
+	return StreamsCondition{And: conditions}
+}
+
+// StreamsOr returns a condition matching when any of conditions match.
+func StreamsOr(conditions ...StreamsCondition) StreamsCondition {
+	return StreamsCondition{Or: conditions}
+}
+
+// StreamsNot returns a condition matching when condition does not match.
+func StreamsNot(condition StreamsCondition) StreamsCondition {
+	return StreamsCondition{Not: &condition}
+}
+
+// StreamsAlways returns a condition that always matches.
+func StreamsAlways() StreamsCondition {
+	return StreamsCondition{Always: &struct{}{}}
+}
+
+// StreamsNever returns a condition that never matches.
+func StreamsNever() StreamsCondition {
+	return StreamsCondition{Never: &struct{}{}}
+}
+
+func (c StreamsCondition) MarshalJSON() ([]byte, error) {
+	type condition StreamsCondition
+	if c.Field == "" && c.And == nil && c.Or == nil && c.Not == nil && c.Never == nil {
+		c.Always = &struct{}{}
+	}
+	return json.Marshal(condition(c))
+}
+
+// StreamsProcessor is a processing step of a stream. Exactly one field is set.
+type StreamsProcessor struct {
+	Grok                 *StreamsGrokProcessor                 `json:"grok,omitempty"`
+	Dissect              *StreamsDissectProcessor              `json:"dissect,omitempty"`
+	Date                 *StreamsDateProcessor                 `json:"date,omitempty"`
+	ManualIngestPipeline *StreamsManualIngestPipelineProcessor `json:"manual_ingest_pipeline,omitempty"`
+}
+
+// StreamsProcessorBase holds the settings shared by all processors.
+type StreamsProcessorBase struct {
+	Description   *string `json:"description,omitempty"`
+	IgnoreFailure *bool   `json:"ignore_failure,omitempty"`
+	// If Only documents matching the condition are processed.
+	If StreamsCondition `json:"if"`
+}
+
+// StreamsGrokProcessor extracts fields from Field with grok patterns.
+type StreamsGrokProcessor struct {
+	StreamsProcessorBase
+	Field              string            `json:"field"`
+	Patterns           []string          `json:"patterns"`
+	PatternDefinitions map[string]string `json:"pattern_definitions,omitempty"`
+	IgnoreMissing      *bool             `json:"ignore_missing,omitempty"`
+}
+
+// StreamsDissectProcessor extracts fields from Field with a dissect pattern.
+type StreamsDissectProcessor struct {
+	StreamsProcessorBase
+	Field           string  `json:"field"`
+	Pattern         string  `json:"pattern"`
+	AppendSeparator *string `json:"append_separator,omitempty"`
+	IgnoreMissing   *bool   `json:"ignore_missing,omitempty"`
+}
+
+// StreamsDateProcessor parses a date from Field.
+type StreamsDateProcessor struct {
+	StreamsProcessorBase
+	Field        string   `json:"field"`
+	Formats      []string `json:"formats"`
+	TargetField  *string  `json:"target_field,omitempty"`
+	OutputFormat *string  `json:"output_format,omitempty"`
+	Timezone     *string  `json:"timezone,omitempty"`
+	Locale       *string  `json:"locale,omitempty"`
+}
+
+// StreamsManualIngestPipelineProcessor runs raw ingest pipeline processors.
+type StreamsManualIngestPipelineProcessor struct {
+	StreamsProcessorBase
+	Processors []map[string]interface{} `json:"processors"`
+	Tag        *string                  `json:"tag,omitempty"`
+	OnFailure  []map[string]interface{} `json:"on_failure,omitempty"`
+}
+
+// StreamsQuery is a KQL query linked to a stream.
+type StreamsQuery struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	KQL   struct {
+		Query string `json:"query"`
+	} `json:"kql"`
+}
+
+// StreamsDashboard is a dashboard linked to a stream.
+type StreamsDashboard struct {
+	ID    string   `json:"id"`
+	Title string   `json:"title"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+// StreamsAcknowledgedResponseBody is returned by the streams endpoints that only acknowledge a change.
+type StreamsAcknowledgedResponseBody struct {
+	Acknowledged bool `json:"acknowledged"`
+}
@@ -0,0 +1,32 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StreamsUnlinkDashboardResponse wraps the response from a Streams.UnlinkDashboard call
+type StreamsUnlinkDashboardResponse Response[StreamsAcknowledgedResponseBody]
+
+type StreamsUnlinkDashboardRequest struct {
+	Name        string
+	DashboardID string
+}
+
+// newStreamsUnlinkDashboard returns a function that performs DELETE /api/streams/{name}/dashboards/{dashboardId} API requests
+func (api *API) newStreamsUnlinkDashboard() func(context.Context, *StreamsUnlinkDashboardRequest, ...RequestOption) (*StreamsUnlinkDashboardResponse, error) {
+	return func(ctx context.Context, req *StreamsUnlinkDashboardRequest, opts ...RequestOption) (*StreamsUnlinkDashboardResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, StreamsAcknowledgedResponseBody](ctx, api, operation{
+			name:    "streams.unlink_dashboard",
+			feature: FeatureStreams,
+			method:  http.MethodDelete,
+			path:    fmt.Sprintf("/api/streams/%s/dashboards/%s", req.Name, req.DashboardID),
+		}, nil, opts)
+		return (*StreamsUnlinkDashboardResponse)(res), err
+	}
+}
@@ -0,0 +1,57 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StreamsUpsertResponse wraps the response from a Streams.Upsert call
+type StreamsUpsertResponse Response[StreamsUpsertResponseBody]
+
+type StreamsUpsertResponseBody struct {
+	Acknowledged bool `json:"acknowledged"`
+	// Result Whether the stream was created or updated.
+	Result string `json:"result"`
+}
+
+type StreamsUpsertRequestBody struct {
+	// Dashboards The IDs of the dashboards to link to the stream.
+	Dashboards []string       `json:"dashboards"`
+	Queries    []StreamsQuery `json:"queries"`
+	Stream     struct {
+		Description *string       `json:"description,omitempty"`
+		Ingest      StreamsIngest `json:"ingest"`
+	} `json:"stream"`
+}
+
+type StreamsUpsertRequest struct {
+	Name string
+	Body StreamsUpsertRequestBody
+}
+
+// newStreamsUpsert returns a function that performs PUT /api/streams/{name} API requests
+func (api *API) newStreamsUpsert() func(context.Context, *StreamsUpsertRequest, ...RequestOption) (*StreamsUpsertResponse, error) {
+	return func(ctx context.Context, req *StreamsUpsertRequest, opts ...RequestOption) (*StreamsUpsertResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Kibana expects arrays, even when nothing is linked
+		body := req.Body
+		if body.Dashboards == nil {
+			body.Dashboards = []string{}
+		}
+		if body.Queries == nil {
+			body.Queries = []StreamsQuery{}
+		}
+
+		res, err := do[StreamsUpsertRequestBody, StreamsUpsertResponseBody](ctx, api, operation{
+			name:    "streams.upsert",
+			feature: FeatureStreams,
+			method:  http.MethodPut,
+			path:    fmt.Sprintf("/api/streams/%s", req.Name),
+		}, &body, opts)
+		return (*StreamsUpsertResponse)(res), err
+	}
+}
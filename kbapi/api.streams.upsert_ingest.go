@@ -0,0 +1,32 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StreamsUpsertIngestResponse wraps the response from a Streams.UpsertIngest call
+type StreamsUpsertIngestResponse Response[StreamsUpsertResponseBody]
+
+type StreamsUpsertIngestRequest struct {
+	Name string
+	Body StreamsIngestBody
+}
+
+// newStreamsUpsertIngest returns a function that performs PUT /api/streams/{name}/_ingest API requests
+func (api *API) newStreamsUpsertIngest() func(context.Context, *StreamsUpsertIngestRequest, ...RequestOption) (*StreamsUpsertIngestResponse, error) {
+	return func(ctx context.Context, req *StreamsUpsertIngestRequest, opts ...RequestOption) (*StreamsUpsertIngestResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[StreamsIngestBody, StreamsUpsertResponseBody](ctx, api, operation{
+			name:    "streams.upsert_ingest",
+			feature: FeatureStreams,
+			method:  http.MethodPut,
+			path:    fmt.Sprintf("/api/streams/%s/_ingest", req.Name),
+		}, &req.Body, opts)
+		return (*StreamsUpsertIngestResponse)(res), err
+	}
+}
@@ -0,0 +1,40 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StreamsUpsertQueryResponse wraps the response from a Streams.UpsertQuery call
+type StreamsUpsertQueryResponse Response[StreamsAcknowledgedResponseBody]
+
+type StreamsUpsertQueryRequestBody struct {
+	Title string `json:"title"`
+	KQL   struct {
+		Query string `json:"query"`
+	} `json:"kql"`
+}
+
+type StreamsUpsertQueryRequest struct {
+	Name    string
+	QueryID string
+	Body    StreamsUpsertQueryRequestBody
+}
+
+// newStreamsUpsertQuery returns a function that performs PUT /api/streams/{name}/queries/{queryId} API requests
+func (api *API) newStreamsUpsertQuery() func(context.Context, *StreamsUpsertQueryRequest, ...RequestOption) (*StreamsUpsertQueryResponse, error) {
+	return func(ctx context.Context, req *StreamsUpsertQueryRequest, opts ...RequestOption) (*StreamsUpsertQueryResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[StreamsUpsertQueryRequestBody, StreamsAcknowledgedResponseBody](ctx, api, operation{
+			name:    "streams.upsert_query",
+			feature: FeatureStreams,
+			method:  http.MethodPut,
+			path:    fmt.Sprintf("/api/streams/%s/queries/%s", req.Name, req.QueryID),
+		}, &req.Body, opts)
+		return (*StreamsUpsertQueryResponse)(res), err
+	}
+}
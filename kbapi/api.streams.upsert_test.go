@@ -0,0 +1,107 @@
+package kbapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamsUpsert(t *testing.T) {
+	mockTransport := NewMockTransport(200, StreamsUpsertResponseBody{Acknowledged: true, Result: "created"}, nil)
+	api := New(mockTransport)
+
+	body := StreamsUpsertRequestBody{}
+	body.Stream.Ingest = StreamsIngest{
+		Lifecycle: StreamsLifecycle{Inherit: &struct{}{}},
+		Processing: []StreamsProcessor{{
+			Grok: &StreamsGrokProcessor{
+				Field:    "message",
+				Patterns: []string{"%{IP:client.ip} %{WORD:http.request.method}"},
+			},
+		}},
+		Wired: &StreamsWiredIngest{
+			Fields: map[string]StreamsFieldDefinition{"client.ip": {Type: "ip"}},
+			Routing: []StreamsRoutingDefinition{{
+				Destination: "logs.nginx.errors",
+				If: StreamsAnd(
+					StreamsFilter("http.response.status_code", StreamsOperatorGte, 500),
+					StreamsNot(StreamsFilter("url.path", StreamsOperatorStartsWith, "/health")),
+				),
+			}},
+		},
+	}
+
+	resp, err := api.Streams.Upsert(context.Background(), &StreamsUpsertRequest{Name: "logs.nginx", Body: body})
+	require.NoError(t, err)
+	assert.Equal(t, "created", resp.Body.Result)
+
+	req := mockTransport.LastRequest()
+	AssertRequestMethod(t, req, "PUT")
+	AssertRequestPath(t, req, "/api/streams/logs.nginx")
+	AssertRequestBodyJSON(t, req, map[string]interface{}{
+		"dashboards": []interface{}{},
+		"queries":    []interface{}{},
+		"stream": map[string]interface{}{
+			"ingest": map[string]interface{}{
+				"lifecycle": map[string]interface{}{"inherit": map[string]interface{}{}},
+				"processing": []interface{}{
+					map[string]interface{}{"grok": map[string]interface{}{
+						"field":    "message",
+						"patterns": []interface{}{"%{IP:client.ip} %{WORD:http.request.method}"},
+						"if":       map[string]interface{}{"always": map[string]interface{}{}},
+					}},
+				},
+				"wired": map[string]interface{}{
+					"fields": map[string]interface{}{"client.ip": map[string]interface{}{"type": "ip"}},
+					"routing": []interface{}{
+						map[string]interface{}{
+							"destination": "logs.nginx.errors",
+							"if": map[string]interface{}{"and": []interface{}{
+								map[string]interface{}{"field": "http.response.status_code", "operator": "gte", "value": float64(500)},
+								map[string]interface{}{"not": map[string]interface{}{"field": "url.path", "operator": "startsWith", "value": "/health"}},
+							}},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestStreamsGet_Condition(t *testing.T) {
+	mockTransport := NewMockTransportWithRawResponse(200, `{
+		"stream": {
+			"name": "logs",
+			"ingest": {
+				"lifecycle": {"dsl": {"data_retention": "7d"}},
+				"processing": [],
+				"wired": {
+					"fields": {},
+					"routing": [
+						{"destination": "logs.system", "if": {"or": [{"field": "host.name", "operator": "exists"}, {"never": {}}]}}
+					]
+				}
+			}
+		},
+		"dashboards": ["dashboard-1"],
+		"queries": []
+	}`, nil)
+	api := New(mockTransport)
+
+	resp, err := api.Streams.Get(context.Background(), &StreamsGetRequest{Name: "logs"})
+	require.NoError(t, err)
+
+	ingest := resp.Body.Stream.Ingest
+	require.NotNil(t, ingest.Lifecycle.DSL)
+	assert.Equal(t, "7d", *ingest.Lifecycle.DSL.DataRetention)
+	require.Len(t, ingest.Wired.Routing, 1)
+
+	condition := ingest.Wired.Routing[0].If
+	require.Len(t, condition.Or, 2)
+	assert.Equal(t, StreamsOperatorExists, condition.Or[0].Operator)
+	assert.Nil(t, condition.Or[0].Value)
+	assert.NotNil(t, condition.Or[1].Never)
+	assert.Equal(t, []string{"dashboard-1"}, resp.Body.Dashboards)
+}
@@ -0,0 +1,86 @@
+package kbapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Synthetics monitor types, as set in the type field of a monitor.
+const (
+	SyntheticsMonitorTypeHTTP    = "http"
+	SyntheticsMonitorTypeTCP     = "tcp"
+	SyntheticsMonitorTypeICMP    = "icmp"
+	SyntheticsMonitorTypeBrowser = "browser"
+)
+
+// SyntheticsMonitorConfig is implemented by every monitor configuration type:
+// SyntheticsHTTPMonitorConfig, SyntheticsTCPMonitorConfig,
+// SyntheticsICMPMonitorConfig and SyntheticsBrowserMonitorConfig.
+type SyntheticsMonitorConfig interface {
+	GetType() string
+}
+
+func (c SyntheticsHTTPMonitorConfig) GetType() string { return SyntheticsMonitorTypeHTTP }
+
+func (c SyntheticsTCPMonitorConfig) GetType() string { return SyntheticsMonitorTypeTCP }
+
+func (c SyntheticsICMPMonitorConfig) GetType() string { return SyntheticsMonitorTypeICMP }
+
+func (c SyntheticsBrowserMonitorConfig) GetType() string { return SyntheticsMonitorTypeBrowser }
+
+// SyntheticsMonitorRequestBody is the body of the monitor create and update
+// requests. The type field is filled in from the concrete Config.
+type SyntheticsMonitorRequestBody struct {
+	Config SyntheticsMonitorConfig
+}
+
+func (b SyntheticsMonitorRequestBody) MarshalJSON() ([]byte, error) {
+	if b.Config == nil {
+		return nil, fmt.Errorf("monitor config cannot be nil")
+	}
+	return marshalWithType(b.Config, b.Config.GetType())
+}
+
+// SyntheticsSchedule is how often a monitor runs. Kibana accepts and returns
+// schedules in minutes, older versions return them as a string number.
+type SyntheticsSchedule struct {
+	Number string `json:"number"`
+	// Unit Either m for minutes or s for seconds.
+	Unit string `json:"unit"`
+}
+
+// SyntheticsScheduleMinutes returns a schedule running every n minutes.
+func SyntheticsScheduleMinutes(n int) *SyntheticsSchedule {
+	return &SyntheticsSchedule{Number: strconv.Itoa(n), Unit: "m"}
+}
+
+func (s SyntheticsSchedule) MarshalJSON() ([]byte, error) {
+	// Schedules in minutes are sent as a plain number
+	if s.Unit == "" || s.Unit == "m" {
+		if n, err := strconv.Atoi(s.Number); err == nil {
+			return json.Marshal(n)
+		}
+	}
+
+	type schedule SyntheticsSchedule
+	return json.Marshal(schedule(s))
+}
+
+func (s *SyntheticsSchedule) UnmarshalJSON(b []byte) error {
+	var minutes json.Number
+	if err := json.Unmarshal(b, &minutes); err == nil {
+		*s = SyntheticsSchedule{Number: minutes.String(), Unit: "m"}
+		return nil
+	}
+
+	var schedule struct {
+		Number json.Number `json:"number"`
+		Unit   string      `json:"unit"`
+	}
+	if err := json.Unmarshal(b, &schedule); err != nil {
+		return err
+	}
+	*s = SyntheticsSchedule{Number: schedule.Number.String(), Unit: schedule.Unit}
+	return nil
+}
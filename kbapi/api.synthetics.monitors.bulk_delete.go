@@ -0,0 +1,36 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SyntheticsMonitorsBulkDeleteResponse wraps the response from a Synthetics.Monitors.BulkDelete call
+type SyntheticsMonitorsBulkDeleteResponse Response[[]SyntheticsDeleteResult]
+
+// SyntheticsBulkDeleteRequestBody lists the IDs of the monitors or parameters to delete.
+type SyntheticsBulkDeleteRequestBody struct {
+	IDs []string `json:"ids"`
+}
+
+type SyntheticsMonitorsBulkDeleteRequest struct {
+	Body SyntheticsBulkDeleteRequestBody
+}
+
+// newSyntheticsMonitorsBulkDelete returns a function that performs DELETE /api/synthetics/monitors API requests
+func (api *API) newSyntheticsMonitorsBulkDelete() func(context.Context, *SyntheticsMonitorsBulkDeleteRequest, ...RequestOption) (*SyntheticsMonitorsBulkDeleteResponse, error) {
+	return func(ctx context.Context, req *SyntheticsMonitorsBulkDeleteRequest, opts ...RequestOption) (*SyntheticsMonitorsBulkDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SyntheticsBulkDeleteRequestBody, []SyntheticsDeleteResult](ctx, api, operation{
+			name:    "synthetics.monitors.bulk_delete",
+			feature: FeatureSynthetics,
+			method:  http.MethodDelete,
+			path:    "/api/synthetics/monitors",
+		}, &req.Body, opts)
+		return (*SyntheticsMonitorsBulkDeleteResponse)(res), err
+	}
+}
@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SyntheticsMonitorsCreateResponse wraps the response from a Synthetics.Monitors.Create call
+type SyntheticsMonitorsCreateResponse Response[SyntheticsMonitor]
+
+type SyntheticsMonitorsCreateRequest struct {
+	Body SyntheticsMonitorRequestBody
+}
+
+// newSyntheticsMonitorsCreate returns a function that performs POST /api/synthetics/monitors API requests
+func (api *API) newSyntheticsMonitorsCreate() func(context.Context, *SyntheticsMonitorsCreateRequest, ...RequestOption) (*SyntheticsMonitorsCreateResponse, error) {
+	return func(ctx context.Context, req *SyntheticsMonitorsCreateRequest, opts ...RequestOption) (*SyntheticsMonitorsCreateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SyntheticsMonitorRequestBody, SyntheticsMonitor](ctx, api, operation{
+			name:    "synthetics.monitors.create",
+			feature: FeatureSynthetics,
+			method:  http.MethodPost,
+			path:    "/api/synthetics/monitors",
+		}, &req.Body, opts)
+		return (*SyntheticsMonitorsCreateResponse)(res), err
+	}
+}
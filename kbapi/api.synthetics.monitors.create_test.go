@@ -0,0 +1,70 @@
+package kbapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntheticsMonitorsCreate(t *testing.T) {
+	mockTransport := NewMockTransportWithRawResponse(200, `{
+		"id": "monitor-1",
+		"config_id": "monitor-1",
+		"name": "api health",
+		"type": "http",
+		"enabled": true,
+		"schedule": {"number": "5", "unit": "m"},
+		"locations": [{"id": "dc-1", "label": "Datacenter 1", "isServiceManaged": false}],
+		"url": "https://api.example.com/health"
+	}`, nil)
+	api := New(mockTransport).Space("ops")
+
+	config := SyntheticsHTTPMonitorConfig{
+		SyntheticsMonitorCommonConfig: SyntheticsMonitorCommonConfig{
+			Name:             "api health",
+			PrivateLocations: []string{"dc-1"},
+			Schedule:         SyntheticsScheduleMinutes(5),
+		},
+		URL: "https://api.example.com/health",
+		Check: &SyntheticsHTTPCheck{
+			Response: &SyntheticsHTTPCheckResponse{Status: []string{"200"}},
+		},
+	}
+
+	resp, err := api.Synthetics.Monitors.Create(context.Background(), &SyntheticsMonitorsCreateRequest{
+		Body: SyntheticsMonitorRequestBody{Config: config},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "monitor-1", resp.Body.ID)
+	assert.Equal(t, SyntheticsSchedule{Number: "5", Unit: "m"}, resp.Body.Schedule)
+	require.Len(t, resp.Body.Locations, 1)
+	assert.Equal(t, "dc-1", resp.Body.Locations[0].ID)
+
+	req := mockTransport.LastRequest()
+	AssertRequestMethod(t, req, "POST")
+	AssertRequestPath(t, req, "/s/ops/api/synthetics/monitors")
+	AssertRequestBodyJSON(t, req, map[string]interface{}{
+		"type":              "http",
+		"name":              "api health",
+		"private_locations": []interface{}{"dc-1"},
+		"schedule":          float64(5),
+		"url":               "https://api.example.com/health",
+		"check": map[string]interface{}{
+			"response": map[string]interface{}{"status": []interface{}{"200"}},
+		},
+	})
+}
+
+func TestSyntheticsSchedule_Unmarshal(t *testing.T) {
+	for input, expected := range map[string]SyntheticsSchedule{
+		`10`:                            {Number: "10", Unit: "m"},
+		`{"number": "30", "unit": "s"}`: {Number: "30", Unit: "s"},
+		`{"number": 3, "unit": "m"}`:    {Number: "3", Unit: "m"},
+	} {
+		var schedule SyntheticsSchedule
+		require.NoError(t, schedule.UnmarshalJSON([]byte(input)))
+		assert.Equal(t, expected, schedule, input)
+	}
+}
@@ -0,0 +1,44 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SyntheticsMonitorsDeleteResponse wraps the response from a Synthetics.Monitors.Delete call
+type SyntheticsMonitorsDeleteResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type SyntheticsMonitorsDeleteRequest struct {
+	ID string
+}
+
+// newSyntheticsMonitorsDelete returns a function that performs DELETE /api/synthetics/monitors/{id} API requests
+func (api *API) newSyntheticsMonitorsDelete() func(context.Context, *SyntheticsMonitorsDeleteRequest, ...RequestOption) (*SyntheticsMonitorsDeleteResponse, error) {
+	return func(ctx context.Context, req *SyntheticsMonitorsDeleteRequest, opts ...RequestOption) (*SyntheticsMonitorsDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:    "synthetics.monitors.delete",
+			feature: FeatureSynthetics,
+			method:  http.MethodDelete,
+			path:    fmt.Sprintf("/api/synthetics/monitors/%s", req.ID),
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &SyntheticsMonitorsDeleteResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
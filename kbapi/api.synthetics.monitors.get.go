@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SyntheticsMonitorsGetResponse wraps the response from a Synthetics.Monitors.Get call
+type SyntheticsMonitorsGetResponse Response[SyntheticsMonitor]
+
+type SyntheticsMonitorsGetRequest struct {
+	ID string
+}
+
+// newSyntheticsMonitorsGet returns a function that performs GET /api/synthetics/monitors/{id} API requests
+func (api *API) newSyntheticsMonitorsGet() func(context.Context, *SyntheticsMonitorsGetRequest, ...RequestOption) (*SyntheticsMonitorsGetResponse, error) {
+	return func(ctx context.Context, req *SyntheticsMonitorsGetRequest, opts ...RequestOption) (*SyntheticsMonitorsGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, SyntheticsMonitor](ctx, api, operation{
+			name:    "synthetics.monitors.get",
+			feature: FeatureSynthetics,
+			method:  http.MethodGet,
+			path:    fmt.Sprintf("/api/synthetics/monitors/%s", req.ID),
+		}, nil, opts)
+		return (*SyntheticsMonitorsGetResponse)(res), err
+	}
+}
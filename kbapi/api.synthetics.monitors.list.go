@@ -0,0 +1,125 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SyntheticsMonitorsListResponse wraps the response from a Synthetics.Monitors.List call
+type SyntheticsMonitorsListResponse Response[SyntheticsMonitorsListResponseBody]
+
+type SyntheticsMonitorsListResponseBody struct {
+	Page    int `json:"page"`
+	PerPage int `json:"perPage"`
+	Total   int `json:"total"`
+	// AbsoluteTotal The total number of monitors, regardless of the filters.
+	AbsoluteTotal int                 `json:"absoluteTotal"`
+	Monitors      []SyntheticsMonitor `json:"monitors"`
+}
+
+type SyntheticsMonitorsListRequestParams struct {
+	Page    *int
+	PerPage *int
+	// SortField One of name, createdAt, updatedAt, status, tags.keyword, url.keyword or locations.keyword.
+	SortField *string
+	SortOrder *string
+	// Query A free text query matched against the monitor name, URL, host and tags.
+	Query            *string
+	Tags             []string
+	MonitorTypes     []string
+	Locations        []string
+	Projects         []string
+	Schedules        []string
+	Status           []string
+	UseLogicalAndFor []string
+}
+
+type SyntheticsMonitorsListRequest struct {
+	Params SyntheticsMonitorsListRequestParams
+}
+
+// newSyntheticsMonitorsList returns a function that performs GET /api/synthetics/monitors API requests
+func (api *API) newSyntheticsMonitorsList() func(context.Context, *SyntheticsMonitorsListRequest, ...RequestOption) (*SyntheticsMonitorsListResponse, error) {
+	return func(ctx context.Context, req *SyntheticsMonitorsListRequest, opts ...RequestOption) (*SyntheticsMonitorsListResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.Page != nil {
+			params.Set("page", strconv.Itoa(*req.Params.Page))
+		}
+		if req.Params.PerPage != nil {
+			params.Set("per_page", strconv.Itoa(*req.Params.PerPage))
+		}
+		if req.Params.SortField != nil {
+			params.Set("sortField", *req.Params.SortField)
+		}
+		if req.Params.SortOrder != nil {
+			params.Set("sortOrder", *req.Params.SortOrder)
+		}
+		if req.Params.Query != nil {
+			params.Set("query", *req.Params.Query)
+		}
+		for _, v := range req.Params.Tags {
+			params.Add("tags", v)
+		}
+		for _, v := range req.Params.MonitorTypes {
+			params.Add("monitorTypes", v)
+		}
+		for _, v := range req.Params.Locations {
+			params.Add("locations", v)
+		}
+		for _, v := range req.Params.Projects {
+			params.Add("projects", v)
+		}
+		for _, v := range req.Params.Schedules {
+			params.Add("schedules", v)
+		}
+		for _, v := range req.Params.Status {
+			params.Add("status", v)
+		}
+		for _, v := range req.Params.UseLogicalAndFor {
+			params.Add("useLogicalAndFor", v)
+		}
+
+		res, err := do[noBody, SyntheticsMonitorsListResponseBody](ctx, api, operation{
+			name:    "synthetics.monitors.list",
+			feature: FeatureSynthetics,
+			method:  http.MethodGet,
+			path:    "/api/synthetics/monitors",
+			query:   params,
+		}, nil, opts)
+		return (*SyntheticsMonitorsListResponse)(res), err
+	}
+}
+
+// All returns an iterator over all monitors matching req. Pages of
+// req.Params.PerPage items, or DefaultPerPage when unset, are fetched on demand.
+func (m SyntheticsMonitors) All(ctx context.Context, req *SyntheticsMonitorsListRequest, opts ...RequestOption) iter.Seq2[SyntheticsMonitor, error] {
+	return items(m.Pages(ctx, req, opts...))
+}
+
+// Pages returns an iterator over the pages of monitors matching req.
+func (m SyntheticsMonitors) Pages(ctx context.Context, req *SyntheticsMonitorsListRequest, opts ...RequestOption) iter.Seq2[*Page[SyntheticsMonitor], error] {
+	var params SyntheticsMonitorsListRequestParams
+	if req != nil {
+		params = req.Params
+	}
+
+	return pages(ctx, intValue(params.Page, 1), intValue(params.PerPage, DefaultPerPage), func(ctx context.Context, page, perPage int) (*Page[SyntheticsMonitor], error) {
+		params.Page = IntPtr(page)
+		params.PerPage = IntPtr(perPage)
+		resp, err := m.List(ctx, &SyntheticsMonitorsListRequest{Params: params}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &Page[SyntheticsMonitor]{Items: resp.Body.Monitors, Page: resp.Body.Page, PerPage: resp.Body.PerPage, Total: resp.Body.Total}, nil
+	})
+}
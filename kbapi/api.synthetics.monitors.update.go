@@ -0,0 +1,32 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SyntheticsMonitorsUpdateResponse wraps the response from a Synthetics.Monitors.Update call
+type SyntheticsMonitorsUpdateResponse Response[SyntheticsMonitor]
+
+type SyntheticsMonitorsUpdateRequest struct {
+	ID   string
+	Body SyntheticsMonitorRequestBody
+}
+
+// newSyntheticsMonitorsUpdate returns a function that performs PUT /api/synthetics/monitors/{id} API requests
+func (api *API) newSyntheticsMonitorsUpdate() func(context.Context, *SyntheticsMonitorsUpdateRequest, ...RequestOption) (*SyntheticsMonitorsUpdateResponse, error) {
+	return func(ctx context.Context, req *SyntheticsMonitorsUpdateRequest, opts ...RequestOption) (*SyntheticsMonitorsUpdateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SyntheticsMonitorRequestBody, SyntheticsMonitor](ctx, api, operation{
+			name:    "synthetics.monitors.update",
+			feature: FeatureSynthetics,
+			method:  http.MethodPut,
+			path:    fmt.Sprintf("/api/synthetics/monitors/%s", req.ID),
+		}, &req.Body, opts)
+		return (*SyntheticsMonitorsUpdateResponse)(res), err
+	}
+}
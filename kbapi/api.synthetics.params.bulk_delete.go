@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SyntheticsParamsBulkDeleteResponse wraps the response from a Synthetics.Params.BulkDelete call
+type SyntheticsParamsBulkDeleteResponse Response[[]SyntheticsDeleteResult]
+
+type SyntheticsParamsBulkDeleteRequest struct {
+	Body SyntheticsBulkDeleteRequestBody
+}
+
+// newSyntheticsParamsBulkDelete returns a function that performs DELETE /api/synthetics/params API requests
+func (api *API) newSyntheticsParamsBulkDelete() func(context.Context, *SyntheticsParamsBulkDeleteRequest, ...RequestOption) (*SyntheticsParamsBulkDeleteResponse, error) {
+	return func(ctx context.Context, req *SyntheticsParamsBulkDeleteRequest, opts ...RequestOption) (*SyntheticsParamsBulkDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SyntheticsBulkDeleteRequestBody, []SyntheticsDeleteResult](ctx, api, operation{
+			name:    "synthetics.params.bulk_delete",
+			feature: FeatureSynthetics,
+			method:  http.MethodDelete,
+			path:    "/api/synthetics/params",
+		}, &req.Body, opts)
+		return (*SyntheticsParamsBulkDeleteResponse)(res), err
+	}
+}
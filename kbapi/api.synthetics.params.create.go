@@ -0,0 +1,40 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SyntheticsParamsCreateResponse wraps the response from a Synthetics.Params.Create call
+type SyntheticsParamsCreateResponse Response[SyntheticsParam]
+
+type SyntheticsParamsCreateRequestBody struct {
+	Key         string   `json:"key"`
+	Value       string   `json:"value"`
+	Description *string  `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	// ShareAcrossSpaces Makes the parameter available in all spaces.
+	ShareAcrossSpaces *bool `json:"share_across_spaces,omitempty"`
+}
+
+type SyntheticsParamsCreateRequest struct {
+	Body SyntheticsParamsCreateRequestBody
+}
+
+// newSyntheticsParamsCreate returns a function that performs POST /api/synthetics/params API requests
+func (api *API) newSyntheticsParamsCreate() func(context.Context, *SyntheticsParamsCreateRequest, ...RequestOption) (*SyntheticsParamsCreateResponse, error) {
+	return func(ctx context.Context, req *SyntheticsParamsCreateRequest, opts ...RequestOption) (*SyntheticsParamsCreateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SyntheticsParamsCreateRequestBody, SyntheticsParam](ctx, api, operation{
+			name:    "synthetics.params.create",
+			feature: FeatureSynthetics,
+			method:  http.MethodPost,
+			path:    "/api/synthetics/params",
+		}, &req.Body, opts)
+		return (*SyntheticsParamsCreateResponse)(res), err
+	}
+}
@@ -0,0 +1,44 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SyntheticsParamsDeleteResponse wraps the response from a Synthetics.Params.Delete call
+type SyntheticsParamsDeleteResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type SyntheticsParamsDeleteRequest struct {
+	ID string
+}
+
+// newSyntheticsParamsDelete returns a function that performs DELETE /api/synthetics/params/{id} API requests
+func (api *API) newSyntheticsParamsDelete() func(context.Context, *SyntheticsParamsDeleteRequest, ...RequestOption) (*SyntheticsParamsDeleteResponse, error) {
+	return func(ctx context.Context, req *SyntheticsParamsDeleteRequest, opts ...RequestOption) (*SyntheticsParamsDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:    "synthetics.params.delete",
+			feature: FeatureSynthetics,
+			method:  http.MethodDelete,
+			path:    fmt.Sprintf("/api/synthetics/params/%s", req.ID),
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &SyntheticsParamsDeleteResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SyntheticsParamsGetResponse wraps the response from a Synthetics.Params.Get call
+type SyntheticsParamsGetResponse Response[SyntheticsParam]
+
+type SyntheticsParamsGetRequest struct {
+	ID string
+}
+
+// newSyntheticsParamsGet returns a function that performs GET /api/synthetics/params/{id} API requests
+func (api *API) newSyntheticsParamsGet() func(context.Context, *SyntheticsParamsGetRequest, ...RequestOption) (*SyntheticsParamsGetResponse, error) {
+	return func(ctx context.Context, req *SyntheticsParamsGetRequest, opts ...RequestOption) (*SyntheticsParamsGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, SyntheticsParam](ctx, api, operation{
+			name:    "synthetics.params.get",
+			feature: FeatureSynthetics,
+			method:  http.MethodGet,
+			path:    fmt.Sprintf("/api/synthetics/params/%s", req.ID),
+		}, nil, opts)
+		return (*SyntheticsParamsGetResponse)(res), err
+	}
+}
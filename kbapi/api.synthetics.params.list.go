@@ -0,0 +1,22 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// SyntheticsParamsListResponse wraps the response from a Synthetics.Params.List call
+type SyntheticsParamsListResponse Response[[]SyntheticsParam]
+
+// newSyntheticsParamsList returns a function that performs GET /api/synthetics/params API requests
+func (api *API) newSyntheticsParamsList() func(context.Context, ...RequestOption) (*SyntheticsParamsListResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*SyntheticsParamsListResponse, error) {
+		res, err := do[noBody, []SyntheticsParam](ctx, api, operation{
+			name:    "synthetics.params.list",
+			feature: FeatureSynthetics,
+			method:  http.MethodGet,
+			path:    "/api/synthetics/params",
+		}, nil, opts)
+		return (*SyntheticsParamsListResponse)(res), err
+	}
+}
@@ -0,0 +1,39 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SyntheticsParamsUpdateResponse wraps the response from a Synthetics.Params.Update call
+type SyntheticsParamsUpdateResponse Response[SyntheticsParam]
+
+type SyntheticsParamsUpdateRequestBody struct {
+	Key         *string  `json:"key,omitempty"`
+	Value       *string  `json:"value,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+type SyntheticsParamsUpdateRequest struct {
+	ID   string
+	Body SyntheticsParamsUpdateRequestBody
+}
+
+// newSyntheticsParamsUpdate returns a function that performs PUT /api/synthetics/params/{id} API requests
+func (api *API) newSyntheticsParamsUpdate() func(context.Context, *SyntheticsParamsUpdateRequest, ...RequestOption) (*SyntheticsParamsUpdateResponse, error) {
+	return func(ctx context.Context, req *SyntheticsParamsUpdateRequest, opts ...RequestOption) (*SyntheticsParamsUpdateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SyntheticsParamsUpdateRequestBody, SyntheticsParam](ctx, api, operation{
+			name:    "synthetics.params.update",
+			feature: FeatureSynthetics,
+			method:  http.MethodPut,
+			path:    fmt.Sprintf("/api/synthetics/params/%s", req.ID),
+		}, &req.Body, opts)
+		return (*SyntheticsParamsUpdateResponse)(res), err
+	}
+}
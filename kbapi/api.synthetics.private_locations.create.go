@@ -0,0 +1,41 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SyntheticsPrivateLocationsCreateResponse wraps the response from a Synthetics.PrivateLocations.Create call
+type SyntheticsPrivateLocationsCreateResponse Response[SyntheticsPrivateLocation]
+
+type SyntheticsPrivateLocationsCreateRequestBody struct {
+	Label string `json:"label"`
+	// AgentPolicyID The ID of the Fleet agent policy that runs the monitors of the location.
+	AgentPolicyID string         `json:"agentPolicyId"`
+	Tags          []string       `json:"tags,omitempty"`
+	Geo           *SyntheticsGeo `json:"geo,omitempty"`
+	// Spaces The IDs of the spaces the location is available in, * for all spaces.
+	Spaces []string `json:"spaces,omitempty"`
+}
+
+type SyntheticsPrivateLocationsCreateRequest struct {
+	Body SyntheticsPrivateLocationsCreateRequestBody
+}
+
+// newSyntheticsPrivateLocationsCreate returns a function that performs POST /api/synthetics/private_locations API requests
+func (api *API) newSyntheticsPrivateLocationsCreate() func(context.Context, *SyntheticsPrivateLocationsCreateRequest, ...RequestOption) (*SyntheticsPrivateLocationsCreateResponse, error) {
+	return func(ctx context.Context, req *SyntheticsPrivateLocationsCreateRequest, opts ...RequestOption) (*SyntheticsPrivateLocationsCreateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SyntheticsPrivateLocationsCreateRequestBody, SyntheticsPrivateLocation](ctx, api, operation{
+			name:    "synthetics.private_locations.create",
+			feature: FeatureSynthetics,
+			method:  http.MethodPost,
+			path:    "/api/synthetics/private_locations",
+		}, &req.Body, opts)
+		return (*SyntheticsPrivateLocationsCreateResponse)(res), err
+	}
+}
@@ -0,0 +1,44 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SyntheticsPrivateLocationsDeleteResponse wraps the response from a Synthetics.PrivateLocations.Delete call
+type SyntheticsPrivateLocationsDeleteResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type SyntheticsPrivateLocationsDeleteRequest struct {
+	ID string
+}
+
+// newSyntheticsPrivateLocationsDelete returns a function that performs DELETE /api/synthetics/private_locations/{id} API requests
+func (api *API) newSyntheticsPrivateLocationsDelete() func(context.Context, *SyntheticsPrivateLocationsDeleteRequest, ...RequestOption) (*SyntheticsPrivateLocationsDeleteResponse, error) {
+	return func(ctx context.Context, req *SyntheticsPrivateLocationsDeleteRequest, opts ...RequestOption) (*SyntheticsPrivateLocationsDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, noBody](ctx, api, operation{
+			name:    "synthetics.private_locations.delete",
+			feature: FeatureSynthetics,
+			method:  http.MethodDelete,
+			path:    fmt.Sprintf("/api/synthetics/private_locations/%s", req.ID),
+		}, nil, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &SyntheticsPrivateLocationsDeleteResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
@@ -0,0 +1,31 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SyntheticsPrivateLocationsGetResponse wraps the response from a Synthetics.PrivateLocations.Get call
+type SyntheticsPrivateLocationsGetResponse Response[SyntheticsPrivateLocation]
+
+type SyntheticsPrivateLocationsGetRequest struct {
+	ID string
+}
+
+// newSyntheticsPrivateLocationsGet returns a function that performs GET /api/synthetics/private_locations/{id} API requests
+func (api *API) newSyntheticsPrivateLocationsGet() func(context.Context, *SyntheticsPrivateLocationsGetRequest, ...RequestOption) (*SyntheticsPrivateLocationsGetResponse, error) {
+	return func(ctx context.Context, req *SyntheticsPrivateLocationsGetRequest, opts ...RequestOption) (*SyntheticsPrivateLocationsGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[noBody, SyntheticsPrivateLocation](ctx, api, operation{
+			name:    "synthetics.private_locations.get",
+			feature: FeatureSynthetics,
+			method:  http.MethodGet,
+			path:    fmt.Sprintf("/api/synthetics/private_locations/%s", req.ID),
+		}, nil, opts)
+		return (*SyntheticsPrivateLocationsGetResponse)(res), err
+	}
+}
@@ -0,0 +1,22 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// SyntheticsPrivateLocationsListResponse wraps the response from a Synthetics.PrivateLocations.List call
+type SyntheticsPrivateLocationsListResponse Response[[]SyntheticsPrivateLocation]
+
+// newSyntheticsPrivateLocationsList returns a function that performs GET /api/synthetics/private_locations API requests
+func (api *API) newSyntheticsPrivateLocationsList() func(context.Context, ...RequestOption) (*SyntheticsPrivateLocationsListResponse, error) {
+	return func(ctx context.Context, opts ...RequestOption) (*SyntheticsPrivateLocationsListResponse, error) {
+		res, err := do[noBody, []SyntheticsPrivateLocation](ctx, api, operation{
+			name:    "synthetics.private_locations.list",
+			feature: FeatureSynthetics,
+			method:  http.MethodGet,
+			path:    "/api/synthetics/private_locations",
+		}, nil, opts)
+		return (*SyntheticsPrivateLocationsListResponse)(res), err
+	}
+}
@@ -0,0 +1,36 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SyntheticsPrivateLocationsUpdateResponse wraps the response from a Synthetics.PrivateLocations.Update call
+type SyntheticsPrivateLocationsUpdateResponse Response[SyntheticsPrivateLocation]
+
+type SyntheticsPrivateLocationsUpdateRequestBody struct {
+	Label string `json:"label"`
+}
+
+type SyntheticsPrivateLocationsUpdateRequest struct {
+	ID   string
+	Body SyntheticsPrivateLocationsUpdateRequestBody
+}
+
+// newSyntheticsPrivateLocationsUpdate returns a function that performs PUT /api/synthetics/private_locations/{id} API requests
+func (api *API) newSyntheticsPrivateLocationsUpdate() func(context.Context, *SyntheticsPrivateLocationsUpdateRequest, ...RequestOption) (*SyntheticsPrivateLocationsUpdateResponse, error) {
+	return func(ctx context.Context, req *SyntheticsPrivateLocationsUpdateRequest, opts ...RequestOption) (*SyntheticsPrivateLocationsUpdateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[SyntheticsPrivateLocationsUpdateRequestBody, SyntheticsPrivateLocation](ctx, api, operation{
+			name:    "synthetics.private_locations.update",
+			feature: FeatureSynthetics,
+			method:  http.MethodPut,
+			path:    fmt.Sprintf("/api/synthetics/private_locations/%s", req.ID),
+		}, &req.Body, opts)
+		return (*SyntheticsPrivateLocationsUpdateResponse)(res), err
+	}
+}
@@ -0,0 +1,193 @@
+package kbapi
+
+import (
+	"time"
+)
+
+// SyntheticsMonitorCommonConfig holds the settings shared by all monitor types.
+type SyntheticsMonitorCommonConfig struct {
+	Name    string                  `json:"name"`
+	Alert   *SyntheticsMonitorAlert `json:"alert,omitempty"`
+	Enabled *bool                   `json:"enabled,omitempty"`
+	Labels  map[string]string       `json:"labels,omitempty"`
+	// Locations The IDs of the Elastic managed locations to run the monitor from.
+	Locations []string `json:"locations,omitempty"`
+	// PrivateLocations The IDs or labels of the private locations to run the monitor from.
+	PrivateLocations []string `json:"private_locations,omitempty"`
+	Namespace        *string  `json:"namespace,omitempty"`
+	// Params A JSON object of parameters available to the monitor, e.g. {"host": "example.com"}.
+	Params          *string             `json:"params,omitempty"`
+	RetestOnFailure *bool               `json:"retest_on_failure,omitempty"`
+	Schedule        *SyntheticsSchedule `json:"schedule,omitempty"`
+	ServiceName     *string             `json:"service.name,omitempty"`
+	Tags            []string            `json:"tags,omitempty"`
+	// Timeout The monitor timeout in seconds.
+	Timeout *int `json:"timeout,omitempty"`
+}
+
+// SyntheticsMonitorAlert enables the status and TLS certificate alerts of a monitor.
+type SyntheticsMonitorAlert struct {
+	Status *SyntheticsMonitorAlertToggle `json:"status,omitempty"`
+	TLS    *SyntheticsMonitorAlertToggle `json:"tls,omitempty"`
+}
+
+type SyntheticsMonitorAlertToggle struct {
+	Enabled bool `json:"enabled"`
+}
+
+// SyntheticsSSLConfig holds the TLS settings of HTTP and TCP monitors.
+type SyntheticsSSLConfig struct {
+	CertificateAuthorities *string  `json:"certificate_authorities,omitempty"`
+	Certificate            *string  `json:"certificate,omitempty"`
+	Key                    *string  `json:"key,omitempty"`
+	KeyPassphrase          *string  `json:"key_passphrase,omitempty"`
+	SupportedProtocols     []string `json:"supported_protocols,omitempty"`
+	// VerificationMode One of full, strict, certificate or none.
+	VerificationMode *string `json:"verification_mode,omitempty"`
+}
+
+// SyntheticsHTTPMonitorConfig is the configuration of an HTTP monitor.
+type SyntheticsHTTPMonitorConfig struct {
+	SyntheticsMonitorCommonConfig
+	URL          string               `json:"url"`
+	Check        *SyntheticsHTTPCheck `json:"check,omitempty"`
+	IPv4         *bool                `json:"ipv4,omitempty"`
+	IPv6         *bool                `json:"ipv6,omitempty"`
+	MaxRedirects *int                 `json:"max_redirects,omitempty"`
+	// Mode Whether the monitor checks any or all of the resolved IPs.
+	Mode         *string               `json:"mode,omitempty"`
+	Username     *string               `json:"username,omitempty"`
+	Password     *string               `json:"password,omitempty"`
+	ProxyHeaders map[string]string     `json:"proxy_headers,omitempty"`
+	ProxyURL     *string               `json:"proxy_url,omitempty"`
+	Response     *SyntheticsHTTPOutput `json:"response,omitempty"`
+	SSL          *SyntheticsSSLConfig  `json:"ssl,omitempty"`
+}
+
+// SyntheticsHTTPCheck describes the request an HTTP monitor sends and the response it expects.
+type SyntheticsHTTPCheck struct {
+	Request  *SyntheticsHTTPCheckRequest  `json:"request,omitempty"`
+	Response *SyntheticsHTTPCheckResponse `json:"response,omitempty"`
+}
+
+type SyntheticsHTTPCheckRequest struct {
+	Method  *string           `json:"method,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    *string           `json:"body,omitempty"`
+}
+
+type SyntheticsHTTPCheckResponse struct {
+	// Status The expected status codes.
+	Status []string `json:"status,omitempty"`
+	Body   *struct {
+		Positive []string `json:"positive,omitempty"`
+		Negative []string `json:"negative,omitempty"`
+	} `json:"body,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// SyntheticsHTTPOutput controls which parts of the response are stored with the check results.
+type SyntheticsHTTPOutput struct {
+	// IncludeBody One of on_error, never or always.
+	IncludeBody    *string `json:"include_body,omitempty"`
+	IncludeHeaders *bool   `json:"include_headers,omitempty"`
+}
+
+// SyntheticsTCPMonitorConfig is the configuration of a TCP monitor.
+type SyntheticsTCPMonitorConfig struct {
+	SyntheticsMonitorCommonConfig
+	// Host The host and port to connect to, e.g. example.com:443.
+	Host                  string               `json:"host"`
+	ProxyURL              *string              `json:"proxy_url,omitempty"`
+	ProxyUseLocalResolver *bool                `json:"proxy_use_local_resolver,omitempty"`
+	SSL                   *SyntheticsSSLConfig `json:"ssl,omitempty"`
+}
+
+// SyntheticsICMPMonitorConfig is the configuration of an ICMP monitor.
+type SyntheticsICMPMonitorConfig struct {
+	SyntheticsMonitorCommonConfig
+	Host string `json:"host"`
+	// Wait The time to wait for an echo reply in seconds.
+	Wait *int `json:"wait,omitempty"`
+}
+
+// SyntheticsBrowserMonitorConfig is the configuration of a browser monitor.
+type SyntheticsBrowserMonitorConfig struct {
+	SyntheticsMonitorCommonConfig
+	InlineScript      string                 `json:"inline_script"`
+	IgnoreHTTPSErrors *bool                  `json:"ignore_https_errors,omitempty"`
+	PlaywrightOptions map[string]interface{} `json:"playwright_options,omitempty"`
+	// Screenshots One of on, off or only-on-failure.
+	Screenshots    *string  `json:"screenshots,omitempty"`
+	SyntheticsArgs []string `json:"synthetics_args,omitempty"`
+}
+
+// SyntheticsMonitor is a monitor as returned by Kibana. Only the fields
+// common to all monitor types and the target of each type are decoded, the
+// full monitor is available in the RawBody of the response.
+type SyntheticsMonitor struct {
+	ID        string                      `json:"id"`
+	ConfigID  string                      `json:"config_id"`
+	Name      string                      `json:"name"`
+	Type      string                      `json:"type"`
+	Enabled   bool                        `json:"enabled"`
+	Schedule  SyntheticsSchedule          `json:"schedule"`
+	Locations []SyntheticsMonitorLocation `json:"locations"`
+	Tags      []string                    `json:"tags,omitempty"`
+	Namespace string                      `json:"namespace,omitempty"`
+	Alert     *SyntheticsMonitorAlert     `json:"alert,omitempty"`
+	// Origin Whether the monitor was created in the UI or by a project.
+	Origin    string     `json:"origin,omitempty"`
+	Revision  int        `json:"revision,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// URL The target of an HTTP monitor.
+	URL *string `json:"url,omitempty"`
+	// Host The target of a TCP or ICMP monitor.
+	Host *string `json:"host,omitempty"`
+	// InlineScript The script of a browser monitor.
+	InlineScript *string `json:"inline_script,omitempty"`
+}
+
+// SyntheticsMonitorLocation is a location a monitor runs from.
+type SyntheticsMonitorLocation struct {
+	ID               string         `json:"id"`
+	Label            string         `json:"label"`
+	IsServiceManaged bool           `json:"isServiceManaged"`
+	Geo              *SyntheticsGeo `json:"geo,omitempty"`
+}
+
+type SyntheticsGeo struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// SyntheticsPrivateLocation is a location backed by a Fleet agent policy.
+type SyntheticsPrivateLocation struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+	// AgentPolicyID The ID of the Fleet agent policy running the monitors of the location.
+	AgentPolicyID    string         `json:"agentPolicyId"`
+	IsServiceManaged bool           `json:"isServiceManaged"`
+	IsInvalid        *bool          `json:"isInvalid,omitempty"`
+	Tags             []string       `json:"tags,omitempty"`
+	Geo              *SyntheticsGeo `json:"geo,omitempty"`
+	Namespace        *string        `json:"namespace,omitempty"`
+	Spaces           []string       `json:"spaces,omitempty"`
+}
+
+// SyntheticsParam is a global parameter available to all monitors.
+type SyntheticsParam struct {
+	ID          string   `json:"id"`
+	Key         string   `json:"key"`
+	Value       *string  `json:"value,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Namespaces  []string `json:"namespaces,omitempty"`
+}
+
+// SyntheticsDeleteResult is the outcome of deleting one monitor or parameter in a bulk delete.
+type SyntheticsDeleteResult struct {
+	ID      string `json:"id"`
+	Deleted bool   `json:"deleted"`
+}
@@ -0,0 +1,30 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TimelineCleanDraftResponse wraps the response from a Timeline.CleanDraft call
+type TimelineCleanDraftResponse Response[SecurityTimelineAPIPersistTimelineResponse]
+
+type TimelineCleanDraftRequest struct {
+	Body CleanDraftTimelinesJSONRequestBody
+}
+
+// newTimelineCleanDraft returns a function that performs POST /api/timeline/_draft API requests
+func (api *API) newTimelineCleanDraft() func(context.Context, *TimelineCleanDraftRequest, ...RequestOption) (*TimelineCleanDraftResponse, error) {
+	return func(ctx context.Context, req *TimelineCleanDraftRequest, opts ...RequestOption) (*TimelineCleanDraftResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[CleanDraftTimelinesJSONRequestBody, SecurityTimelineAPIPersistTimelineResponse](ctx, api, operation{
+			name:   "timeline.clean_draft",
+			method: http.MethodPost,
+			path:   "/api/timeline/_draft",
+		}, &req.Body, opts)
+		return (*TimelineCleanDraftResponse)(res), err
+	}
+}
@@ -0,0 +1,30 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TimelineCopyResponse wraps the response from a Timeline.Copy call
+type TimelineCopyResponse Response[SecurityTimelineAPIPersistTimelineResponse]
+
+type TimelineCopyRequest struct {
+	Body CopyTimelineJSONRequestBody
+}
+
+// newTimelineCopy returns a function that performs POST /api/timeline/_copy API requests
+func (api *API) newTimelineCopy() func(context.Context, *TimelineCopyRequest, ...RequestOption) (*TimelineCopyResponse, error) {
+	return func(ctx context.Context, req *TimelineCopyRequest, opts ...RequestOption) (*TimelineCopyResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[CopyTimelineJSONRequestBody, SecurityTimelineAPIPersistTimelineResponse](ctx, api, operation{
+			name:   "timeline.copy",
+			method: http.MethodPost,
+			path:   "/api/timeline/_copy",
+		}, &req.Body, opts)
+		return (*TimelineCopyResponse)(res), err
+	}
+}
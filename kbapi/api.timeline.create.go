@@ -0,0 +1,30 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TimelineCreateResponse wraps the response from a Timeline.Create call
+type TimelineCreateResponse Response[SecurityTimelineAPIPersistTimelineResponse]
+
+type TimelineCreateRequest struct {
+	Body CreateTimelinesJSONRequestBody
+}
+
+// newTimelineCreate returns a function that performs POST /api/timeline API requests
+func (api *API) newTimelineCreate() func(context.Context, *TimelineCreateRequest, ...RequestOption) (*TimelineCreateResponse, error) {
+	return func(ctx context.Context, req *TimelineCreateRequest, opts ...RequestOption) (*TimelineCreateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[CreateTimelinesJSONRequestBody, SecurityTimelineAPIPersistTimelineResponse](ctx, api, operation{
+			name:   "timeline.create",
+			method: http.MethodPost,
+			path:   "/api/timeline",
+		}, &req.Body, opts)
+		return (*TimelineCreateResponse)(res), err
+	}
+}
@@ -0,0 +1,43 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// TimelineDeleteResponse wraps the response from a Timeline.Delete call
+type TimelineDeleteResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type TimelineDeleteRequest struct {
+	Body DeleteTimelinesJSONRequestBody
+}
+
+// newTimelineDelete returns a function that performs DELETE /api/timeline API requests
+func (api *API) newTimelineDelete() func(context.Context, *TimelineDeleteRequest, ...RequestOption) (*TimelineDeleteResponse, error) {
+	return func(ctx context.Context, req *TimelineDeleteRequest, opts ...RequestOption) (*TimelineDeleteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[DeleteTimelinesJSONRequestBody, noBody](ctx, api, operation{
+			name:   "timeline.delete",
+			method: http.MethodDelete,
+			path:   "/api/timeline",
+		}, &req.Body, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &TimelineDeleteResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
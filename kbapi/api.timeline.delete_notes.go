@@ -0,0 +1,47 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// TimelineDeleteNotesResponse wraps the response from a Timeline.DeleteNotes call
+type TimelineDeleteNotesResponse struct {
+	StatusCode int
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type TimelineDeleteNotesRequestBody struct {
+	NoteIDs []string `json:"noteIds"`
+}
+
+type TimelineDeleteNotesRequest struct {
+	Body TimelineDeleteNotesRequestBody
+}
+
+// newTimelineDeleteNotes returns a function that performs DELETE /api/note API requests
+func (api *API) newTimelineDeleteNotes() func(context.Context, *TimelineDeleteNotesRequest, ...RequestOption) (*TimelineDeleteNotesResponse, error) {
+	return func(ctx context.Context, req *TimelineDeleteNotesRequest, opts ...RequestOption) (*TimelineDeleteNotesResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[TimelineDeleteNotesRequestBody, noBody](ctx, api, operation{
+			name:   "timeline.delete_notes",
+			method: http.MethodDelete,
+			path:   "/api/note",
+		}, &req.Body, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		return &TimelineDeleteNotesResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}, err
+	}
+}
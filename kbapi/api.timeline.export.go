@@ -0,0 +1,81 @@
+package kbapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// TimelineExportResponse wraps the response from a Timeline.Export call
+type TimelineExportResponse struct {
+	StatusCode int
+	Body       []json.RawMessage
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+type TimelineExportRequest struct {
+	Params ExportTimelinesParams
+	Body   ExportTimelinesJSONRequestBody
+}
+
+// newTimelineExport returns a function that performs POST /api/timeline/_export API requests
+func (api *API) newTimelineExport() func(context.Context, *TimelineExportRequest, ...RequestOption) (*TimelineExportResponse, error) {
+	return func(ctx context.Context, req *TimelineExportRequest, opts ...RequestOption) (*TimelineExportResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.FileName != "" {
+			params.Set("file_name", req.Params.FileName)
+		}
+
+		res, err := do[ExportTimelinesJSONRequestBody, []byte](ctx, api, operation{
+			name:   "timeline.export",
+			method: http.MethodPost,
+			path:   "/api/timeline/_export",
+			query:  params,
+		}, &req.Body, opts)
+		if res == nil {
+			return nil, err
+		}
+
+		resp := &TimelineExportResponse{
+			StatusCode: res.StatusCode,
+			Error:      res.Error,
+			RawBody:    res.RawBody,
+		}
+		if res.Body != nil {
+			resp.Body = splitNDJSON(*res.Body)
+		}
+		return resp, err
+	}
+}
+
+// WriteToFile writes the response body to the specified path in NDJSON format.
+// See https://github.com/ndjson/ndjson-spec
+func (d *TimelineExportResponse) WriteToFile(filepath string) error {
+	file, err := os.Create(filepath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	for _, obj := range d.Body {
+		if _, err := file.Write(obj); err != nil {
+			return fmt.Errorf("failed to write object to file: %w", err)
+		}
+		if _, err := file.Write([]byte("\n")); err != nil {
+			return fmt.Errorf("failed to write newline to file: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package kbapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimelineExport(t *testing.T) {
+	mockTransport := NewMockTransportWithRawResponse(200, "{\"savedObjectId\":\"t1\"}\n{\"savedObjectId\":\"t2\"}\n", nil)
+	api := New(mockTransport).Space("soc")
+
+	ids := []string{"t1", "t2"}
+	resp, err := api.Timeline.Export(context.Background(), &TimelineExportRequest{
+		Params: ExportTimelinesParams{FileName: "timelines.ndjson"},
+		Body:   ExportTimelinesJSONRequestBody{Ids: &ids},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Body, 2)
+	assert.JSONEq(t, `{"savedObjectId":"t2"}`, string(resp.Body[1]))
+
+	req := mockTransport.LastRequest()
+	AssertRequestMethod(t, req, "POST")
+	AssertRequestPath(t, req, "/s/soc/api/timeline/_export")
+	AssertRequestParam(t, req, "file_name", "timelines.ndjson")
+	AssertRequestBodyJSON(t, req, map[string]interface{}{"ids": []interface{}{"t1", "t2"}})
+}
+
+func TestTimelineGetNotes_IDs(t *testing.T) {
+	mockTransport := NewMockTransport(200, SecurityTimelineAPIGetNotesResult{TotalCount: 0}, nil)
+	api := New(mockTransport)
+
+	documentIDs := &SecurityTimelineAPIDocumentIds{}
+	require.NoError(t, documentIDs.SetIDs("event-1", "event-2"))
+
+	_, err := api.Timeline.GetNotes(context.Background(), &TimelineGetNotesRequest{
+		Params: GetNotesParams{DocumentIds: documentIDs},
+	})
+	require.NoError(t, err)
+
+	req := mockTransport.LastRequest()
+	AssertRequestPath(t, req, "/api/note")
+	assert.Equal(t, []string{"event-1", "event-2"}, req.URL.Query()["documentIds"])
+}
@@ -0,0 +1,42 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TimelineGetResponse wraps the response from a Timeline.Get call
+type TimelineGetResponse Response[SecurityTimelineAPITimelineResponse]
+
+type TimelineGetRequest struct {
+	Params GetTimelineParams
+}
+
+// newTimelineGet returns a function that performs GET /api/timeline API requests
+func (api *API) newTimelineGet() func(context.Context, *TimelineGetRequest, ...RequestOption) (*TimelineGetResponse, error) {
+	return func(ctx context.Context, req *TimelineGetRequest, opts ...RequestOption) (*TimelineGetResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.TemplateTimelineId != nil {
+			params.Set("template_timeline_id", *req.Params.TemplateTimelineId)
+		}
+		if req.Params.Id != nil {
+			params.Set("id", *req.Params.Id)
+		}
+
+		res, err := do[noBody, SecurityTimelineAPITimelineResponse](ctx, api, operation{
+			name:   "timeline.get",
+			method: http.MethodGet,
+			path:   "/api/timeline",
+			query:  params,
+		}, nil, opts)
+		return (*TimelineGetResponse)(res), err
+	}
+}
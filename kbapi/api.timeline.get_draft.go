@@ -0,0 +1,39 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TimelineGetDraftResponse wraps the response from a Timeline.GetDraft call
+type TimelineGetDraftResponse Response[SecurityTimelineAPIPersistTimelineResponse]
+
+type TimelineGetDraftRequest struct {
+	Params GetDraftTimelinesParams
+}
+
+// newTimelineGetDraft returns a function that performs GET /api/timeline/_draft API requests
+func (api *API) newTimelineGetDraft() func(context.Context, *TimelineGetDraftRequest, ...RequestOption) (*TimelineGetDraftResponse, error) {
+	return func(ctx context.Context, req *TimelineGetDraftRequest, opts ...RequestOption) (*TimelineGetDraftResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.TimelineType != "" {
+			params.Set("timelineType", req.Params.TimelineType)
+		}
+
+		res, err := do[noBody, SecurityTimelineAPIPersistTimelineResponse](ctx, api, operation{
+			name:   "timeline.get_draft",
+			method: http.MethodGet,
+			path:   "/api/timeline/_draft",
+			query:  params,
+		}, nil, opts)
+		return (*TimelineGetDraftResponse)(res), err
+	}
+}
@@ -0,0 +1,70 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TimelineGetNotesResponse wraps the response from a Timeline.GetNotes call
+type TimelineGetNotesResponse Response[SecurityTimelineAPIGetNotesResult]
+
+type TimelineGetNotesRequest struct {
+	Params GetNotesParams
+}
+
+// newTimelineGetNotes returns a function that performs GET /api/note API requests
+func (api *API) newTimelineGetNotes() func(context.Context, *TimelineGetNotesRequest, ...RequestOption) (*TimelineGetNotesResponse, error) {
+	return func(ctx context.Context, req *TimelineGetNotesRequest, opts ...RequestOption) (*TimelineGetNotesResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.DocumentIds != nil {
+			for _, id := range req.Params.DocumentIds.GetIDs() {
+				params.Add("documentIds", id)
+			}
+		}
+		if req.Params.SavedObjectIds != nil {
+			for _, id := range req.Params.SavedObjectIds.GetIDs() {
+				params.Add("savedObjectIds", id)
+			}
+		}
+		if req.Params.Page != nil {
+			params.Set("page", *req.Params.Page)
+		}
+		if req.Params.PerPage != nil {
+			params.Set("perPage", *req.Params.PerPage)
+		}
+		if req.Params.Search != nil {
+			params.Set("search", *req.Params.Search)
+		}
+		if req.Params.SortField != nil {
+			params.Set("sortField", *req.Params.SortField)
+		}
+		if req.Params.SortOrder != nil {
+			params.Set("sortOrder", *req.Params.SortOrder)
+		}
+		if req.Params.Filter != nil {
+			params.Set("filter", *req.Params.Filter)
+		}
+		if req.Params.CreatedByFilter != nil {
+			params.Set("createdByFilter", *req.Params.CreatedByFilter)
+		}
+		if req.Params.AssociatedFilter != nil {
+			params.Set("associatedFilter", *req.Params.AssociatedFilter)
+		}
+
+		res, err := do[noBody, SecurityTimelineAPIGetNotesResult](ctx, api, operation{
+			name:   "timeline.get_notes",
+			method: http.MethodGet,
+			path:   "/api/note",
+			query:  params,
+		}, nil, opts)
+		return (*TimelineGetNotesResponse)(res), err
+	}
+}
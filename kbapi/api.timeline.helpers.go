@@ -0,0 +1,207 @@
+package kbapi
+
+import (
+	"encoding/json"
+)
+
+// getIDs decodes a list of IDs sent either as a single ID or as an array of IDs.
+func getIDs(union json.RawMessage) []string {
+	var id string
+	if err := json.Unmarshal(union, &id); err == nil {
+		return []string{id}
+	}
+
+	var ids []string
+	_ = json.Unmarshal(union, &ids)
+	return ids
+}
+
+// GetIDs returns the document IDs.
+func (t SecurityTimelineAPIDocumentIds) GetIDs() []string {
+	return getIDs(t.union)
+}
+
+// SetIDs sets the document IDs.
+func (t *SecurityTimelineAPIDocumentIds) SetIDs(ids ...string) error {
+	union, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	t.union = union
+	return nil
+}
+
+// GetIDs returns the saved object IDs.
+func (t SecurityTimelineAPISavedObjectIds) GetIDs() []string {
+	return getIDs(t.union)
+}
+
+// SetIDs sets the saved object IDs.
+func (t *SecurityTimelineAPISavedObjectIds) SetIDs(ids ...string) error {
+	union, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	t.union = union
+	return nil
+}
+
+func (t SecurityTimelineAPIDocumentIds) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPIDocumentIds) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPIImportTimelines_DateRange_End) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPIImportTimelines_DateRange_End) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPIImportTimelines_DateRange_Start) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPIImportTimelines_DateRange_Start) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPIImportTimelines_EqlOptions_Size) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPIImportTimelines_EqlOptions_Size) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPIQueryMatchResult_Value) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPIQueryMatchResult_Value) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPISavedObjectIds) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPISavedObjectIds) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPISavedTimeline_DateRange_End) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPISavedTimeline_DateRange_End) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPISavedTimeline_DateRange_Start) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPISavedTimeline_DateRange_Start) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPISavedTimeline_EqlOptions_Size) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPISavedTimeline_EqlOptions_Size) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPISavedTimelineWithSavedObjectId_DateRange_End) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPISavedTimelineWithSavedObjectId_DateRange_End) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPISavedTimelineWithSavedObjectId_DateRange_Start) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPISavedTimelineWithSavedObjectId_DateRange_Start) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPISavedTimelineWithSavedObjectId_EqlOptions_Size) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPISavedTimelineWithSavedObjectId_EqlOptions_Size) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPISort) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPISort) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPITimelineResponse_DateRange_End) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPITimelineResponse_DateRange_End) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPITimelineResponse_DateRange_Start) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPITimelineResponse_DateRange_Start) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPITimelineResponse_EqlOptions_Size) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPITimelineResponse_EqlOptions_Size) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPITimelineSavedToReturnObject_DateRange_End) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPITimelineSavedToReturnObject_DateRange_End) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPITimelineSavedToReturnObject_DateRange_Start) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPITimelineSavedToReturnObject_DateRange_Start) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t SecurityTimelineAPITimelineSavedToReturnObject_EqlOptions_Size) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *SecurityTimelineAPITimelineSavedToReturnObject_EqlOptions_Size) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
+
+func (t DeleteNoteJSONBody) MarshalJSON() ([]byte, error) {
+	return marshalUnion(t.union)
+}
+
+func (t *DeleteNoteJSONBody) UnmarshalJSON(b []byte) error {
+	return t.union.UnmarshalJSON(b)
+}
@@ -0,0 +1,66 @@
+package kbapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+)
+
+// TimelineImportResponse wraps the response from a Timeline.Import call
+type TimelineImportResponse Response[SecurityTimelineAPIImportTimelineResult]
+
+type TimelineImportRequestBody struct {
+	// File is the NDJSON content of a timeline export
+	File []byte
+	// IsImmutable marks the imported timelines as immutable
+	IsImmutable *bool
+}
+
+type TimelineImportRequest struct {
+	Body TimelineImportRequestBody
+}
+
+// newTimelineImport returns a function that performs POST /api/timeline/_import API requests
+func (api *API) newTimelineImport() func(context.Context, *TimelineImportRequest, ...RequestOption) (*TimelineImportResponse, error) {
+	return func(ctx context.Context, req *TimelineImportRequest, opts ...RequestOption) (*TimelineImportResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Set up multipart form data
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+
+		part, err := writer.CreateFormFile("file", "timelines_export.ndjson")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create form file: %w", err)
+		}
+
+		if _, err := part.Write(req.Body.File); err != nil {
+			return nil, fmt.Errorf("failed to write data to form: %w", err)
+		}
+
+		if req.Body.IsImmutable != nil {
+			if err := writer.WriteField("isImmutable", strconv.FormatBool(*req.Body.IsImmutable)); err != nil {
+				return nil, fmt.Errorf("failed to write field to form: %w", err)
+			}
+		}
+
+		// Close the multipart writer
+		if err := writer.Close(); err != nil {
+			return nil, fmt.Errorf("failed to close writer: %w", err)
+		}
+
+		res, err := do[noBody, SecurityTimelineAPIImportTimelineResult](ctx, api, operation{
+			name:        "timeline.import",
+			method:      http.MethodPost,
+			path:        "/api/timeline/_import",
+			body:        body,
+			contentType: writer.FormDataContentType(),
+		}, nil, opts)
+		return (*TimelineImportResponse)(res), err
+	}
+}
@@ -0,0 +1,30 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TimelineInstallPrepackagedResponse wraps the response from a Timeline.InstallPrepackaged call
+type TimelineInstallPrepackagedResponse Response[SecurityTimelineAPIImportTimelineResult]
+
+type TimelineInstallPrepackagedRequest struct {
+	Body InstallPrepackedTimelinesJSONRequestBody
+}
+
+// newTimelineInstallPrepackaged returns a function that performs POST /api/timeline/_prepackaged API requests
+func (api *API) newTimelineInstallPrepackaged() func(context.Context, *TimelineInstallPrepackagedRequest, ...RequestOption) (*TimelineInstallPrepackagedResponse, error) {
+	return func(ctx context.Context, req *TimelineInstallPrepackagedRequest, opts ...RequestOption) (*TimelineInstallPrepackagedResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[InstallPrepackedTimelinesJSONRequestBody, SecurityTimelineAPIImportTimelineResult](ctx, api, operation{
+			name:   "timeline.install_prepackaged",
+			method: http.MethodPost,
+			path:   "/api/timeline/_prepackaged",
+		}, &req.Body, opts)
+		return (*TimelineInstallPrepackagedResponse)(res), err
+	}
+}
@@ -0,0 +1,60 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TimelineListResponse wraps the response from a Timeline.List call
+type TimelineListResponse Response[GetTimelinesResponse]
+
+type TimelineListRequest struct {
+	Params GetTimelinesParams
+}
+
+// newTimelineList returns a function that performs GET /api/timelines API requests
+func (api *API) newTimelineList() func(context.Context, *TimelineListRequest, ...RequestOption) (*TimelineListResponse, error) {
+	return func(ctx context.Context, req *TimelineListRequest, opts ...RequestOption) (*TimelineListResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.OnlyUserFavorite != nil {
+			params.Set("only_user_favorite", *req.Params.OnlyUserFavorite)
+		}
+		if req.Params.TimelineType != nil {
+			params.Set("timeline_type", *req.Params.TimelineType)
+		}
+		if req.Params.SortField != nil {
+			params.Set("sort_field", *req.Params.SortField)
+		}
+		if req.Params.SortOrder != nil {
+			params.Set("sort_order", *req.Params.SortOrder)
+		}
+		if req.Params.PageSize != nil {
+			params.Set("page_size", *req.Params.PageSize)
+		}
+		if req.Params.PageIndex != nil {
+			params.Set("page_index", *req.Params.PageIndex)
+		}
+		if req.Params.Search != nil {
+			params.Set("search", *req.Params.Search)
+		}
+		if req.Params.Status != nil {
+			params.Set("status", *req.Params.Status)
+		}
+
+		res, err := do[noBody, GetTimelinesResponse](ctx, api, operation{
+			name:   "timeline.list",
+			method: http.MethodGet,
+			path:   "/api/timelines",
+			query:  params,
+		}, nil, opts)
+		return (*TimelineListResponse)(res), err
+	}
+}
@@ -0,0 +1,42 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TimelinePersistNoteResponse wraps the response from a Timeline.PersistNote call
+type TimelinePersistNoteResponse Response[TimelinePersistNoteResponseBody]
+
+type TimelinePersistNoteResponseBody struct {
+	Note SecurityTimelineAPINote `json:"note"`
+}
+
+type TimelinePersistNoteRequestBody struct {
+	Note SecurityTimelineAPIBareNote `json:"note"`
+	// NoteID is the `savedObjectId` of the note to update, empty to create a new note
+	NoteID *string `json:"noteId,omitempty"`
+	// Version is the current version of the note when updating it
+	Version *string `json:"version,omitempty"`
+}
+
+type TimelinePersistNoteRequest struct {
+	Body TimelinePersistNoteRequestBody
+}
+
+// newTimelinePersistNote returns a function that performs PATCH /api/note API requests
+func (api *API) newTimelinePersistNote() func(context.Context, *TimelinePersistNoteRequest, ...RequestOption) (*TimelinePersistNoteResponse, error) {
+	return func(ctx context.Context, req *TimelinePersistNoteRequest, opts ...RequestOption) (*TimelinePersistNoteResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[TimelinePersistNoteRequestBody, TimelinePersistNoteResponseBody](ctx, api, operation{
+			name:   "timeline.persist_note",
+			method: http.MethodPatch,
+			path:   "/api/note",
+		}, &req.Body, opts)
+		return (*TimelinePersistNoteResponse)(res), err
+	}
+}
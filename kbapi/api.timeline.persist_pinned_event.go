@@ -0,0 +1,46 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TimelinePersistPinnedEventResponse wraps the response from a Timeline.PersistPinnedEvent call
+type TimelinePersistPinnedEventResponse Response[TimelinePersistPinnedEventResponseBody]
+
+// TimelinePersistPinnedEventResponseBody is the pinned event, or only
+// Unpinned set to true when an existing pin was removed.
+type TimelinePersistPinnedEventResponseBody struct {
+	SecurityTimelineAPIPinnedEvent
+	Unpinned *bool `json:"unpinned,omitempty"`
+}
+
+type TimelinePersistPinnedEventRequestBody struct {
+	// EventID is the `_id` of the event to pin or unpin
+	EventID string `json:"eventId"`
+	// PinnedEventID is the `savedObjectId` of an existing pin, set to unpin the event
+	PinnedEventID *string `json:"pinnedEventId,omitempty"`
+	// TimelineID is the `savedObjectId` of the timeline the event belongs to
+	TimelineID string `json:"timelineId"`
+}
+
+type TimelinePersistPinnedEventRequest struct {
+	Body TimelinePersistPinnedEventRequestBody
+}
+
+// newTimelinePersistPinnedEvent returns a function that performs PATCH /api/pinned_event API requests
+func (api *API) newTimelinePersistPinnedEvent() func(context.Context, *TimelinePersistPinnedEventRequest, ...RequestOption) (*TimelinePersistPinnedEventResponse, error) {
+	return func(ctx context.Context, req *TimelinePersistPinnedEventRequest, opts ...RequestOption) (*TimelinePersistPinnedEventResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[TimelinePersistPinnedEventRequestBody, TimelinePersistPinnedEventResponseBody](ctx, api, operation{
+			name:   "timeline.persist_pinned_event",
+			method: http.MethodPatch,
+			path:   "/api/pinned_event",
+		}, &req.Body, opts)
+		return (*TimelinePersistPinnedEventResponse)(res), err
+	}
+}
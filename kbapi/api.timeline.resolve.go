@@ -0,0 +1,42 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TimelineResolveResponse wraps the response from a Timeline.Resolve call
+type TimelineResolveResponse Response[SecurityTimelineAPIResolvedTimeline]
+
+type TimelineResolveRequest struct {
+	Params ResolveTimelineParams
+}
+
+// newTimelineResolve returns a function that performs GET /api/timeline/resolve API requests
+func (api *API) newTimelineResolve() func(context.Context, *TimelineResolveRequest, ...RequestOption) (*TimelineResolveResponse, error) {
+	return func(ctx context.Context, req *TimelineResolveRequest, opts ...RequestOption) (*TimelineResolveResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		// Build query parameters
+		params := url.Values{}
+
+		if req.Params.TemplateTimelineId != nil {
+			params.Set("template_timeline_id", *req.Params.TemplateTimelineId)
+		}
+		if req.Params.Id != nil {
+			params.Set("id", *req.Params.Id)
+		}
+
+		res, err := do[noBody, SecurityTimelineAPIResolvedTimeline](ctx, api, operation{
+			name:   "timeline.resolve",
+			method: http.MethodGet,
+			path:   "/api/timeline/resolve",
+			query:  params,
+		}, nil, opts)
+		return (*TimelineResolveResponse)(res), err
+	}
+}
@@ -0,0 +1,38 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TimelineUpdateResponse wraps the response from a Timeline.Update call
+type TimelineUpdateResponse Response[SecurityTimelineAPIPersistTimelineResponse]
+
+type TimelineUpdateRequestBody struct {
+	// TimelineID is the `savedObjectId` of the timeline to update
+	TimelineID *string `json:"timelineId"`
+	// Version is the current version of the timeline
+	Version  *string                          `json:"version"`
+	Timeline SecurityTimelineAPISavedTimeline `json:"timeline"`
+}
+
+type TimelineUpdateRequest struct {
+	Body TimelineUpdateRequestBody
+}
+
+// newTimelineUpdate returns a function that performs PATCH /api/timeline API requests
+func (api *API) newTimelineUpdate() func(context.Context, *TimelineUpdateRequest, ...RequestOption) (*TimelineUpdateResponse, error) {
+	return func(ctx context.Context, req *TimelineUpdateRequest, opts ...RequestOption) (*TimelineUpdateResponse, error) {
+		if req == nil {
+			return nil, fmt.Errorf("Request cannot be nil")
+		}
+
+		res, err := do[TimelineUpdateRequestBody, SecurityTimelineAPIPersistTimelineResponse](ctx, api, operation{
+			name:   "timeline.update",
+			method: http.MethodPatch,
+			path:   "/api/timeline",
+		}, &req.Body, opts)
+		return (*TimelineUpdateResponse)(res), err
+	}
+}
@@ -0,0 +1,99 @@
+package kbapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Error is returned by every endpoint when Kibana answers with a non-success
+// status code. Use errors.As to retrieve it, or one of the Is* helpers to test
+// for a specific status code.
+type Error struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+	// Reason is the short error description returned by Kibana, e.g. "Not Found".
+	Reason string `json:"error"`
+	// Message is the detailed error message returned by Kibana.
+	Message string `json:"message"`
+	// Attributes holds additional error details returned by some APIs.
+	Attributes map[string]any `json:"attributes,omitempty"`
+
+	// Method is the HTTP method of the failed request.
+	Method string `json:"-"`
+	// Path is the URL path of the failed request.
+	Path string `json:"-"`
+	// Body is the raw response body.
+	Body []byte `json:"-"`
+}
+
+// newError builds an Error from a non-success response.
+func newError(req *http.Request, statusCode int, body []byte) *Error {
+	e := &Error{}
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		// Kibana returns {"statusCode":404,"error":"Not Found","message":"..."}
+		// for most APIs; anything else is only kept in Body.
+		_ = json.Unmarshal(trimmed, e)
+	} else {
+		e.Message = string(trimmed)
+	}
+
+	e.StatusCode = statusCode
+	e.Body = body
+	if req != nil {
+		e.Method = req.Method
+		if req.URL != nil {
+			e.Path = req.URL.Path
+		}
+	}
+
+	return e
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	var detail string
+	switch {
+	case e.Reason != "" && e.Message != "":
+		detail = fmt.Sprintf("%s: %s", e.Reason, e.Message)
+	case e.Message != "":
+		detail = e.Message
+	case e.Reason != "":
+		detail = e.Reason
+	default:
+		detail = string(bytes.TrimSpace(e.Body))
+	}
+
+	if e.Method == "" && e.Path == "" {
+		return fmt.Sprintf("HTTP Status Code %d: %s", e.StatusCode, detail)
+	}
+	return fmt.Sprintf("HTTP Status Code %d: %s [%s %s]", e.StatusCode, detail, e.Method, e.Path)
+}
+
+// StatusCodeOf returns the HTTP status code carried by err,
+// or 0 when err does not wrap an *Error.
+func StatusCodeOf(err error) int {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.StatusCode
+	}
+	return 0
+}
+
+// IsBadRequest reports whether err is a Kibana 400 Bad Request error.
+func IsBadRequest(err error) bool { return StatusCodeOf(err) == http.StatusBadRequest }
+
+// IsUnauthorized reports whether err is a Kibana 401 Unauthorized error.
+func IsUnauthorized(err error) bool { return StatusCodeOf(err) == http.StatusUnauthorized }
+
+// IsForbidden reports whether err is a Kibana 403 Forbidden error.
+func IsForbidden(err error) bool { return StatusCodeOf(err) == http.StatusForbidden }
+
+// IsNotFound reports whether err is a Kibana 404 Not Found error.
+func IsNotFound(err error) bool { return StatusCodeOf(err) == http.StatusNotFound }
+
+// IsConflict reports whether err is a Kibana 409 Conflict error.
+func IsConflict(err error) bool { return StatusCodeOf(err) == http.StatusConflict }
@@ -0,0 +1,81 @@
+package kbapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestError(t *testing.T) {
+	testCases := []struct {
+		name         string
+		statusCode   int
+		responseBody string
+		reason       string
+		message      string
+		check        func(error) bool
+	}{
+		{
+			name:         "Not found",
+			statusCode:   404,
+			responseBody: `{"statusCode":404,"error":"Not Found","message":"Could not find rule abc"}`,
+			reason:       "Not Found",
+			message:      "Could not find rule abc",
+			check:        IsNotFound,
+		},
+		{
+			name:         "Conflict",
+			statusCode:   409,
+			responseBody: `{"statusCode":409,"error":"Conflict","message":"Rule abc already exists"}`,
+			reason:       "Conflict",
+			message:      "Rule abc already exists",
+			check:        IsConflict,
+		},
+		{
+			name:         "Forbidden",
+			statusCode:   403,
+			responseBody: `{"statusCode":403,"error":"Forbidden","message":"Unauthorized to get rule"}`,
+			reason:       "Forbidden",
+			message:      "Unauthorized to get rule",
+			check:        IsForbidden,
+		},
+		{
+			name:         "Non JSON body",
+			statusCode:   401,
+			responseBody: "Unauthorized",
+			message:      "Unauthorized",
+			check:        IsUnauthorized,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			mockTransport := NewMockTransportWithRawResponse(test.statusCode, test.responseBody, nil)
+			api := New(mockTransport)
+
+			resp, err := api.Alerting.Get(context.Background(), &AlertingGetRequest{ID: "abc"})
+			require.Error(t, err)
+			assert.True(t, test.check(err))
+
+			var kbErr *Error
+			require.True(t, errors.As(fmt.Errorf("wrapped: %w", err), &kbErr))
+			assert.Equal(t, test.statusCode, kbErr.StatusCode)
+			assert.Equal(t, test.reason, kbErr.Reason)
+			assert.Equal(t, test.message, kbErr.Message)
+			assert.Equal(t, http.MethodGet, kbErr.Method)
+			assert.Equal(t, "/api/alerting/rule/abc", kbErr.Path)
+			assert.Equal(t, test.responseBody, string(kbErr.Body))
+
+			require.NotNil(t, resp)
+			assert.Equal(t, kbErr, resp.Error)
+		})
+	}
+
+	assert.False(t, IsNotFound(errors.New("plain error")))
+	assert.Equal(t, 0, StatusCodeOf(nil))
+}
@@ -0,0 +1,125 @@
+package kbapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Feature is a set of endpoints that is not available on every Kibana deployment.
+type Feature string
+
+// Features gated by version or build flavor.
+const (
+	FeatureAlertingBackfill       Feature = "alerting_backfill"
+	FeatureAlertingSnoozeSchedule Feature = "alerting_snooze_schedule"
+	FeatureAssetCriticality       Feature = "asset_criticality"
+	FeatureEntityStore            Feature = "entity_store"
+	FeatureFleetServerManagement  Feature = "fleet_server_management"
+	FeatureLogstashPipelines      Feature = "logstash_pipelines"
+	FeatureMaintenanceWindows     Feature = "maintenance_windows"
+	FeatureSLODefinitions         Feature = "slo_definitions"
+	FeatureSLOs                   Feature = "slos"
+	FeatureStreams                Feature = "streams"
+	FeatureSynthetics             Feature = "synthetics"
+	FeatureTaskManagerHealth      Feature = "task_manager_health"
+	FeatureUptimeSettings         Feature = "uptime_settings"
+)
+
+// Requirement is the deployment a Feature needs.
+type Requirement struct {
+	// MinVersion The first version with the feature, any version when zero.
+	MinVersion Version
+	// Flavors The build flavors with the feature, every flavor when empty.
+	Flavors []string
+}
+
+// satisfiedBy reports whether d meets the requirement. Serverless projects
+// have no version to compare with, only the build flavor is checked.
+func (r Requirement) satisfiedBy(d *Deployment) bool {
+	if len(r.Flavors) > 0 && !slices.Contains(r.Flavors, d.BuildFlavor) {
+		return false
+	}
+	return d.Serverless() || d.Version.AtLeast(r.MinVersion)
+}
+
+// Requirements lists the deployment every Feature needs.
+var Requirements = map[Feature]Requirement{
+	FeatureAlertingBackfill:       {MinVersion: MustParseVersion("8.19.0")},
+	FeatureAlertingSnoozeSchedule: {MinVersion: MustParseVersion("8.19.0")},
+	FeatureAssetCriticality:       {MinVersion: MustParseVersion("8.15.0")},
+	FeatureEntityStore:            {MinVersion: MustParseVersion("8.16.0")},
+	FeatureMaintenanceWindows:     {MinVersion: MustParseVersion("8.8.0")},
+	FeatureSLODefinitions:         {MinVersion: MustParseVersion("8.14.0")},
+	FeatureSLOs:                   {MinVersion: MustParseVersion("8.12.0")},
+	FeatureStreams:                {MinVersion: MustParseVersion("9.1.0")},
+	FeatureSynthetics:             {MinVersion: MustParseVersion("8.15.0")},
+	// Serverless projects come with a managed Fleet Server
+	FeatureFleetServerManagement: {Flavors: []string{BuildFlavorTraditional}},
+	FeatureLogstashPipelines:     {Flavors: []string{BuildFlavorTraditional}},
+	FeatureTaskManagerHealth:     {Flavors: []string{BuildFlavorTraditional}},
+	FeatureUptimeSettings:        {Flavors: []string{BuildFlavorTraditional}},
+}
+
+// Supports reports whether the deployment d has feature. Unknown features
+// are reported as supported.
+func Supports(d *Deployment, feature Feature) bool {
+	requirement, ok := Requirements[feature]
+	return !ok || requirement.satisfiedBy(d)
+}
+
+// ErrUnsupportedVersion is wrapped by the UnsupportedVersionError returned
+// by endpoints that the Kibana deployment does not have.
+var ErrUnsupportedVersion = errors.New("not supported by this Kibana deployment")
+
+// UnsupportedVersionError is returned without sending the request when an
+// endpoint belongs to a Feature that the Kibana deployment does not have.
+// It matches ErrUnsupportedVersion with errors.Is.
+type UnsupportedVersionError struct {
+	// Operation The name of the endpoint, e.g. streams.list.
+	Operation   string
+	Feature     Feature
+	Requirement Requirement
+	Deployment  Deployment
+}
+
+// Error implements the error interface.
+func (e *UnsupportedVersionError) Error() string {
+	if e.Deployment.Serverless() {
+		return fmt.Sprintf("%s: %s is not available on serverless projects", e.Operation, e.Feature)
+	}
+	if len(e.Requirement.Flavors) > 0 && !slices.Contains(e.Requirement.Flavors, e.Deployment.BuildFlavor) {
+		return fmt.Sprintf("%s: %s is not available on %s deployments", e.Operation, e.Feature, e.Deployment.BuildFlavor)
+	}
+	return fmt.Sprintf("%s: %s requires Kibana %s or later, the deployment runs %s", e.Operation, e.Feature, e.Requirement.MinVersion, e.Deployment.Version)
+}
+
+// Unwrap returns ErrUnsupportedVersion.
+func (e *UnsupportedVersionError) Unwrap() error {
+	return ErrUnsupportedVersion
+}
+
+// checkOperation returns an *UnsupportedVersionError when the transport
+// detects a deployment that does not have the feature of the operation.
+// The request is sent anyway when the deployment cannot be detected, so that
+// Kibana reports the actual error.
+func checkOperation(ctx context.Context, api *API, op operation) error {
+	if op.feature == "" {
+		return nil
+	}
+	detector, ok := api.transport.(DeploymentDetector)
+	if !ok {
+		return nil
+	}
+	deployment, err := detector.Deployment(ctx)
+	if err != nil || deployment == nil {
+		return nil
+	}
+
+	requirement, ok := Requirements[op.feature]
+	if !ok || requirement.satisfiedBy(deployment) {
+		return nil
+	}
+	return &UnsupportedVersionError{Operation: op.name, Feature: op.feature, Requirement: requirement, Deployment: *deployment}
+}
@@ -0,0 +1,145 @@
+package kbapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// detectingTransport is a transport that reports a fixed deployment
+type detectingTransport struct {
+	funcTransport
+	deployment *Deployment
+	err        error
+}
+
+func (d detectingTransport) Deployment(context.Context) (*Deployment, error) {
+	return d.deployment, d.err
+}
+
+func TestParseVersion(t *testing.T) {
+	for input, want := range map[string]Version{
+		"9.1.0":          {Major: 9, Minor: 1},
+		"8.19.3":         {Major: 8, Minor: 19, Patch: 3},
+		"9.2.0-SNAPSHOT": {Major: 9, Minor: 2},
+		"8.17":           {Major: 8, Minor: 17},
+	} {
+		got, err := ParseVersion(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, want, got, input)
+	}
+
+	for _, input := range []string{"", "9.x.0", "1.2.3.4"} {
+		_, err := ParseVersion(input)
+		assert.Error(t, err, input)
+	}
+
+	assert.True(t, MustParseVersion("8.19.0").AtLeast(MustParseVersion("8.8.0")))
+	assert.False(t, MustParseVersion("8.18.9").AtLeast(MustParseVersion("8.19.0")))
+}
+
+func TestCheckOperation(t *testing.T) {
+	var requests int
+	send := funcTransport(func(req *http.Request) (*http.Response, error) {
+		requests++
+		return jsonResponse(t, 200, map[string]interface{}{"streams": []interface{}{}}), nil
+	})
+
+	tests := []struct {
+		name        string
+		deployment  *Deployment
+		err         error
+		unsupported bool
+	}{
+		{name: "older version", deployment: &Deployment{Version: MustParseVersion("8.17.0"), BuildFlavor: BuildFlavorTraditional}, unsupported: true},
+		{name: "minimum version", deployment: &Deployment{Version: MustParseVersion("9.1.0"), BuildFlavor: BuildFlavorTraditional}},
+		{name: "serverless", deployment: &Deployment{Version: MustParseVersion("8.11.0"), BuildFlavor: BuildFlavorServerless}},
+		{name: "detection failed", err: errors.New("forbidden")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = 0
+			api := New(detectingTransport{funcTransport: send, deployment: tt.deployment, err: tt.err})
+
+			_, err := api.Streams.List(context.Background())
+			if !tt.unsupported {
+				require.NoError(t, err)
+				assert.Equal(t, 1, requests)
+				return
+			}
+
+			require.ErrorIs(t, err, ErrUnsupportedVersion)
+			var unsupported *UnsupportedVersionError
+			require.ErrorAs(t, err, &unsupported)
+			assert.Equal(t, "streams.list", unsupported.Operation)
+			assert.Equal(t, FeatureStreams, unsupported.Feature)
+			assert.EqualError(t, err, "streams.list: streams requires Kibana 9.1.0 or later, the deployment runs 8.17.0")
+			assert.Zero(t, requests)
+		})
+	}
+}
+
+func TestCheckOperation_Serverless(t *testing.T) {
+	api := New(detectingTransport{
+		funcTransport: func(req *http.Request) (*http.Response, error) {
+			t.Fatalf("unexpected request %s", req.URL.Path)
+			return nil, nil
+		},
+		deployment: &Deployment{BuildFlavor: BuildFlavorServerless},
+	})
+
+	_, err := api.Logstash.List(context.Background())
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+	assert.EqualError(t, err, "logstash.list: logstash_pipelines is not available on serverless projects")
+}
+
+func TestCheckOperation_Features(t *testing.T) {
+	var requests int
+	api := New(detectingTransport{
+		funcTransport: func(req *http.Request) (*http.Response, error) {
+			requests++
+			return jsonResponse(t, 200, map[string]interface{}{}), nil
+		},
+		deployment: &Deployment{Version: MustParseVersion("8.7.0"), BuildFlavor: BuildFlavorTraditional},
+	})
+	ctx := context.Background()
+
+	tests := []struct {
+		feature Feature
+		call    func() error
+	}{
+		{FeatureAlertingBackfill, func() error {
+			_, err := api.Alerting.Backfill.Find(ctx, &AlertingBackfillFindRequest{})
+			return err
+		}},
+		{FeatureMaintenanceWindows, func() error {
+			_, err := api.MaintenanceWindows.Find(ctx, &MaintenanceWindowsFindRequest{})
+			return err
+		}},
+		{FeatureSLODefinitions, func() error {
+			_, err := api.SLOs.FindDefinitions(ctx, &SLOsFindDefinitionsRequest{})
+			return err
+		}},
+		{FeatureSynthetics, func() error {
+			_, err := api.Synthetics.Monitors.List(ctx, &SyntheticsMonitorsListRequest{})
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.feature), func(t *testing.T) {
+			var unsupported *UnsupportedVersionError
+			require.ErrorAs(t, tt.call(), &unsupported)
+			assert.Equal(t, tt.feature, unsupported.Feature)
+		})
+	}
+	assert.Zero(t, requests)
+
+	// Endpoints without a feature are always sent
+	_, err := api.Alerting.Get(ctx, &AlertingGetRequest{ID: "rule-1"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+}
@@ -0,0 +1,175 @@
+package kbapi
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// NDJSONStream reads the objects of an NDJSON export of type T as they are
+// received, without buffering the export. The summary Kibana appends to the
+// export is decoded into D and returned by Details once all objects are read.
+// The stream must be closed.
+type NDJSONStream[T, D any] struct {
+	body       io.ReadCloser
+	reader     *bufio.Reader
+	detailsKey string
+	details    *D
+	consumed   bool
+}
+
+// newNDJSONStream returns a stream reading body. The last line is decoded as
+// the export details when it has the detailsKey field.
+func newNDJSONStream[T, D any](body io.ReadCloser, detailsKey string) *NDJSONStream[T, D] {
+	return &NDJSONStream[T, D]{body: body, reader: bufio.NewReader(body), detailsKey: detailsKey}
+}
+
+// Objects returns an iterator over the exported objects. The export can only
+// be iterated once, the iterator stops at the first error.
+func (s *NDJSONStream[T, D]) Objects() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		if s.consumed {
+			yield(zero, errors.New("the export has already been read"))
+			return
+		}
+		s.consumed = true
+
+		// Hold back one line, the last one may be the export details
+		var pending []byte
+		for {
+			line, err := s.readLine()
+			if err != nil && !errors.Is(err, io.EOF) {
+				yield(zero, fmt.Errorf("failed to read export: %w", err))
+				return
+			}
+			if line == nil {
+				break
+			}
+
+			if pending != nil {
+				if !s.yieldObject(pending, yield) {
+					return
+				}
+			}
+			pending = line
+		}
+
+		if pending == nil {
+			return
+		}
+		details, err := decodeExportDetails[D](pending, s.detailsKey)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		if details != nil {
+			s.details = details
+			return
+		}
+		s.yieldObject(pending, yield)
+	}
+}
+
+// Details returns the export details, or nil when they were excluded from
+// the export or the objects were not read yet.
+func (s *NDJSONStream[T, D]) Details() *D {
+	return s.details
+}
+
+// Close closes the response body.
+func (s *NDJSONStream[T, D]) Close() error {
+	return s.body.Close()
+}
+
+// readLine returns the next non-empty line, or nil at the end of the export.
+func (s *NDJSONStream[T, D]) readLine() ([]byte, error) {
+	for {
+		line, err := s.reader.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+		line = bytes.TrimPrefix(line, []byte(","))
+		if len(line) > 0 {
+			return line, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (s *NDJSONStream[T, D]) yieldObject(line []byte, yield func(T, error) bool) bool {
+	var obj T
+	if err := json.Unmarshal(line, &obj); err != nil {
+		yield(obj, fmt.Errorf("failed to decode exported object: %w", err))
+		return false
+	}
+	return yield(obj, nil)
+}
+
+// decodeExportDetails decodes line into D when it has the key field, and
+// returns nil otherwise.
+func decodeExportDetails[D any](line []byte, key string) (*D, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(line, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode exported object: %w", err)
+	}
+	if _, ok := fields[key]; !ok {
+		return nil, nil
+	}
+
+	var details D
+	if err := json.Unmarshal(line, &details); err != nil {
+		return nil, fmt.Errorf("failed to decode export details: %w", err)
+	}
+	return &details, nil
+}
+
+// exportWriter passes an export through to w, except for its last line,
+// which is held back until the export is written. The last line is only
+// written to w when it is not the export details, see exportWriterDetails.
+type exportWriter struct {
+	w io.Writer
+	// pending holds the last non-empty line written so far and what follows it
+	pending []byte
+}
+
+func (e *exportWriter) Write(p []byte) (int, error) {
+	e.pending = append(e.pending, p...)
+
+	// Pass through every line before the last non-empty one
+	rest := bytes.TrimRight(e.pending, " \t\r\n")
+	cut := bytes.LastIndexByte(rest, '\n') + 1
+	if cut == 0 {
+		return len(p), nil
+	}
+	if _, err := e.w.Write(e.pending[:cut]); err != nil {
+		return 0, err
+	}
+	e.pending = append(e.pending[:0], e.pending[cut:]...)
+	return len(p), nil
+}
+
+// exportWriterDetails decodes the last line written to e into D when it has
+// the key field. Otherwise the line is an exported object, it is written to
+// the output of e and nil is returned.
+func exportWriterDetails[D any](e *exportWriter, key string) (*D, error) {
+	pending := e.pending
+	e.pending = nil
+	line := bytes.TrimSpace(pending)
+	if len(line) == 0 {
+		return nil, nil
+	}
+
+	details, err := decodeExportDetails[D](line, key)
+	if details != nil {
+		return details, nil
+	}
+	if _, werr := e.w.Write(pending); werr != nil {
+		return nil, werr
+	}
+	return nil, err
+}
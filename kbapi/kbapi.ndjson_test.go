@@ -0,0 +1,129 @@
+package kbapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSavedObjectExport = `{"id":"dash-1","type":"dashboard","attributes":{"title":"Overview"},"references":[]}
+{"id":"vis-1","type":"visualization","attributes":{"title":"Requests"},"references":[]}
+{"excludedObjects":[],"excludedObjectsCount":0,"exportedCount":2,"missingRefCount":1,"missingReferences":[{"id":"logs-*","type":"index-pattern"}]}
+`
+
+func TestSavedObjects_ExportStream(t *testing.T) {
+	mockTransport := NewMockTransportWithRawResponse(200, testSavedObjectExport, nil)
+	api := New(mockTransport)
+
+	resp, err := api.SavedObjects.ExportStream(context.Background(), &SavedObjectExportRequest{
+		Body: SavedObjectExportRequestBody{Objects: []Object{{Type: "dashboard", ID: "dash-1"}}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Stream)
+	defer resp.Stream.Close()
+
+	var ids []string
+	for obj, err := range resp.Stream.Objects() {
+		require.NoError(t, err)
+		ids = append(ids, obj.Type+"/"+obj.ID)
+	}
+	assert.Equal(t, []string{"dashboard/dash-1", "visualization/vis-1"}, ids)
+
+	details := resp.Stream.Details()
+	require.NotNil(t, details)
+	assert.Equal(t, 2, details.ExportedCount)
+	assert.Equal(t, []Object{{ID: "logs-*", Type: "index-pattern"}}, details.MissingReferences)
+	AssertRequestPath(t, mockTransport.LastRequest(), "/api/saved_objects/_export")
+}
+
+func TestSavedObjects_ExportStreamWithoutDetails(t *testing.T) {
+	api := New(NewMockTransportWithRawResponse(200, `{"id":"dash-1","type":"dashboard","attributes":{}}`, nil))
+
+	resp, err := api.SavedObjects.ExportStream(context.Background(), &SavedObjectExportRequest{})
+	require.NoError(t, err)
+	defer resp.Stream.Close()
+
+	var count int
+	for _, err := range resp.Stream.Objects() {
+		require.NoError(t, err)
+		count++
+	}
+	assert.Equal(t, 1, count)
+	assert.Nil(t, resp.Stream.Details())
+}
+
+func TestSavedObjects_ExportStreamError(t *testing.T) {
+	api := New(NewMockTransportWithRawResponse(400, `{"statusCode":400,"error":"Bad Request","message":"Trying to export non-exportable type(s): foo"}`, nil))
+
+	resp, err := api.SavedObjects.ExportStream(context.Background(), &SavedObjectExportRequest{})
+	require.Error(t, err)
+	assert.True(t, IsBadRequest(err))
+	assert.Nil(t, resp.Stream)
+	assert.NotNil(t, resp.RawBody)
+}
+
+func TestSecurityDetections_ExportRulesTo(t *testing.T) {
+	export := `{"rule_id":"rule-1","name":"Rule 1"}
+{"exported_count":1,"exported_rules_count":1,"missing_rules":[{"rule_id":"rule-2"}],"missing_rules_count":1}
+`
+	mockTransport := NewMockTransportWithRawResponse(200, export, nil)
+	api := New(mockTransport)
+
+	var out bytes.Buffer
+	resp, err := api.SecurityDetections.ExportRulesTo(context.Background(), &SecurityDetectionsExportRulesToRequest{
+		Params: SecurityDetectionsExportRulesRequestParams{ExcludeExportDetails: BoolPtr(false)},
+		Output: &out,
+	})
+	require.NoError(t, err)
+	// The export details are not written to Output, so that it can be imported again
+	assert.Equal(t, "{\"rule_id\":\"rule-1\",\"name\":\"Rule 1\"}\n", out.String())
+	require.NotNil(t, resp.Details)
+	assert.Equal(t, 1, resp.Details.ExportedRulesCount)
+	require.Len(t, resp.Details.MissingRules, 1)
+	assert.Equal(t, "rule-2", resp.Details.MissingRules[0].RuleID)
+	AssertRequestParam(t, mockTransport.LastRequest(), "exclude_export_details", "false")
+}
+
+func TestExportWriter(t *testing.T) {
+	// Write the export one byte at a time, with and without a trailing newline
+	for _, export := range []string{testSavedObjectExport, testSavedObjectExport[:len(testSavedObjectExport)-1], testSavedObjectExport + "\n\n"} {
+		var out bytes.Buffer
+		w := &exportWriter{w: &out}
+		_, err := io.Copy(w, iotest.OneByteReader(bytes.NewReader([]byte(export))))
+		require.NoError(t, err)
+
+		details, err := exportWriterDetails[SavedObjectExportDetails](w, "exportedCount")
+		require.NoError(t, err)
+		require.NotNil(t, details)
+		assert.Equal(t, 1, details.MissingRefCount)
+		assert.Equal(t, testSavedObjectExport[:strings.Index(testSavedObjectExport, `{"excludedObjects"`)], out.String())
+	}
+
+	// Without export details, the last object is written once the export is done
+	var out bytes.Buffer
+	w := &exportWriter{w: &out}
+	_, err := w.Write([]byte("{\"id\":\"1\"}\n{\"id\":\"2\"}\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "{\"id\":\"1\"}\n", out.String())
+	details, err := exportWriterDetails[SavedObjectExportDetails](w, "exportedCount")
+	require.NoError(t, err)
+	assert.Nil(t, details)
+	assert.Equal(t, "{\"id\":\"1\"}\n{\"id\":\"2\"}\n", out.String())
+}
+
+func TestNDJSONStream_ReadOnce(t *testing.T) {
+	stream := newNDJSONStream[json.RawMessage, SavedObjectExportDetails](io.NopCloser(bytes.NewReader([]byte(testSavedObjectExport))), "exportedCount")
+	for _, err := range stream.Objects() {
+		require.NoError(t, err)
+	}
+	for _, err := range stream.Objects() {
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,95 @@
+package kbapi
+
+import (
+	"context"
+	"iter"
+)
+
+// DefaultPerPage is the page size used by iterators when the request does not set one.
+const DefaultPerPage = 100
+
+// Page is a single page of results returned by a paginated endpoint.
+type Page[T any] struct {
+	Items   []T
+	Page    int
+	PerPage int
+	// Total is the total number of items matching the request, as reported by Kibana.
+	Total int
+}
+
+// pageFunc fetches a single page of results.
+type pageFunc[T any] func(ctx context.Context, page, perPage int) (*Page[T], error)
+
+// pages returns an iterator that fetches successive pages starting at start
+// until every item reported by Kibana has been returned, a short page is
+// received, fetch fails or ctx is done. Errors are yielded once and end the
+// iteration.
+func pages[T any](ctx context.Context, start, perPage int, fetch pageFunc[T]) iter.Seq2[*Page[T], error] {
+	if start < 1 {
+		start = 1
+	}
+	if perPage < 1 {
+		perPage = DefaultPerPage
+	}
+
+	return func(yield func(*Page[T], error) bool) {
+		page := start
+		seen := (page - 1) * perPage
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			p, err := fetch(ctx, page, perPage)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if !yield(p, nil) {
+				return
+			}
+
+			seen += len(p.Items)
+			if len(p.Items) == 0 || len(p.Items) < perPage || (p.Total > 0 && seen >= p.Total) {
+				return
+			}
+			page++
+		}
+	}
+}
+
+// items flattens a page iterator into an iterator over the individual items.
+func items[T any](seq iter.Seq2[*Page[T], error]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p, err := range seq {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range p.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// intValue returns the value of p, or def when p is nil.
+func intValue(p *int, def int) int {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// float32Value returns the value of p as an int, or def when p is nil.
+func float32Value(p *float32, def int) int {
+	if p == nil {
+		return def
+	}
+	return int(*p)
+}
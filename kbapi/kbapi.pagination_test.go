@@ -0,0 +1,142 @@
+package kbapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// funcTransport implements the Transport interface with a function
+type funcTransport func(req *http.Request) (*http.Response, error)
+
+func (f funcTransport) Perform(req *http.Request) (*http.Response, error) { return f(req) }
+
+func jsonResponse(t *testing.T, statusCode int, body interface{}) *http.Response {
+	t.Helper()
+	b, err := json.Marshal(body)
+	require.NoError(t, err)
+	return &http.Response{StatusCode: statusCode, Body: io.NopCloser(bytes.NewReader(b)), Header: http.Header{}}
+}
+
+func TestAlerting_All(t *testing.T) {
+	rules := []AlertingResponseBase{{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}, {ID: "5"}}
+
+	var requested []string
+	api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+		q := req.URL.Query()
+		requested = append(requested, q.Get("page"))
+		page, _ := strconv.Atoi(q.Get("page"))
+		perPage, _ := strconv.Atoi(q.Get("per_page"))
+		from := min((page-1)*perPage, len(rules))
+		to := min(from+perPage, len(rules))
+		return jsonResponse(t, 200, AlertingListResponseBody{Data: rules[from:to], Page: page, PerPage: perPage, Total: len(rules)}), nil
+	}))
+
+	req := &AlertingListRequest{Params: AlertingListRequestParams{PerPage: IntPtr(2)}}
+
+	var ids []string
+	for rule, err := range api.Alerting.All(context.Background(), req) {
+		require.NoError(t, err)
+		ids = append(ids, rule.ID)
+	}
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, ids)
+	assert.Equal(t, []string{"1", "2", "3"}, requested)
+
+	// Breaking out early stops fetching pages
+	requested = nil
+	for rule, err := range api.Alerting.All(context.Background(), req) {
+		require.NoError(t, err)
+		if rule.ID == "2" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"1"}, requested)
+
+	for p, err := range api.Alerting.Pages(context.Background(), req) {
+		require.NoError(t, err)
+		assert.Equal(t, 5, p.Total)
+	}
+}
+
+func TestAlerting_AllStopsOnError(t *testing.T) {
+	calls := 0
+	api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 2 {
+			return jsonResponse(t, 500, map[string]interface{}{"statusCode": 500, "error": "Internal Server Error"}), nil
+		}
+		return jsonResponse(t, 200, AlertingListResponseBody{Data: []AlertingResponseBase{{ID: "1"}}, PerPage: 1, Total: 3}), nil
+	}))
+
+	var errs []error
+	count := 0
+	for _, err := range api.Alerting.All(context.Background(), &AlertingListRequest{Params: AlertingListRequestParams{PerPage: IntPtr(1)}}) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		count++
+	}
+	assert.Equal(t, 1, count)
+	require.Len(t, errs, 1)
+	assert.Equal(t, 500, StatusCodeOf(errs[0]))
+	assert.Equal(t, 2, calls)
+}
+
+func TestAlerting_AllContextCanceled(t *testing.T) {
+	api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+		t.Fatal("no request should be performed")
+		return nil, nil
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for _, err := range api.Alerting.All(ctx, nil) {
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+}
+
+func TestAgents_AllSearchAfter(t *testing.T) {
+	var queries []map[string]string
+	api := New(funcTransport(func(req *http.Request) (*http.Response, error) {
+		q := req.URL.Query()
+		queries = append(queries, map[string]string{"page": q.Get("page"), "searchAfter": q.Get("searchAfter")})
+
+		body := FleetListAgentsResponseBody{PerPage: 2, Total: 3}
+		switch q.Get("searchAfter") {
+		case "":
+			body.Items = []FleetListAgentsResponseBodyItem{{Id: "a"}, {Id: "b"}}
+			body.NextSearchAfter = StrPtr(`[2,"b"]`)
+		case `[2,"b"]`:
+			body.Items = []FleetListAgentsResponseBodyItem{{Id: "c"}}
+		}
+		return jsonResponse(t, 200, body), nil
+	}))
+
+	req := &FleetListAgentsRequest{Params: FleetListAgentsRequestParams{PerPage: Float32Ptr(2)}}
+	all := api.Fleet.Agents.All(context.Background(), req)
+
+	// Ranging twice starts from the first page both times
+	for range 2 {
+		queries = nil
+		var ids []string
+		for agent, err := range all {
+			require.NoError(t, err)
+			ids = append(ids, agent.Id)
+		}
+		assert.Equal(t, []string{"a", "b", "c"}, ids)
+		assert.Equal(t, []map[string]string{
+			{"page": "1", "searchAfter": ""},
+			{"page": "", "searchAfter": `[2,"b"]`},
+		}, queries)
+	}
+	assert.Nil(t, req.Params.SearchAfter)
+}
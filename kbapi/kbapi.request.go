@@ -0,0 +1,286 @@
+package kbapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Response wraps the result of a Kibana API call.
+type Response[T any] struct {
+	StatusCode int
+	Header     http.Header
+	Body       *T
+	Error      *Error
+	RawBody    io.ReadCloser
+}
+
+// internalOrigin is sent as x-elastic-internal-origin with requests to internal APIs.
+const internalOrigin = "go-kibana"
+
+// noBody is the request or response type of operations without a JSON payload.
+type noBody struct{}
+
+// operation describes a single Kibana API request performed by do.
+type operation struct {
+	// name is the instrumentation span name, e.g. "alerting.get".
+	name string
+	// feature is the Feature the endpoint belongs to, empty when every
+	// deployment has the endpoint.
+	feature Feature
+	method  string
+	path    string
+	query   url.Values
+	// body is a raw request payload sent instead of the JSON encoded request body.
+	body        io.Reader
+	contentType string
+	// getBody returns a fresh copy of body, so that a streamed body can be sent
+	// again on retries without being buffered by the transport.
+	getBody func() (io.ReadCloser, error)
+	// output receives a successful response body as it is read, instead of it
+	// being buffered and decoded.
+	output io.Writer
+	// stream leaves a successful response body unread in Response.RawBody,
+	// the caller reads and closes it.
+	stream bool
+}
+
+// do performs op and decodes a successful response into a Response[Resp].
+// A non-nil body is JSON encoded and sent as the request payload. Any
+// non-2xx response is returned as an *Error, both in Response.Error and as
+// the error value.
+//
+// Every endpoint goes through do, so it is the single place where request
+// options, spaces, instrumentation and error handling are applied.
+func do[Req, Resp any](ctx context.Context, api *API, op operation, body *Req, opts []RequestOption) (*Response[Resp], error) {
+	var instrument Instrumentation
+	if i, ok := api.transport.(Instrumented); ok {
+		instrument = i.InstrumentationEnabled()
+	}
+
+	if instrument != nil {
+		ctx = instrument.Start(ctx, op.name)
+		defer instrument.Close(ctx)
+	}
+
+	resp, err := perform[Req, Resp](ctx, api, instrument, op, body, opts)
+	if err != nil && instrument != nil {
+		instrument.RecordError(ctx, err)
+	}
+	return resp, err
+}
+
+// perform builds, sends and decodes the request described by op.
+func perform[Req, Resp any](ctx context.Context, api *API, instrument Instrumentation, op operation, body *Req, opts []RequestOption) (*Response[Resp], error) {
+	// Fail fast on endpoints the deployment does not have
+	if err := checkOperation(ctx, api, op); err != nil {
+		return nil, err
+	}
+
+	// Let the transport know the endpoint, e.g. to limit the rate per API group
+	httpReq, err := newRequest(context.WithValue(ctx, operationKey{}, op.name), op, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply all the functional options
+	for _, opt := range opts {
+		if err := opt(httpReq); err != nil {
+			return nil, err
+		}
+	}
+
+	// A space set with WithSpace takes precedence over the bound space
+	if !hasSpace(httpReq) {
+		setSpacePath(httpReq, api.space)
+	}
+
+	// Pre-request instrumentation
+	if instrument != nil {
+		instrument.BeforeRequest(httpReq, op.name)
+		if reader := instrument.RecordRequestBody(ctx, op.name, httpReq.Body); reader != nil {
+			httpReq.Body = reader
+		}
+	}
+
+	httpResp, err := api.transport.Perform(httpReq)
+
+	if instrument != nil {
+		instrument.AfterRequest(httpReq, "kibana", op.path)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Stream a successful response to op.output instead of buffering it
+	if op.output != nil && httpResp.StatusCode >= 200 && httpResp.StatusCode <= 299 {
+		defer httpResp.Body.Close()
+		if _, err := io.Copy(op.output, httpResp.Body); err != nil {
+			return nil, fmt.Errorf("failed to stream response body: %v", err)
+		}
+		return &Response[Resp]{StatusCode: httpResp.StatusCode, Header: httpResp.Header}, nil
+	}
+	if op.stream && httpResp.StatusCode >= 200 && httpResp.StatusCode <= 299 {
+		return &Response[Resp]{StatusCode: httpResp.StatusCode, Header: httpResp.Header, RawBody: httpResp.Body}, nil
+	}
+
+	data, err := io.ReadAll(httpResp.Body)
+	httpResp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	resp := &Response[Resp]{
+		StatusCode: httpResp.StatusCode,
+		Header:     httpResp.Header,
+		RawBody:    io.NopCloser(bytes.NewReader(data)),
+	}
+
+	if httpResp.StatusCode < 200 || httpResp.StatusCode > 299 {
+		resp.Error = newError(httpReq, httpResp.StatusCode, data)
+		return resp, resp.Error
+	}
+
+	// Nothing to decode, e.g. 204 No Content
+	if len(bytes.TrimSpace(data)) == 0 {
+		return resp, nil
+	}
+
+	var result Resp
+	switch v := any(&result).(type) {
+	case *noBody:
+	case *[]byte:
+		*v = data
+	case *json.RawMessage:
+		*v = data
+	case *string:
+		*v = string(data)
+	default:
+		if err := json.Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+	}
+	resp.Body = &result
+
+	return resp, nil
+}
+
+// newRequest creates the HTTP request for op.
+func newRequest[Req any](ctx context.Context, op operation, body *Req) (*http.Request, error) {
+	payload := op.body
+	contentType := op.contentType
+	if payload == nil && body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		payload = bytes.NewReader(jsonBody)
+		contentType = "application/json"
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, op.method, op.path, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if op.getBody != nil {
+		httpReq.GetBody = op.getBody
+	}
+
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+
+	// Kibana 9 rejects requests to internal APIs without an internal origin
+	if strings.HasPrefix(op.path, "/internal/") {
+		httpReq.Header.Set("x-elastic-internal-origin", internalOrigin)
+	}
+
+	if len(op.query) > 0 {
+		httpReq.URL.RawQuery = op.query.Encode()
+	}
+
+	return httpReq, nil
+}
+
+// streamMultipartFile returns a reader streaming a multipart form with the
+// content of r as its "file" part, along with the content type of the form.
+// The form is written as it is read, so r is never held in memory. The
+// returned reader must be closed to release the writing goroutine.
+//
+// When r is an io.Seeker, getBody rewinds it and streams the form again, so
+// the transport can retry the request without buffering it.
+func streamMultipartFile(filename string, r io.Reader) (body io.ReadCloser, contentType string, getBody func() (io.ReadCloser, error)) {
+	boundary := multipart.NewWriter(io.Discard).Boundary()
+
+	// done is closed once the previous stream stopped reading r
+	var done chan struct{}
+	stream := func() io.ReadCloser {
+		pr, pw := io.Pipe()
+		done = make(chan struct{})
+		go func(done chan struct{}) {
+			defer close(done)
+			writer := multipart.NewWriter(pw)
+			err := writer.SetBoundary(boundary)
+			var part io.Writer
+			if err == nil {
+				part, err = writer.CreateFormFile("file", filename)
+			}
+			if err == nil {
+				_, err = io.Copy(part, r)
+			}
+			if err == nil {
+				err = writer.Close()
+			}
+			pw.CloseWithError(err)
+		}(done)
+		return pr
+	}
+
+	// Record where r starts before the first stream reads from it
+	seeker, seekable := r.(io.Seeker)
+	var start int64
+	if seekable {
+		var err error
+		start, err = seeker.Seek(0, io.SeekCurrent)
+		seekable = err == nil
+	}
+
+	body = stream()
+	if seekable {
+		previous := body
+		getBody = func() (io.ReadCloser, error) {
+			previous.Close()
+			<-done
+			if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+				return nil, err
+			}
+			previous = stream()
+			return previous, nil
+		}
+	}
+
+	return body, "multipart/form-data; boundary=" + boundary, getBody
+}
+
+// splitNDJSON splits an NDJSON payload into its individual JSON documents.
+func splitNDJSON(data []byte) []json.RawMessage {
+	var objects []json.RawMessage
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		if bytes.HasPrefix(line, []byte(",")) {
+			line = line[1:]
+		}
+		objects = append(objects, json.RawMessage(line))
+	}
+	return objects
+}
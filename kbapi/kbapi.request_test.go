@@ -0,0 +1,148 @@
+package kbapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/elastic/elastic-transport-go/v8/elastictransport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingInstrumentation records the spans and errors reported by do
+type recordingInstrumentation struct {
+	elastictransport.Instrumentation
+	spans  []string
+	errors []error
+}
+
+func (i *recordingInstrumentation) Start(ctx context.Context, name string) context.Context {
+	i.spans = append(i.spans, name)
+	return ctx
+}
+
+func (i *recordingInstrumentation) Close(ctx context.Context) {}
+
+func (i *recordingInstrumentation) RecordError(ctx context.Context, err error) {
+	i.errors = append(i.errors, err)
+}
+
+func (i *recordingInstrumentation) BeforeRequest(req *http.Request, endpoint string) {}
+
+func (i *recordingInstrumentation) RecordRequestBody(ctx context.Context, endpoint string, query io.Reader) io.ReadCloser {
+	return nil
+}
+
+func (i *recordingInstrumentation) AfterRequest(req *http.Request, system, endpoint string) {}
+
+// instrumentedTransport wraps a MockTransport with instrumentation
+type instrumentedTransport struct {
+	*MockTransport
+	instrument *recordingInstrumentation
+}
+
+func (t *instrumentedTransport) InstrumentationEnabled() elastictransport.Instrumentation {
+	return t.instrument
+}
+
+func TestDo(t *testing.T) {
+	type body struct {
+		Name string `json:"name"`
+	}
+
+	testCases := []struct {
+		name         string
+		statusCode   int
+		responseBody string
+		body         *body
+		expectError  bool
+		expectBody   bool
+	}{
+		{
+			name:         "JSON request and response",
+			statusCode:   200,
+			responseBody: `{"name":"created"}`,
+			body:         &body{Name: "test"},
+			expectBody:   true,
+		},
+		{
+			name:         "Created",
+			statusCode:   201,
+			responseBody: `{"name":"created"}`,
+			expectBody:   true,
+		},
+		{
+			name:       "No content",
+			statusCode: 204,
+		},
+		{
+			name:         "Error response",
+			statusCode:   400,
+			responseBody: `{"statusCode":400,"error":"Bad Request","message":"invalid"}`,
+			expectError:  true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			instrument := &recordingInstrumentation{}
+			mockTransport := NewMockTransportWithRawResponse(test.statusCode, test.responseBody, nil)
+			api := New(&instrumentedTransport{MockTransport: mockTransport, instrument: instrument})
+
+			resp, err := do[body, body](context.Background(), api, operation{
+				name:   "test.do",
+				method: http.MethodPost,
+				path:   "/api/test",
+				query:  map[string][]string{"page": {"2"}},
+			}, test.body, nil)
+
+			req := mockTransport.LastRequest()
+			AssertRequestPath(t, req, "/api/test")
+			AssertRequestParam(t, req, "page", "2")
+			if test.body != nil {
+				AssertRequestHeader(t, req, "Content-Type", "application/json")
+				AssertRequestBodyJSON(t, req, test.body)
+			} else {
+				assert.Empty(t, req.Header.Get("Content-Type"))
+			}
+
+			assert.Equal(t, []string{"test.do"}, instrument.spans)
+			require.NotNil(t, resp)
+			assert.Equal(t, test.statusCode, resp.StatusCode)
+
+			raw, readErr := io.ReadAll(resp.RawBody)
+			require.NoError(t, readErr)
+			assert.Equal(t, test.responseBody, string(raw))
+
+			if test.expectError {
+				require.Error(t, err)
+				assert.True(t, IsBadRequest(err))
+				assert.Equal(t, err, resp.Error)
+				assert.Nil(t, resp.Body)
+				assert.Equal(t, []error{err}, instrument.errors)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Nil(t, resp.Error)
+			assert.Empty(t, instrument.errors, "no error should be recorded on success")
+			if test.expectBody {
+				require.NotNil(t, resp.Body)
+				assert.Equal(t, "created", resp.Body.Name)
+			} else {
+				assert.Nil(t, resp.Body)
+			}
+		})
+	}
+}
+
+func TestConnectorsList_Path(t *testing.T) {
+	mockTransport := NewMockTransport(200, ConnectorsListResponseBody{}, nil)
+	api := New(mockTransport)
+
+	_, err := api.Connectors.List(context.Background())
+	require.NoError(t, err)
+	AssertRequestPath(t, mockTransport.LastRequest(), "/api/actions/connectors")
+}
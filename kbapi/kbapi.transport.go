@@ -1,7 +1,10 @@
 package kbapi
 
 import (
+	"context"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 // Interface for performing requests (allows mocking for tests)
@@ -9,6 +12,17 @@ type Transport interface {
 	Perform(req *http.Request) (*http.Response, error)
 }
 
+// operationKey is the context key of the operation name of a request.
+type operationKey struct{}
+
+// OperationName returns the name of the endpoint a request was built for,
+// e.g. fleet.agents.update, from the request context. Transports use it to
+// tell API groups apart. It returns an empty string for other contexts.
+func OperationName(ctx context.Context) string {
+	name, _ := ctx.Value(operationKey{}).(string)
+	return name
+}
+
 // Option defines a functional option for configuring API requests
 type RequestOption func(*http.Request) error
 
@@ -23,3 +37,129 @@ func WithHeaders(headers http.Header) RequestOption {
 		return nil
 	}
 }
+
+// WithAPIKey authenticates the request with the base64-encoded API key
+// instead of the credentials of the transport.
+func WithAPIKey(apiKey string) RequestOption {
+	return func(req *http.Request) error {
+		req.Header.Set("Authorization", "ApiKey "+apiKey)
+		return nil
+	}
+}
+
+// WithBasicAuth authenticates the request with HTTP basic authentication
+// instead of the credentials of the transport.
+func WithBasicAuth(username, password string) RequestOption {
+	return func(req *http.Request) error {
+		req.SetBasicAuth(username, password)
+		return nil
+	}
+}
+
+// WithRunAs sends the request on behalf of another user. The authenticated
+// user needs the run_as privilege for that user.
+// See https://www.elastic.co/docs/deploy-manage/users-roles/cluster-or-deployment-auth/submitting-requests-on-behalf-of-other-users
+func WithRunAs(username string) RequestOption {
+	return func(req *http.Request) error {
+		req.Header.Set("es-security-runas-user", username)
+		return nil
+	}
+}
+
+// WithOpaqueID sets the X-Opaque-Id header, which Kibana and Elasticsearch
+// record in their logs to correlate the request.
+func WithOpaqueID(id string) RequestOption {
+	return func(req *http.Request) error {
+		req.Header.Set("X-Opaque-Id", id)
+		return nil
+	}
+}
+
+// WithSpace scopes the request to the given Kibana space by rewriting the
+// path to /s/{id}/api/... Requests to APIs that are not space aware, such as
+// Fleet and roles, are left untouched. WithSpace("default") targets the
+// default space even through an API bound to another space.
+func WithSpace(id string) RequestOption {
+	return func(req *http.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), spaceKey{}, id))
+		setSpacePath(req, id)
+		return nil
+	}
+}
+
+// spaceKey is the context key of the space set with WithSpace.
+type spaceKey struct{}
+
+// hasSpace reports whether the space of req was set with WithSpace.
+func hasSpace(req *http.Request) bool {
+	_, ok := req.Context().Value(spaceKey{}).(string)
+	return ok
+}
+
+// defaultSpace is the ID of the Kibana space served without a /s/{id} prefix.
+const defaultSpace = "default"
+
+// spaceAwarePrefixes lists the API paths that Kibana serves per space.
+var spaceAwarePrefixes = []string{
+	"/api/actions",
+	"/api/alerting",
+	"/api/asset_criticality",
+	"/api/cases",
+	"/api/data_views",
+	"/api/detection_engine",
+	"/api/endpoint_list",
+	"/api/entity_store",
+	"/api/exception_lists",
+	"/api/exceptions",
+	"/api/lists",
+	"/api/ml/saved_objects",
+	"/api/note",
+	"/api/observability",
+	"/api/osquery",
+	"/api/pinned_event",
+	"/api/risk_score",
+	"/api/saved_objects",
+	"/api/security_ai_assistant",
+	"/api/short_url",
+	"/api/spaces/_",
+	"/api/synthetics",
+	"/api/timeline",
+	"/api/uptime",
+	"/internal/alerting/rules/maintenance_window",
+	"/internal/observability/slos",
+	"/internal/risk_score",
+}
+
+// isSpaceAware reports whether path belongs to an API that Kibana serves per space.
+func isSpaceAware(path string) bool {
+	for _, prefix := range spaceAwarePrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// setSpacePath prefixes the request path with /s/{space}. Paths that are
+// already scoped to a space or that are not space aware are left as they are.
+func setSpacePath(req *http.Request, space string) {
+	if space == "" || space == defaultSpace || req.URL == nil {
+		return
+	}
+	if strings.HasPrefix(req.URL.Path, "/s/") || !isSpaceAware(req.URL.Path) {
+		return
+	}
+	escaped := req.URL.EscapedPath()
+	req.URL.Path = "/s/" + space + req.URL.Path
+	req.URL.RawPath = "/s/" + url.PathEscape(space) + escaped
+}
+
+// Space returns a view of the API bound to the given Kibana space. Every
+// space aware endpoint called through the returned API targets /s/{id}/api/...
+// while global APIs such as Fleet and roles keep their usual paths.
+// A per-request WithSpace option takes precedence over the bound space.
+func (api *API) Space(id string) *API {
+	scoped := New(api.transport)
+	scoped.space = id
+	return scoped
+}
@@ -0,0 +1,150 @@
+package kbapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithSpace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		path     string
+		space    string
+		expected string
+	}{
+		{
+			name:     "Space aware path",
+			path:     "/api/alerting/rule/abc",
+			space:    "team-a",
+			expected: "/s/team-a/api/alerting/rule/abc",
+		},
+		{
+			name:     "Default space",
+			path:     "/api/cases/_find",
+			space:    "default",
+			expected: "/api/cases/_find",
+		},
+		{
+			name:     "Empty space",
+			path:     "/api/cases/_find",
+			space:    "",
+			expected: "/api/cases/_find",
+		},
+		{
+			name:     "Fleet is global",
+			path:     "/api/fleet/agent_policies",
+			space:    "team-a",
+			expected: "/api/fleet/agent_policies",
+		},
+		{
+			name:     "Roles are global",
+			path:     "/api/security/role/admin",
+			space:    "team-a",
+			expected: "/api/security/role/admin",
+		},
+		{
+			name:     "Spaces management is global",
+			path:     "/api/spaces/space/team-a",
+			space:    "team-a",
+			expected: "/api/spaces/space/team-a",
+		},
+		{
+			name:     "Copy saved objects is space aware",
+			path:     "/api/spaces/_copy_saved_objects",
+			space:    "team-a",
+			expected: "/s/team-a/api/spaces/_copy_saved_objects",
+		},
+		{
+			name:     "Already scoped path",
+			path:     "/s/team-b/api/saved_objects/_export",
+			space:    "team-a",
+			expected: "/s/team-b/api/saved_objects/_export",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, test.path, nil)
+			require.NoError(t, err)
+
+			require.NoError(t, WithSpace(test.space)(req))
+			AssertRequestPath(t, req, test.expected)
+		})
+	}
+}
+
+func TestAPI_Space(t *testing.T) {
+	mockTransport := NewMockTransport(200, AlertingGetResponseBody{}, nil)
+	api := New(mockTransport)
+
+	scoped := api.Space("team-a")
+
+	_, err := scoped.Alerting.Get(context.Background(), &AlertingGetRequest{ID: "abc"})
+	require.NoError(t, err)
+	AssertRequestPath(t, mockTransport.LastRequest(), "/s/team-a/api/alerting/rule/abc")
+
+	mockTransport.MockResponse = NewMockTransport(200, AlertingGetResponseBody{}, nil).MockResponse
+	_, err = scoped.Alerting.Get(context.Background(), &AlertingGetRequest{ID: "abc"}, WithSpace("team-b"))
+	require.NoError(t, err)
+	AssertRequestPath(t, mockTransport.LastRequest(), "/s/team-b/api/alerting/rule/abc")
+
+	mockTransport.MockResponse = NewMockTransport(200, FleetAgentPoliciesGetAgentPolicyResponseBody{}, nil).MockResponse
+	_, err = scoped.Fleet.AgentPolicies.Get(context.Background(), &FleetGetAgentPolicyRequest{ID: "policy"})
+	require.NoError(t, err)
+	AssertRequestPath(t, mockTransport.LastRequest(), "/api/fleet/agent_policies/policy")
+
+	mockTransport.MockResponse = NewMockTransport(200, AlertingGetResponseBody{}, nil).MockResponse
+	_, err = api.Alerting.Get(context.Background(), &AlertingGetRequest{ID: "abc"})
+	require.NoError(t, err)
+	AssertRequestPath(t, mockTransport.LastRequest(), "/api/alerting/rule/abc")
+}
+
+func TestCredentialOptions(t *testing.T) {
+	mockTransport := NewMockTransport(200, AlertingGetResponseBody{}, nil)
+	api := New(mockTransport)
+
+	_, err := api.Alerting.Get(context.Background(), &AlertingGetRequest{ID: "abc"},
+		WithAPIKey("a2V5"), WithRunAs("jdoe"), WithOpaqueID("job-42"))
+	require.NoError(t, err)
+	req := mockTransport.LastRequest()
+	require.Equal(t, "ApiKey a2V5", req.Header.Get("Authorization"))
+	require.Equal(t, "jdoe", req.Header.Get("es-security-runas-user"))
+	require.Equal(t, "job-42", req.Header.Get("X-Opaque-Id"))
+
+	mockTransport.MockResponse = NewMockTransport(200, AlertingGetResponseBody{}, nil).MockResponse
+	_, err = api.Alerting.Get(context.Background(), &AlertingGetRequest{ID: "abc"}, WithBasicAuth("elastic", "changeme"))
+	require.NoError(t, err)
+	req = mockTransport.LastRequest()
+	require.Equal(t, "Basic ZWxhc3RpYzpjaGFuZ2VtZQ==", req.Header.Get("Authorization"))
+	require.Empty(t, req.Header.Get("es-security-runas-user"))
+}
+
+func TestOperationName(t *testing.T) {
+	mockTransport := NewMockTransport(200, AlertingGetResponseBody{}, nil)
+	api := New(mockTransport)
+
+	_, err := api.Alerting.Get(context.Background(), &AlertingGetRequest{ID: "abc"})
+	require.NoError(t, err)
+	require.Equal(t, "alerting.get", OperationName(mockTransport.LastRequest().Context()))
+	require.Empty(t, OperationName(context.Background()))
+}
+
+func TestAPI_Space_DefaultOverride(t *testing.T) {
+	mockTransport := NewMockTransport(200, AlertingGetResponseBody{}, nil)
+	api := New(mockTransport).Space("team-a")
+
+	_, err := api.Alerting.Get(context.Background(), &AlertingGetRequest{ID: "abc"}, WithSpace("default"))
+	require.NoError(t, err)
+	AssertRequestPath(t, mockTransport.LastRequest(), "/api/alerting/rule/abc")
+}
+
+func TestWithSpace_Escaping(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "/api/cases/_find", nil)
+	require.NoError(t, err)
+
+	require.NoError(t, WithSpace("team/a b")(req))
+	require.Equal(t, "/s/team%2Fa%20b/api/cases/_find", req.URL.EscapedPath())
+}
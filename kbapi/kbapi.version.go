@@ -0,0 +1,107 @@
+package kbapi
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Build flavors of a Kibana deployment, as reported by Status.Get.
+const (
+	BuildFlavorTraditional = "traditional"
+	BuildFlavorServerless  = "serverless"
+)
+
+// Version is a Kibana version.
+type Version struct {
+	Major, Minor, Patch int
+}
+
+// ParseVersion parses a version such as 9.1.0 or 9.2.0-SNAPSHOT. The
+// pre-release suffix is ignored.
+func ParseVersion(s string) (Version, error) {
+	number, _, _ := strings.Cut(strings.TrimPrefix(s, "v"), "-")
+	parts := strings.Split(number, ".")
+	if len(parts) == 0 || len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid version %q", s)
+	}
+
+	var v Version
+	for i, dst := range []*int{&v.Major, &v.Minor, &v.Patch}[:len(parts)] {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid version %q", s)
+		}
+		*dst = n
+	}
+	return v, nil
+}
+
+// MustParseVersion is like ParseVersion but panics when s is invalid.
+func MustParseVersion(s string) Version {
+	v, err := ParseVersion(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns the version as major.minor.patch.
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Compare returns -1, 0 or 1 when v is lower than, equal to or higher than o.
+func (v Version) Compare(o Version) int {
+	for _, d := range [][2]int{{v.Major, o.Major}, {v.Minor, o.Minor}, {v.Patch, o.Patch}} {
+		switch {
+		case d[0] < d[1]:
+			return -1
+		case d[0] > d[1]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// AtLeast reports whether v is o or a later version.
+func (v Version) AtLeast(o Version) bool {
+	return v.Compare(o) >= 0
+}
+
+// Deployment describes the Kibana deployment a client talks to.
+type Deployment struct {
+	Version Version
+	// BuildFlavor One of the BuildFlavor* values.
+	BuildFlavor string
+}
+
+// Serverless reports whether the deployment is an Elastic Cloud serverless project.
+func (d Deployment) Serverless() bool {
+	return d.BuildFlavor == BuildFlavorServerless
+}
+
+// DeploymentFromStatus returns the deployment described by a Status.Get response.
+func DeploymentFromStatus(status *KibanaStatusResponse) (*Deployment, error) {
+	if status == nil {
+		return nil, fmt.Errorf("status response has no body")
+	}
+	version, err := ParseVersion(status.Version.Number)
+	if err != nil {
+		return nil, err
+	}
+	flavor := status.Version.BuildFlavor
+	if flavor == "" {
+		// Kibana only reports the build flavor since 8.11
+		flavor = BuildFlavorTraditional
+	}
+	return &Deployment{Version: version, BuildFlavor: flavor}, nil
+}
+
+// DeploymentDetector is implemented by transports that know the deployment
+// they send requests to, such as kibana.Client. Endpoints that belong to a
+// Feature are checked against the deployment before the request is sent.
+type DeploymentDetector interface {
+	Deployment(ctx context.Context) (*Deployment, error)
+}
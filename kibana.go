@@ -1,12 +1,16 @@
 package kibana
 
 import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"os"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -18,19 +22,41 @@ import (
 
 // Default values for client configuration
 const (
-	defaultURL = "http://localhost:5601"
-	Version    = "0.1.0"
+	defaultURL        = "http://localhost:5601"
+	defaultMaxRetries = 3
+	Version           = "0.1.0"
 )
 
+// deploymentRetryInterval is how long a failed detection of the Kibana
+// version is reported again before Status.Get is called again.
+const deploymentRetryInterval = time.Minute
+
+// defaultRetryOnStatus are the statuses retried by default.
+var defaultRetryOnStatus = []int{502, 503, 504}
+
+// retryAfterStatuses are the statuses retried by the client after the delay
+// of their Retry-After header instead of being retried by the transport.
+var retryAfterStatuses = []int{http.StatusTooManyRequests, http.StatusServiceUnavailable}
+
 // Config represents the client configuration.
 type Config struct {
 	Addresses       []string    // A list of Kibana instances to use.
+	CloudID         string      // Endpoint for the Elastic Cloud deployment; if set, Addresses must be empty.
 	Username        string      // Username for HTTP Basic Authentication.
 	Password        string      // Password for HTTP Basic Authentication.
 	APIKey          string      // Base64-encoded token for authorization; if set, overrides username/password and service token.
 	Header          http.Header // Global HTTP request header.
 	XSRFHeaderValue string      // Value for the kbn-xsrf header; defaults to "true" if not set.
 
+	// Credentials authenticates every request instead of Username, Password and APIKey,
+	// and is refreshed when Kibana answers 401 Unauthorized. See CredentialProvider.
+	Credentials CredentialProvider
+
+	// Serverless targets an Elastic Cloud serverless project. API key authentication is
+	// required, the internal origin header is sent, and the endpoints that serverless
+	// projects do not have fail with kbapi.ErrUnsupportedVersion without being sent.
+	Serverless bool
+
 	// PEM-encoded certificate authorities.
 	// When set, an empty certificate pool will be created, and the certificates will be appended to it.
 	// The option is only valid when the transport is not specified, or when it's http.Transport.
@@ -46,6 +72,18 @@ type Config struct {
 
 	RetryBackoff func(attempt int) time.Duration // Optional backoff duration. Default: nil.
 
+	// Rate limits, applied before the requests are handed to the transport.
+	// 429 and 503 responses are sent again by the client rather than the
+	// transport, up to MaxRetries times, after the delay of their Retry-After
+	// header or RetryBackoff, and the other requests of the API group wait as
+	// long. Without either, they are sent again at once when RetryOnStatus has them.
+	// A request holds its in-flight slots until its response body is read or
+	// closed. Client.RateLimitMetrics reports the waits; Metrics keeps
+	// returning elastictransport.Metrics so that existing callers compile.
+	RateLimit       RateLimit            // Limits every request. Default: unlimited.
+	GroupRateLimits map[string]RateLimit // Limits per API group, keyed by operation name prefix such as "fleet" or "fleet.agents".
+	MaxRetryAfter   time.Duration        // Longest Retry-After delay waited for. Default: 1 minute.
+
 	// Logger for client operations
 	Transport http.RoundTripper         // The HTTP transport object.
 	Logger    elastictransport.Logger   // The logger object.
@@ -62,10 +100,22 @@ type Client struct {
 
 	// Configuration
 	xsrfHeaderValue string
+	serverless      bool
+	credentials     CredentialProvider
+
+	// Rate limiting
+	limiter       *rateLimiter
+	retryOnStatus []int
+	maxRetries    int
+	maxRetryAfter time.Duration
+	retryBackoff  func(attempt int) time.Duration
 
 	// Internal state
 	productCheckMu      sync.RWMutex
 	productCheckSuccess bool
+	deployment          kbapi.Deployment
+	productCheckErr     error
+	productCheckRetryAt time.Time
 }
 
 // NewOpenTelemetryInstrumentation provides the OpenTelemetry integration for Kibana client
@@ -75,6 +125,13 @@ func NewOpenTelemetryInstrumentation(provider trace.TracerProvider, captureBody
 
 // NewClient creates a new Kibana client
 func NewClient(cfg Config) (*Client, error) {
+	if cfg.Credentials != nil && (cfg.APIKey != "" || cfg.Username != "" || cfg.Password != "") {
+		return nil, errors.New("cannot create client: both Credentials and Username, Password or APIKey are set")
+	}
+	if cfg.Serverless && !serverlessAuth(cfg) {
+		return nil, errors.New("cannot create client: serverless projects require API key authentication")
+	}
+
 	tp, err := newTransport(cfg)
 	if err != nil {
 		return nil, err
@@ -89,6 +146,30 @@ func NewClient(cfg Config) (*Client, error) {
 	client := &Client{
 		Transport:       tp,
 		xsrfHeaderValue: xsrfValue,
+		serverless:      cfg.Serverless,
+		credentials:     cfg.Credentials,
+		limiter:         newRateLimiter(cfg.RateLimit, cfg.GroupRateLimits),
+		retryOnStatus:   cfg.RetryOnStatus,
+		maxRetries:      cfg.MaxRetries,
+		maxRetryAfter:   cfg.MaxRetryAfter,
+		retryBackoff:    cfg.RetryBackoff,
+	}
+	if client.maxRetries == 0 {
+		client.maxRetries = defaultMaxRetries
+	}
+	if cfg.DisableRetry {
+		client.maxRetries = 0
+	}
+	if client.maxRetryAfter == 0 {
+		client.maxRetryAfter = defaultMaxRetryAfter
+	}
+	if len(client.retryOnStatus) == 0 {
+		client.retryOnStatus = defaultRetryOnStatus
+	}
+	if cfg.Serverless {
+		// Serverless projects are upgraded continuously and have no version to detect
+		client.deployment = kbapi.Deployment{BuildFlavor: kbapi.BuildFlavorServerless}
+		client.productCheckSuccess = true
 	}
 
 	// Initialize API
@@ -101,10 +182,21 @@ func NewClient(cfg Config) (*Client, error) {
 func newTransport(cfg Config) (*elastictransport.Client, error) {
 	var addrs []string
 
-	// Use provided addresses or environment variable
-	if len(cfg.Addresses) == 0 {
+	if len(cfg.Addresses) > 0 && cfg.CloudID != "" {
+		return nil, errors.New("cannot create client: both Addresses and CloudID are set")
+	}
+
+	// Use provided addresses, the cloud ID or environment variable
+	switch {
+	case cfg.CloudID != "":
+		addr, err := addrFromCloudID(cfg.CloudID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create client: cannot parse CloudID: %s", err)
+		}
+		addrs = append(addrs, addr)
+	case len(cfg.Addresses) == 0:
 		addrs = addrsFromEnvironment("KIBANA_URL")
-	} else {
+	default:
 		addrs = append(addrs, cfg.Addresses...)
 	}
 
@@ -122,6 +214,14 @@ func newTransport(cfg Config) (*elastictransport.Client, error) {
 
 	userAgent := initUserAgent()
 
+	transport := cfg.Transport
+	if cp := clientCertificates(cfg.Credentials); cp != nil {
+		transport, err = clientCertificateTransport(cfg.Transport, cp)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create client: %s", err)
+		}
+	}
+
 	// Configure transport
 	tpConfig := elastictransport.Config{
 		UserAgent:         userAgent,
@@ -131,13 +231,13 @@ func newTransport(cfg Config) (*elastictransport.Client, error) {
 		APIKey:            cfg.APIKey,
 		Header:            cfg.Header,
 		CACert:            cfg.CACert,
-		RetryOnStatus:     cfg.RetryOnStatus,
+		RetryOnStatus:     transportRetryOnStatus(cfg.RetryOnStatus),
 		DisableRetry:      cfg.DisableRetry,
 		RetryOnError:      cfg.RetryOnError,
 		MaxRetries:        cfg.MaxRetries,
 		EnableMetrics:     cfg.EnableMetrics,
 		EnableDebugLogger: cfg.EnableDebugLogger,
-		Transport:         cfg.Transport,
+		Transport:         transport,
 		Logger:            cfg.Logger,
 		Selector:          cfg.Selector,
 		Instrumentation:   cfg.Instrumentation,
@@ -151,6 +251,26 @@ func newTransport(cfg Config) (*elastictransport.Client, error) {
 	return tp, nil
 }
 
+// transportRetryOnStatus returns the statuses retried by the transport, the
+// retryAfterStatuses are left to the client so that Retry-After is honored.
+func transportRetryOnStatus(statuses []int) []int {
+	if len(statuses) == 0 {
+		statuses = defaultRetryOnStatus
+	}
+	var retried []int
+	for _, status := range statuses {
+		if !slices.Contains(retryAfterStatuses, status) {
+			retried = append(retried, status)
+		}
+	}
+	if len(retried) == 0 {
+		// The transport retries its defaults when the list is empty, it never
+		// receives a response without a status
+		return []int{0}
+	}
+	return retried
+}
+
 // InstrumentationEnabled propagates back to the client the Instrumentation provided by the transport.
 func (c *Client) InstrumentationEnabled() elastictransport.Instrumentation {
 	if tp, ok := c.Transport.(elastictransport.Instrumented); ok {
@@ -162,17 +282,196 @@ func (c *Client) InstrumentationEnabled() elastictransport.Instrumentation {
 // Perform delegates to Transport to execute a request and return a response.
 func (c *Client) Perform(req *http.Request) (*http.Response, error) {
 	req.Header.Set("kbn-xsrf", c.xsrfHeaderValue)
+	if c.serverless {
+		// Serverless projects reject requests to internal APIs without an internal origin
+		req.Header.Set("x-elastic-internal-origin", "go-kibana")
+	}
 
-	// Perform the request
-	res, err := c.Transport.Perform(req)
+	// Credentials set on the request, e.g. with kbapi.WithAPIKey, take precedence
+	authenticate := c.credentials != nil && req.Header.Get("Authorization") == ""
+	if authenticate {
+		if err := c.credentials.Authenticate(req); err != nil {
+			return nil, fmt.Errorf("cannot authenticate request: %w", err)
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		// Perform the request
+		res, err := c.send(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if authenticate && res.StatusCode == http.StatusUnauthorized {
+			if res, err = c.retryUnauthorized(req, res); err != nil {
+				return nil, err
+			}
+		}
+
+		delay, ok := c.retryDelay(res, attempt)
+		if !ok || !rewind(req) {
+			return res, nil
+		}
+		res.Body.Close()
+
+		if delay > 0 {
+			// Hold back the other requests of the API group while Kibana is busy
+			c.limiter.retryAfter(kbapi.OperationName(req.Context()), delay)
+			if err := sleep(req.Context(), delay); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// send waits for the rate limits of the request and hands it to the transport.
+// The in-flight slots are held until the response body is read or closed.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	release, err := c.limiter.acquire(req.Context(), kbapi.OperationName(req.Context()))
 	if err != nil {
 		return nil, err
 	}
 
+	res, err := c.Transport.Perform(req)
+	if err != nil || res == nil || res.Body == nil {
+		release()
+		return res, err
+	}
+	res.Body = &releasingBody{ReadCloser: res.Body, release: release}
 	return res, nil
 }
 
-// Metrics returns the client metrics.
+// retryUnauthorized sends req once more when the credential provider
+// refreshes the credentials Kibana rejected with res, or when they were
+// refreshed by a concurrent request since req was sent.
+func (c *Client) retryUnauthorized(req *http.Request, res *http.Response) (*http.Response, error) {
+	if !rewind(req) {
+		return res, nil
+	}
+	refreshed, err := c.credentials.Refresh(req.Context())
+	if err != nil {
+		return res, nil
+	}
+
+	// Concurrent requests may have refreshed the credentials already
+	sent := req.Header.Get("Authorization")
+	req.Header.Del("Authorization")
+	if err := c.credentials.Authenticate(req); err != nil {
+		return nil, fmt.Errorf("cannot authenticate request: %w", err)
+	}
+	if !refreshed && req.Header.Get("Authorization") == sent {
+		return res, nil
+	}
+	res.Body.Close()
+	return c.send(req)
+}
+
+// retryDelay returns how long to wait before sending the request again when
+// Kibana is busy, and false when res is final.
+func (c *Client) retryDelay(res *http.Response, attempt int) (time.Duration, bool) {
+	if attempt > c.maxRetries || !slices.Contains(retryAfterStatuses, res.StatusCode) {
+		return 0, false
+	}
+	delay, ok := parseRetryAfter(res, time.Now())
+	if !ok && c.retryBackoff != nil {
+		delay, ok = c.retryBackoff(attempt), true
+	}
+	if !ok && slices.Contains(c.retryOnStatus, res.StatusCode) {
+		// Retried at once, as the transport does
+		delay, ok = 0, true
+	}
+	if !ok || delay > c.maxRetryAfter {
+		return 0, false
+	}
+	return delay, true
+}
+
+// rewind resets the body of req so that it can be sent again, and reports
+// false when the body cannot be sent again.
+func rewind(req *http.Request) bool {
+	if req.GetBody == nil {
+		return req.Body == nil || req.Body == http.NoBody
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	req.Body = body
+	return true
+}
+
+// Deployment returns the version and build flavor of Kibana. They are
+// detected with Status.Get on the first call and cached once detected. A
+// failed detection is reported without calling Status.Get again for a minute.
+// With Config.Serverless, the build flavor is serverless and the version is zero.
+// Deployment implements kbapi.DeploymentDetector, so that endpoints that
+// Kibana does not have fail with kbapi.ErrUnsupportedVersion.
+func (c *Client) Deployment(ctx context.Context) (*kbapi.Deployment, error) {
+	c.productCheckMu.RLock()
+	if c.productCheckSuccess {
+		deployment := c.deployment
+		c.productCheckMu.RUnlock()
+		return &deployment, nil
+	}
+	c.productCheckMu.RUnlock()
+
+	c.productCheckMu.Lock()
+	defer c.productCheckMu.Unlock()
+	if !c.productCheckSuccess {
+		if c.productCheckErr != nil && time.Now().Before(c.productCheckRetryAt) {
+			return nil, c.productCheckErr
+		}
+		deployment, err := c.detectDeployment(ctx)
+		if err != nil {
+			// Only failures of Kibana are cached, not the ones of the caller
+			if ctx.Err() == nil {
+				c.productCheckErr = err
+				c.productCheckRetryAt = time.Now().Add(deploymentRetryInterval)
+			}
+			return nil, err
+		}
+		c.deployment = *deployment
+		c.productCheckSuccess = true
+		c.productCheckErr = nil
+	}
+	deployment := c.deployment
+	return &deployment, nil
+}
+
+// detectDeployment returns the deployment reported by Status.Get.
+func (c *Client) detectDeployment(ctx context.Context) (*kbapi.Deployment, error) {
+	status, err := c.API.Status.Get(ctx, &kbapi.GetStatusRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot detect the Kibana version: %w", err)
+	}
+	deployment, err := kbapi.DeploymentFromStatus(status.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot detect the Kibana version: %w", err)
+	}
+	return deployment, nil
+}
+
+// Version returns the version of Kibana, see Deployment.
+func (c *Client) Version(ctx context.Context) (kbapi.Version, error) {
+	deployment, err := c.Deployment(ctx)
+	if err != nil {
+		return kbapi.Version{}, err
+	}
+	return deployment.Version, nil
+}
+
+// Supports reports whether Kibana has feature, see Deployment.
+func (c *Client) Supports(ctx context.Context, feature kbapi.Feature) (bool, error) {
+	deployment, err := c.Deployment(ctx)
+	if err != nil {
+		return false, err
+	}
+	return kbapi.Supports(deployment, feature), nil
+}
+
+// Metrics returns the transport metrics. The rate limit metrics are
+// reported by RateLimitMetrics, as changing the result type would break the
+// callers that use elastictransport.Metrics.
 func (c *Client) Metrics() (elastictransport.Metrics, error) {
 	if mt, ok := c.Transport.(elastictransport.Measurable); ok {
 		return mt.Metrics()
@@ -180,6 +479,41 @@ func (c *Client) Metrics() (elastictransport.Metrics, error) {
 	return elastictransport.Metrics{}, errors.New("transport is missing method Metrics()")
 }
 
+// RateLimitMetrics returns the time requests spent waiting for the rate
+// limits and for the Retry-After delays of Kibana.
+func (c *Client) RateLimitMetrics() RateLimitMetrics {
+	return c.limiter.metrics()
+}
+
+// serverlessAuth reports whether the configuration authenticates with an API
+// key, as serverless projects require. Custom credential providers are trusted.
+func serverlessAuth(cfg Config) bool {
+	if cfg.Credentials != nil {
+		scheme := authScheme(cfg.Credentials)
+		return scheme == "" || scheme == AuthSchemeAPIKey
+	}
+	return cfg.APIKey != "" && cfg.Username == "" && cfg.Password == ""
+}
+
+// clientCertificateTransport returns a copy of transport presenting the
+// client certificates of cp during TLS handshakes.
+func clientCertificateTransport(transport http.RoundTripper, cp ClientCertificateProvider) (http.RoundTripper, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpTransport, ok := transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("client certificates require an *http.Transport, got %T", transport)
+	}
+
+	httpTransport = httpTransport.Clone()
+	if httpTransport.TLSClientConfig == nil {
+		httpTransport.TLSClientConfig = &tls.Config{}
+	}
+	httpTransport.TLSClientConfig.GetClientCertificate = cp.ClientCertificate
+	return httpTransport, nil
+}
+
 // addrsFromEnvironment returns a list of addresses by splitting
 // the environment variable with comma, or an empty list.
 func addrsFromEnvironment(envVar string) []string {
@@ -193,6 +527,37 @@ func addrsFromEnvironment(envVar string) []string {
 	return addrs
 }
 
+// addrFromCloudID extracts the Kibana URL from CloudID.
+// See: https://www.elastic.co/guide/en/cloud/current/ec-cloud-id.html
+func addrFromCloudID(input string) (string, error) {
+	var scheme = "https://"
+
+	values := strings.Split(input, ":")
+	if len(values) != 2 {
+		return "", fmt.Errorf("unexpected format: %q", input)
+	}
+	data, err := base64.StdEncoding.DecodeString(values[1])
+	if err != nil {
+		return "", err
+	}
+	parts := strings.Split(string(data), "$")
+
+	if len(parts) < 3 || parts[2] == "" {
+		return "", fmt.Errorf("invalid encoded value: %s", parts)
+	}
+
+	// The Kibana component overrides the port of the host when it has one
+	host, port, _ := strings.Cut(parts[0], ":")
+	kibana, kibanaPort, _ := strings.Cut(parts[2], ":")
+	if kibanaPort != "" {
+		port = kibanaPort
+	}
+	if port != "" {
+		return fmt.Sprintf("%s%s.%s:%s", scheme, kibana, host, port), nil
+	}
+	return fmt.Sprintf("%s%s.%s", scheme, kibana, host), nil
+}
+
 // addrsToURLs creates a list of url.URL structures from url list.
 func addrsToURLs(addrs []string) ([]*url.URL, error) {
 	var urls []*url.URL
@@ -0,0 +1,436 @@
+package kibana
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tehbooom/go-kibana/kbapi"
+)
+
+// roundTripFunc is an http.RoundTripper calling the function
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// response returns a JSON response with the status code and body
+func response(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestClient_DeploymentFailureCached(t *testing.T) {
+	var requests int
+	client, err := NewClient(Config{
+		Addresses:    []string{"http://localhost:5601"},
+		DisableRetry: true,
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			return response(http.StatusForbidden, `{"statusCode":403,"error":"Forbidden","message":"forbidden"}`), nil
+		}),
+	})
+	require.NoError(t, err)
+
+	_, err = client.Deployment(context.Background())
+	require.Error(t, err)
+	_, err = client.Deployment(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, 1, requests)
+
+	// A canceled caller does not cache its failure
+	client.productCheckErr = nil
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.Deployment(ctx)
+	require.Error(t, err)
+	assert.Nil(t, client.productCheckErr)
+}
+
+func TestAddrFromCloudID(t *testing.T) {
+	cloudID := func(value string) string {
+		return "my-deployment:" + base64.StdEncoding.EncodeToString([]byte(value))
+	}
+
+	tests := []struct {
+		name    string
+		cloudID string
+		want    string
+		wantErr bool
+	}{
+		{name: "without port", cloudID: cloudID("us-east-1.aws.found.io$es-uuid$kb-uuid"), want: "https://kb-uuid.us-east-1.aws.found.io"},
+		{name: "host port", cloudID: cloudID("us-east-1.aws.found.io:9243$es-uuid$kb-uuid"), want: "https://kb-uuid.us-east-1.aws.found.io:9243"},
+		{name: "kibana port overrides host port", cloudID: cloudID("us-east-1.aws.found.io:443$es-uuid$kb-uuid:9243"), want: "https://kb-uuid.us-east-1.aws.found.io:9243"},
+		{name: "kibana port only", cloudID: cloudID("us-east-1.aws.found.io$es-uuid$kb-uuid:9243"), want: "https://kb-uuid.us-east-1.aws.found.io:9243"},
+		{name: "missing kibana uuid", cloudID: cloudID("us-east-1.aws.found.io$es-uuid"), wantErr: true},
+		{name: "empty kibana uuid", cloudID: cloudID("us-east-1.aws.found.io$es-uuid$"), wantErr: true},
+		{name: "missing name", cloudID: base64.StdEncoding.EncodeToString([]byte("us-east-1.aws.found.io$es-uuid$kb-uuid")), wantErr: true},
+		{name: "invalid base64", cloudID: "my-deployment:%%%", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := addrFromCloudID(tt.cloudID)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, addr)
+		})
+	}
+}
+
+func TestNewClient_CloudID(t *testing.T) {
+	var host string
+	client, err := NewClient(Config{
+		CloudID: "my-deployment:" + base64.StdEncoding.EncodeToString([]byte("us-east-1.aws.found.io$es-uuid$kb-uuid")),
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			host = req.URL.Host
+			return response(http.StatusOK, `{}`), nil
+		}),
+	})
+	require.NoError(t, err)
+	_, err = client.API.Status.Get(context.Background(), &kbapi.GetStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "kb-uuid.us-east-1.aws.found.io", host)
+
+	_, err = NewClient(Config{CloudID: "my-deployment:", Addresses: []string{"http://localhost:5601"}})
+	assert.Error(t, err)
+}
+
+func TestNewClient_ServerlessCredentials(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{name: "api key", cfg: Config{APIKey: "key"}},
+		{name: "api key provider", cfg: Config{Credentials: NewAPIKeyProvider("key")}},
+		{name: "custom provider", cfg: Config{Credentials: CombineCredentials(NewAPIKeyProvider("key"))}},
+		{name: "no credentials", cfg: Config{}, wantErr: true},
+		{name: "basic auth", cfg: Config{Username: "elastic", Password: "changeme"}, wantErr: true},
+		{name: "api key and basic auth", cfg: Config{APIKey: "key", Username: "elastic", Password: "changeme"}, wantErr: true},
+		{name: "basic auth provider", cfg: Config{Credentials: NewBasicAuthProvider("elastic", "changeme")}, wantErr: true},
+		{name: "bearer token provider", cfg: Config{Credentials: NewBearerTokenProvider("token")}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.Serverless = true
+			tt.cfg.Addresses = []string{"https://project.kb.us-east-1.aws.elastic.cloud"}
+			_, err := NewClient(tt.cfg)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "serverless projects require API key authentication")
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestClient_ServerlessInternalOrigin(t *testing.T) {
+	for _, serverless := range []bool{true, false} {
+		var header http.Header
+		client, err := NewClient(Config{
+			Addresses:  []string{"http://localhost:5601"},
+			APIKey:     "key",
+			Serverless: serverless,
+			Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				header = req.Header
+				return response(http.StatusOK, `{}`), nil
+			}),
+		})
+		require.NoError(t, err)
+
+		_, err = client.API.Status.Get(context.Background(), &kbapi.GetStatusRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "APIKey key", header.Get("Authorization"))
+		if serverless {
+			assert.Equal(t, "go-kibana", header.Get("x-elastic-internal-origin"))
+		} else {
+			assert.Empty(t, header.Get("x-elastic-internal-origin"))
+		}
+	}
+}
+
+func TestClient_RetryAfter(t *testing.T) {
+	var requests int
+	client, err := NewClient(Config{
+		Addresses: []string{"http://localhost:5601"},
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			if requests == 1 {
+				res := response(http.StatusServiceUnavailable, `{}`)
+				res.Header.Set("Retry-After", "1")
+				return res, nil
+			}
+			return response(http.StatusOK, `{}`), nil
+		}),
+	})
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = client.API.Status.Get(context.Background(), &kbapi.GetStatusRequest{})
+	require.NoError(t, err)
+	// The transport does not retry the 503 itself, the client waits for Retry-After
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	assert.Equal(t, 2, requests)
+
+	m := client.RateLimitMetrics()
+	assert.Equal(t, 1, m.Global.RetriedAfter)
+	assert.Equal(t, time.Second, m.Global.RetryAfterTime)
+}
+
+func TestClient_InFlightUntilBodyRead(t *testing.T) {
+	body, writer := io.Pipe()
+	var requests int
+	client, err := NewClient(Config{
+		Addresses: []string{"http://localhost:5601"},
+		RateLimit: RateLimit{MaxInFlight: 1},
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			if requests == 1 {
+				res := response(http.StatusOK, "")
+				res.Body = body
+				return res, nil
+			}
+			return response(http.StatusOK, `{}`), nil
+		}),
+	})
+	require.NoError(t, err)
+
+	newRequest := func() *http.Request {
+		req, err := http.NewRequest(http.MethodGet, "/api/status", nil)
+		require.NoError(t, err)
+		return req
+	}
+
+	slow, err := client.Perform(newRequest())
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.RateLimitMetrics().Global.InFlight)
+
+	done := make(chan error, 1)
+	go func() {
+		res, err := client.Perform(newRequest())
+		if err == nil {
+			res.Body.Close()
+		}
+		done <- err
+	}()
+
+	// The slot is held while the body of the first response is being read
+	go func() {
+		writer.Write([]byte(`{"status":`))
+		time.Sleep(50 * time.Millisecond)
+		writer.Write([]byte(`"ok"}`))
+		writer.Close()
+	}()
+	buf := make([]byte, len(`{"status":`))
+	_, err = io.ReadFull(slow.Body, buf)
+	require.NoError(t, err)
+	select {
+	case <-done:
+		t.Fatal("second request sent before the first response body was read")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	_, err = io.ReadAll(slow.Body)
+	require.NoError(t, err)
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("second request still waiting after the first response body was read")
+	}
+
+	// Closing the body after reading it does not release the slot twice
+	require.NoError(t, slow.Body.Close())
+	m := client.RateLimitMetrics()
+	assert.Equal(t, 0, m.Global.InFlight)
+	assert.Equal(t, 1, m.Global.Queued)
+}
+
+func TestClient_RetryUnavailable(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      Config
+		requests int
+	}{
+		{name: "retried at once by default", cfg: Config{MaxRetries: 2}, requests: 3},
+		{name: "not in RetryOnStatus", cfg: Config{RetryOnStatus: []int{502}}, requests: 1},
+		{name: "retry disabled", cfg: Config{DisableRetry: true}, requests: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			tt.cfg.Addresses = []string{"http://localhost:5601"}
+			tt.cfg.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				requests++
+				return response(http.StatusServiceUnavailable, `{}`), nil
+			})
+			client, err := NewClient(tt.cfg)
+			require.NoError(t, err)
+
+			_, err = client.API.Status.Get(context.Background(), &kbapi.GetStatusRequest{})
+			require.Error(t, err)
+			assert.Equal(t, tt.requests, requests)
+		})
+	}
+}
+
+func TestTransportRetryOnStatus(t *testing.T) {
+	assert.Equal(t, []int{502, 504}, transportRetryOnStatus(nil))
+	assert.Equal(t, []int{500}, transportRetryOnStatus([]int{429, 500, 503}))
+	// An empty list would make the transport retry its defaults
+	assert.Equal(t, []int{0}, transportRetryOnStatus([]int{503}))
+}
+
+func TestClient_Metrics(t *testing.T) {
+	newClient := func(enableMetrics bool) *Client {
+		client, err := NewClient(Config{
+			Addresses:     []string{"http://localhost:5601"},
+			EnableMetrics: enableMetrics,
+			Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				return response(http.StatusOK, `{}`), nil
+			}),
+		})
+		require.NoError(t, err)
+		return client
+	}
+
+	client := newClient(true)
+	_, err := client.API.Status.Get(context.Background(), &kbapi.GetStatusRequest{})
+	require.NoError(t, err)
+	m, err := client.Metrics()
+	require.NoError(t, err)
+	assert.Equal(t, 1, m.Requests)
+
+	_, err = newClient(false).Metrics()
+	assert.Error(t, err)
+}
+
+// staleProvider is a provider whose credentials were refreshed by another
+// request, its Refresh reports no change.
+type staleProvider struct {
+	authorization string
+}
+
+func (p *staleProvider) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", p.authorization)
+	return nil
+}
+
+func (p *staleProvider) Refresh(context.Context) (bool, error) {
+	return false, nil
+}
+
+func TestClient_RefreshUnauthorized(t *testing.T) {
+	newClient := func(credentials CredentialProvider, handler func(auth string) int) (*Client, *[]string) {
+		var sent []string
+		client, err := NewClient(Config{
+			Addresses:   []string{"http://localhost:5601"},
+			Credentials: credentials,
+			Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				sent = append(sent, req.Header.Get("Authorization"))
+				return response(handler(req.Header.Get("Authorization")), `{}`), nil
+			}),
+		})
+		require.NoError(t, err)
+		return client, &sent
+	}
+
+	t.Run("refreshed credentials", func(t *testing.T) {
+		tokens := []string{"expired", "fresh"}
+		var fetches int
+		client, sent := newClient(NewTokenSourceProvider(AuthSchemeBearer, func(context.Context) (string, error) {
+			token := tokens[min(fetches, len(tokens)-1)]
+			fetches++
+			return token, nil
+		}), func(auth string) int {
+			if auth == "Bearer expired" {
+				return http.StatusUnauthorized
+			}
+			return http.StatusOK
+		})
+
+		_, err := client.API.Status.Get(context.Background(), &kbapi.GetStatusRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Bearer expired", "Bearer fresh"}, *sent)
+	})
+
+	t.Run("sent again once", func(t *testing.T) {
+		var fetches int
+		client, sent := newClient(NewTokenSourceProvider(AuthSchemeBearer, func(context.Context) (string, error) {
+			fetches++
+			return fmt.Sprintf("token-%d", fetches), nil
+		}), func(string) int { return http.StatusUnauthorized })
+
+		_, err := client.API.Status.Get(context.Background(), &kbapi.GetStatusRequest{})
+		require.Error(t, err)
+		assert.Equal(t, []string{"Bearer token-1", "Bearer token-2"}, *sent)
+	})
+
+	t.Run("unchanged credentials", func(t *testing.T) {
+		client, sent := newClient(NewAPIKeyProvider("key"), func(string) int { return http.StatusUnauthorized })
+
+		_, err := client.API.Status.Get(context.Background(), &kbapi.GetStatusRequest{})
+		require.Error(t, err)
+		assert.Len(t, *sent, 1)
+	})
+
+	t.Run("refreshed by a concurrent request", func(t *testing.T) {
+		provider := &staleProvider{authorization: "ApiKey old"}
+		client, sent := newClient(provider, func(auth string) int {
+			if auth == "ApiKey old" {
+				provider.authorization = "ApiKey new"
+				return http.StatusUnauthorized
+			}
+			return http.StatusOK
+		})
+
+		_, err := client.API.Status.Get(context.Background(), &kbapi.GetStatusRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ApiKey old", "ApiKey new"}, *sent)
+	})
+
+	t.Run("credentials set on the request", func(t *testing.T) {
+		client, sent := newClient(NewAPIKeyProvider("key"), func(string) int { return http.StatusUnauthorized })
+
+		_, err := client.API.Status.Get(context.Background(), &kbapi.GetStatusRequest{}, kbapi.WithAPIKey("other"))
+		require.Error(t, err)
+		assert.Equal(t, []string{"ApiKey other"}, *sent)
+	})
+}
+
+func TestServerlessAuth(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want bool
+	}{
+		{name: "api key", cfg: Config{APIKey: "key"}, want: true},
+		{name: "api key with username", cfg: Config{APIKey: "key", Username: "elastic"}},
+		{name: "basic auth", cfg: Config{Username: "elastic", Password: "changeme"}},
+		{name: "none", cfg: Config{}},
+		{name: "api key provider", cfg: Config{Credentials: NewAPIKeyProvider("key")}, want: true},
+		{name: "token source api key", cfg: Config{Credentials: NewTokenSourceProvider(AuthSchemeAPIKey, nil)}, want: true},
+		{name: "token source bearer", cfg: Config{Credentials: NewTokenSourceProvider(AuthSchemeBearer, nil)}},
+		{name: "custom provider", cfg: Config{Credentials: &staleProvider{}}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, serverlessAuth(tt.cfg))
+		})
+	}
+}
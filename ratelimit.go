@@ -0,0 +1,312 @@
+package kibana
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultMaxRetryAfter is the longest Retry-After delay honored by default.
+const defaultMaxRetryAfter = time.Minute
+
+// RateLimit limits the requests sent to Kibana. The zero value does not
+// limit requests.
+type RateLimit struct {
+	RequestsPerSecond float64 // Rate at which the token bucket refills; unlimited when zero.
+	Burst             int     // Number of requests sent at once before the rate applies. Default: 1.
+	MaxInFlight       int     // Maximum number of concurrent requests; unlimited when zero.
+}
+
+// RateLimitMetrics reports the time requests spent waiting for the rate
+// limits and for the Retry-After delays of Kibana.
+type RateLimitMetrics struct {
+	Global LimiterMetrics `json:"global"`
+	// Groups The metrics of every API group of Config.GroupRateLimits.
+	Groups map[string]LimiterMetrics `json:"groups,omitempty"`
+}
+
+// LimiterMetrics reports the requests delayed by a rate limit.
+type LimiterMetrics struct {
+	Throttled     int           `json:"throttled"`      // Requests that waited for a token of the bucket.
+	ThrottledTime time.Duration `json:"throttled_time"` // Total time spent waiting for tokens.
+	Queued        int           `json:"queued"`         // Requests that waited for an in-flight slot.
+	QueuedTime    time.Duration `json:"queued_time"`    // Total time spent waiting for in-flight slots.
+	InFlight      int           `json:"in_flight"`      // Requests sent whose response body is not yet read or closed.
+
+	RetriedAfter   int           `json:"retried_after"`    // Requests sent again after a Retry-After delay.
+	RetryAfterTime time.Duration `json:"retry_after_time"` // Total time spent waiting for Retry-After delays.
+}
+
+// limiter is a token bucket combined with a maximum number of in-flight
+// requests.
+type limiter struct {
+	rate  float64
+	burst float64
+	slots chan struct{}
+
+	mu        sync.Mutex
+	tokens    float64
+	last      time.Time
+	notBefore time.Time
+	metrics   LimiterMetrics
+}
+
+func newLimiter(l RateLimit) *limiter {
+	burst := l.Burst
+	if burst <= 0 {
+		burst = 1
+	}
+	lim := &limiter{rate: l.RequestsPerSecond, burst: float64(burst), tokens: float64(burst)}
+	if l.MaxInFlight > 0 {
+		lim.slots = make(chan struct{}, l.MaxInFlight)
+	}
+	return lim
+}
+
+// reserve takes a token from the bucket and returns how long to wait before
+// the request can be sent. Tokens are borrowed from the future, so that
+// concurrent requests are spread over time.
+func (l *limiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var delay time.Duration
+	if l.rate > 0 {
+		if !l.last.IsZero() {
+			l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		}
+		l.last = now
+		l.tokens--
+		if l.tokens < 0 {
+			delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+		}
+	}
+	if paused := l.notBefore.Sub(now); paused > delay {
+		delay = paused
+	}
+	return delay
+}
+
+// cancel returns a token that was reserved for a request that was not sent.
+func (l *limiter) cancel() {
+	if l.rate == 0 {
+		return
+	}
+	l.mu.Lock()
+	l.tokens = min(l.burst, l.tokens+1)
+	l.mu.Unlock()
+}
+
+// pause holds back the requests until the time Kibana asked to retry at.
+func (l *limiter) pause(until time.Time) {
+	l.mu.Lock()
+	if until.After(l.notBefore) {
+		l.notBefore = until
+	}
+	l.mu.Unlock()
+}
+
+// wait blocks until the token bucket lets the request through.
+func (l *limiter) wait(ctx context.Context) error {
+	delay := l.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+	if err := sleep(ctx, delay); err != nil {
+		l.cancel()
+		return err
+	}
+	l.record(func(m *LimiterMetrics) {
+		m.Throttled++
+		m.ThrottledTime += delay
+	})
+	return nil
+}
+
+// acquire blocks until an in-flight slot is available.
+func (l *limiter) acquire(ctx context.Context) error {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		default:
+			start := time.Now()
+			select {
+			case l.slots <- struct{}{}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			queued := time.Since(start)
+			l.record(func(m *LimiterMetrics) {
+				m.Queued++
+				m.QueuedTime += queued
+			})
+		}
+	}
+	l.record(func(m *LimiterMetrics) { m.InFlight++ })
+	return nil
+}
+
+// release frees the in-flight slot taken by acquire.
+func (l *limiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+	l.record(func(m *LimiterMetrics) { m.InFlight-- })
+}
+
+func (l *limiter) record(update func(m *LimiterMetrics)) {
+	l.mu.Lock()
+	update(&l.metrics)
+	l.mu.Unlock()
+}
+
+func (l *limiter) snapshot() LimiterMetrics {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.metrics
+}
+
+// rateLimiter applies the global rate limit and the rate limits of the API
+// groups to the requests of a Client.
+type rateLimiter struct {
+	global *limiter
+	groups map[string]*limiter
+}
+
+func newRateLimiter(global RateLimit, groups map[string]RateLimit) *rateLimiter {
+	r := &rateLimiter{global: newLimiter(global), groups: make(map[string]*limiter, len(groups))}
+	for group, l := range groups {
+		r.groups[group] = newLimiter(l)
+	}
+	return r
+}
+
+// group returns the limiter of the API group of the operation name, and nil
+// when it has none. API groups are matched by operation name prefix, e.g.
+// fleet or fleet.agents, the longest match wins.
+func (r *rateLimiter) group(operation string) *limiter {
+	var match string
+	for group := range r.groups {
+		if (operation == group || strings.HasPrefix(operation, group+".")) && len(group) > len(match) {
+			match = group
+		}
+	}
+	return r.groups[match]
+}
+
+// limiters returns the limiters that apply to the operation name, the one
+// of its API group first.
+func (r *rateLimiter) limiters(operation string) []*limiter {
+	if group := r.group(operation); group != nil {
+		return []*limiter{group, r.global}
+	}
+	return []*limiter{r.global}
+}
+
+// acquire waits for the rate limits of the operation name and returns the
+// function that releases the in-flight slots once the request is done.
+func (r *rateLimiter) acquire(ctx context.Context, operation string) (func(), error) {
+	limiters := r.limiters(operation)
+	for _, l := range limiters {
+		if err := l.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	// Take the slot of the group first, so that a request waiting for its
+	// group does not hold a global slot
+	for i, l := range limiters {
+		if err := l.acquire(ctx); err != nil {
+			for _, acquired := range limiters[:i] {
+				acquired.release()
+			}
+			return nil, err
+		}
+	}
+	return func() {
+		for _, l := range limiters {
+			l.release()
+		}
+	}, nil
+}
+
+// releasingBody releases the in-flight slots of a request once its response
+// body is read to the end or closed, whichever comes first.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err == io.EOF {
+		b.once.Do(b.release)
+	}
+	return n, err
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// retryAfter holds back the requests of the API group of the operation name
+// until Kibana accepts requests again, and records the delay. Every request
+// is held back when the operation has no API group.
+func (r *rateLimiter) retryAfter(operation string, delay time.Duration) {
+	l := r.group(operation)
+	if l == nil {
+		l = r.global
+	}
+	l.pause(time.Now().Add(delay))
+	l.record(func(m *LimiterMetrics) {
+		m.RetriedAfter++
+		m.RetryAfterTime += delay
+	})
+}
+
+// metrics returns the global metrics and the metrics of every API group.
+func (r *rateLimiter) metrics() RateLimitMetrics {
+	m := RateLimitMetrics{Global: r.global.snapshot()}
+	if len(r.groups) > 0 {
+		m.Groups = make(map[string]LimiterMetrics, len(r.groups))
+		for group, l := range r.groups {
+			m.Groups[group] = l.snapshot()
+		}
+	}
+	return m
+}
+
+// parseRetryAfter returns the delay of the Retry-After header of res, given
+// in seconds or as an HTTP date, and false when there is none.
+func parseRetryAfter(res *http.Response, now time.Time) (time.Duration, bool) {
+	value := strings.TrimSpace(res.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(0, date.Sub(now)), true
+	}
+	return 0, false
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,152 @@
+package kibana
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter_Reserve(t *testing.T) {
+	start := time.Now()
+	l := newLimiter(RateLimit{RequestsPerSecond: 10, Burst: 2})
+
+	tests := []struct {
+		name   string
+		at     time.Duration
+		cancel bool
+		want   time.Duration
+	}{
+		{name: "first token of the burst", want: 0},
+		{name: "second token of the burst", want: 0},
+		{name: "borrowed token", want: 100 * time.Millisecond, cancel: true},
+		{name: "canceled token is returned", want: 100 * time.Millisecond},
+		{name: "next borrowed token", want: 200 * time.Millisecond},
+		{name: "refilled bucket", at: time.Second, want: 0},
+	}
+	for _, tt := range tests {
+		delay := l.reserve(start.Add(tt.at))
+		assert.InDelta(t, tt.want, delay, float64(time.Millisecond), tt.name)
+		if tt.cancel {
+			l.cancel()
+		}
+	}
+
+	// A pause holds back requests even with tokens left
+	l.pause(start.Add(time.Second + 500*time.Millisecond))
+	assert.Equal(t, 500*time.Millisecond, l.reserve(start.Add(time.Second)))
+
+	// Without a rate, only the pause delays requests
+	unlimited := newLimiter(RateLimit{})
+	for range 10 {
+		assert.Zero(t, unlimited.reserve(start))
+	}
+}
+
+func TestLimiter_Acquire(t *testing.T) {
+	l := newLimiter(RateLimit{MaxInFlight: 1})
+	require.NoError(t, l.acquire(context.Background()))
+	assert.Equal(t, 1, l.snapshot().InFlight)
+
+	// The slot is taken, the request waits until its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, l.acquire(ctx), context.DeadlineExceeded)
+
+	done := make(chan error)
+	go func() { done <- l.acquire(context.Background()) }()
+	time.Sleep(10 * time.Millisecond)
+	l.release()
+	require.NoError(t, <-done)
+
+	m := l.snapshot()
+	assert.Equal(t, 1, m.InFlight)
+	assert.Equal(t, 1, m.Queued)
+	assert.Positive(t, m.QueuedTime)
+}
+
+func TestRateLimiter_Group(t *testing.T) {
+	r := newRateLimiter(RateLimit{}, map[string]RateLimit{"fleet": {}, "fleet.agents": {}})
+
+	tests := []struct {
+		operation string
+		group     string
+	}{
+		{operation: "fleet", group: "fleet"},
+		{operation: "fleet.get_settings", group: "fleet"},
+		{operation: "fleet.agents.list", group: "fleet.agents"},
+		{operation: "fleet.agents", group: "fleet.agents"},
+		{operation: "fleet.agent_policies.list", group: "fleet"},
+		{operation: "fleetx.list"},
+		{operation: "alerting.get"},
+		{operation: ""},
+	}
+	for _, tt := range tests {
+		limiters := r.limiters(tt.operation)
+		if tt.group == "" {
+			assert.Nil(t, r.group(tt.operation), tt.operation)
+			assert.Equal(t, []*limiter{r.global}, limiters, tt.operation)
+			continue
+		}
+		assert.Same(t, r.groups[tt.group], r.group(tt.operation), tt.operation)
+		assert.Equal(t, []*limiter{r.groups[tt.group], r.global}, limiters, tt.operation)
+	}
+}
+
+func TestRateLimiter_RetryAfter(t *testing.T) {
+	r := newRateLimiter(RateLimit{}, map[string]RateLimit{"fleet": {}})
+
+	// Only the API group is held back
+	r.retryAfter("fleet.agents.list", time.Minute)
+	m := r.metrics()
+	assert.Equal(t, LimiterMetrics{RetriedAfter: 1, RetryAfterTime: time.Minute}, m.Groups["fleet"])
+	assert.Zero(t, m.Global)
+	assert.Positive(t, r.groups["fleet"].reserve(time.Now()))
+	assert.Zero(t, r.global.reserve(time.Now()))
+
+	// Operations without an API group hold back every request
+	r.retryAfter("alerting.get", time.Minute)
+	m = r.metrics()
+	assert.Equal(t, LimiterMetrics{RetriedAfter: 1, RetryAfterTime: time.Minute}, m.Global)
+	assert.Positive(t, r.global.reserve(time.Now()))
+
+	// Requests waiting for the pause can be canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := r.acquire(ctx, "alerting.get")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{name: "seconds", value: "5", want: 5 * time.Second, ok: true},
+		{name: "zero seconds", value: "0", ok: true},
+		{name: "padded seconds", value: " 2 ", want: 2 * time.Second, ok: true},
+		{name: "http date", value: now.Add(30 * time.Second).Format(http.TimeFormat), want: 30 * time.Second, ok: true},
+		{name: "past http date", value: now.Add(-time.Minute).Format(http.TimeFormat), ok: true},
+		{name: "negative seconds", value: "-1"},
+		{name: "invalid", value: "soon"},
+		{name: "missing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &http.Response{Header: http.Header{}}
+			if tt.value != "" {
+				res.Header.Set("Retry-After", tt.value)
+			}
+			delay, ok := parseRetryAfter(res, now)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, delay)
+		})
+	}
+}
@@ -0,0 +1,240 @@
+// Package graph builds the reference graph of Kibana saved objects, to find
+// the objects a dashboard needs, the objects that depend on a data view, and
+// the references that point to objects that do not exist.
+package graph
+
+import (
+	"encoding/json"
+	"iter"
+	"slices"
+
+	"github.com/tehbooom/go-kibana/kbapi"
+)
+
+// Node is a saved object of the graph.
+type Node struct {
+	Type  string `json:"type"`
+	ID    string `json:"id"`
+	Title string `json:"title,omitempty"`
+	// Missing reports whether the object is referenced but was not added to the graph.
+	Missing bool `json:"missing,omitempty"`
+}
+
+// Object returns the type and ID of the node.
+func (n Node) Object() kbapi.Object {
+	return kbapi.Object{Type: n.Type, ID: n.ID}
+}
+
+// Edge is a reference from a saved object to another one.
+type Edge struct {
+	From kbapi.Object `json:"from"`
+	To   kbapi.Object `json:"to"`
+	// Name The name of the reference, e.g. panel_0 or kibanaSavedObjectMeta.searchSourceJSON.index.
+	Name string `json:"name"`
+}
+
+// Graph is the reference graph of a set of saved objects. Nodes and edges
+// are returned in the order they were added. A Graph is not safe for
+// concurrent use.
+type Graph struct {
+	nodes map[kbapi.Object]*Node
+	order []kbapi.Object
+	out   map[kbapi.Object][]Edge
+	in    map[kbapi.Object][]Edge
+}
+
+// New returns an empty graph.
+func New() *Graph {
+	return &Graph{
+		nodes: map[kbapi.Object]*Node{},
+		out:   map[kbapi.Object][]Edge{},
+		in:    map[kbapi.Object][]Edge{},
+	}
+}
+
+// FromObjects returns the graph of objects.
+func FromObjects(objects []kbapi.RawSavedObject) *Graph {
+	g := New()
+	for _, obj := range objects {
+		g.Add(obj)
+	}
+	return g
+}
+
+// FromSeq returns the graph of the objects of seq, such as the objects of an
+// export stream or of SavedObjects.All. It stops at the first error.
+func FromSeq(seq iter.Seq2[kbapi.RawSavedObject, error]) (*Graph, error) {
+	g := New()
+	for obj, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		g.Add(obj)
+	}
+	return g, nil
+}
+
+// Add adds obj and its references to the graph. Referenced objects that were
+// not added yet are marked as missing until they are added. Adding an object
+// twice has no effect.
+func (g *Graph) Add(obj kbapi.RawSavedObject) {
+	key := kbapi.Object{Type: obj.Type, ID: obj.ID}
+	if node, ok := g.nodes[key]; ok && !node.Missing {
+		return
+	}
+	node := g.node(key)
+	node.Missing = false
+	node.Title = title(obj.Attributes)
+
+	for _, ref := range obj.References {
+		to := kbapi.Object{Type: ref.Type, ID: ref.ID}
+		g.node(to)
+		edge := Edge{From: key, To: to, Name: ref.Name}
+		g.out[key] = append(g.out[key], edge)
+		g.in[to] = append(g.in[to], edge)
+	}
+}
+
+// node returns the node of key, adding it as missing when it does not exist.
+func (g *Graph) node(key kbapi.Object) *Node {
+	node, ok := g.nodes[key]
+	if !ok {
+		node = &Node{Type: key.Type, ID: key.ID, Missing: true}
+		g.nodes[key] = node
+		g.order = append(g.order, key)
+	}
+	return node
+}
+
+// Node returns the node of obj, and false when obj is not in the graph.
+func (g *Graph) Node(obj kbapi.Object) (Node, bool) {
+	node, ok := g.nodes[obj]
+	if !ok {
+		return Node{}, false
+	}
+	return *node, true
+}
+
+// Nodes returns the nodes of the graph, including the missing ones.
+func (g *Graph) Nodes() []Node {
+	nodes := make([]Node, 0, len(g.order))
+	for _, key := range g.order {
+		nodes = append(nodes, *g.nodes[key])
+	}
+	return nodes
+}
+
+// Edges returns the references between the objects of the graph.
+func (g *Graph) Edges() []Edge {
+	var edges []Edge
+	for _, key := range g.order {
+		edges = append(edges, g.out[key]...)
+	}
+	return edges
+}
+
+// References returns the references of obj.
+func (g *Graph) References(obj kbapi.Object) []Edge {
+	return slices.Clone(g.out[obj])
+}
+
+// ReferencedBy returns the references to obj.
+func (g *Graph) ReferencedBy(obj kbapi.Object) []Edge {
+	return slices.Clone(g.in[obj])
+}
+
+// Closure returns roots and every object they reference, directly or not,
+// such as the visualizations, searches and data views of a dashboard. These
+// are the objects to export to move roots to another space or cluster.
+// Referenced objects come before the objects that reference them, so the
+// result can be imported in order. Missing objects are left out, see Dangling.
+func (g *Graph) Closure(roots ...kbapi.Object) []kbapi.Object {
+	var closure []kbapi.Object
+	visited := map[kbapi.Object]bool{}
+
+	var visit func(kbapi.Object)
+	visit = func(obj kbapi.Object) {
+		if visited[obj] {
+			return
+		}
+		visited[obj] = true
+		for _, edge := range g.out[obj] {
+			visit(edge.To)
+		}
+		if node, ok := g.nodes[obj]; ok && !node.Missing {
+			closure = append(closure, obj)
+		}
+	}
+	for _, root := range roots {
+		visit(root)
+	}
+	return closure
+}
+
+// Dependents returns the objects that reference obj, directly or not, such
+// as the visualizations and dashboards built on a data view. These are the
+// objects that break when obj is deleted.
+func (g *Graph) Dependents(obj kbapi.Object) []kbapi.Object {
+	var dependents []kbapi.Object
+	visited := map[kbapi.Object]bool{obj: true}
+	queue := []kbapi.Object{obj}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, edge := range g.in[current] {
+			if visited[edge.From] {
+				continue
+			}
+			visited[edge.From] = true
+			dependents = append(dependents, edge.From)
+			queue = append(queue, edge.From)
+		}
+	}
+	return dependents
+}
+
+// Dangling returns the references to objects that were not added to the
+// graph. When the graph holds every object of a space, these are the
+// references to deleted objects.
+func (g *Graph) Dangling() []Edge {
+	var dangling []Edge
+	for _, edge := range g.Edges() {
+		if g.nodes[edge.To].Missing {
+			dangling = append(dangling, edge)
+		}
+	}
+	return dangling
+}
+
+// Orphans returns the objects that no object references, restricted to the
+// given types when any, e.g. the visualizations that are on no dashboard or
+// the data views that nothing uses.
+func (g *Graph) Orphans(types ...string) []kbapi.Object {
+	var orphans []kbapi.Object
+	for _, key := range g.order {
+		if g.nodes[key].Missing || len(g.in[key]) > 0 {
+			continue
+		}
+		if len(types) > 0 && !slices.Contains(types, key.Type) {
+			continue
+		}
+		orphans = append(orphans, key)
+	}
+	return orphans
+}
+
+// title returns the title of an object, or its name for the types that
+// have no title such as connectors.
+func title(attributes json.RawMessage) string {
+	var attrs struct {
+		Title string `json:"title"`
+		Name  string `json:"name"`
+	}
+	if err := json.Unmarshal(attributes, &attrs); err != nil {
+		return ""
+	}
+	if attrs.Title != "" {
+		return attrs.Title
+	}
+	return attrs.Name
+}
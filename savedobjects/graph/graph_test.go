@@ -0,0 +1,103 @@
+package graph
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tehbooom/go-kibana/kbapi"
+)
+
+func object(typ, id, title string, refs ...kbapi.SavedObjectReference) kbapi.RawSavedObject {
+	attributes, _ := json.Marshal(map[string]string{"title": title})
+	return kbapi.RawSavedObject{
+		Type:       typ,
+		ID:         id,
+		Attributes: attributes,
+		References: refs,
+	}
+}
+
+func ref(name, typ, id string) kbapi.SavedObjectReference {
+	return kbapi.SavedObjectReference{Name: name, Type: typ, ID: id}
+}
+
+func testGraph() *Graph {
+	return FromObjects([]kbapi.RawSavedObject{
+		object("dashboard", "dash", "Overview", ref("panel_0", "visualization", "vis"), ref("panel_1", "lens", "lens")),
+		object("visualization", "vis", "Requests", ref("kibanaSavedObjectMeta.searchSourceJSON.index", "index-pattern", "logs")),
+		object("lens", "lens", "Latency", ref("indexpattern-datasource-layer-1", "index-pattern", "logs"), ref("tag-ref", "tag", "gone")),
+		object("index-pattern", "logs", "logs-*"),
+		object("index-pattern", "metrics", "metrics-*"),
+		object("visualization", "unused", "Unused", ref("kibanaSavedObjectMeta.searchSourceJSON.index", "index-pattern", "metrics")),
+	})
+}
+
+func TestGraph_Closure(t *testing.T) {
+	g := testGraph()
+
+	closure := g.Closure(kbapi.Object{Type: "dashboard", ID: "dash"})
+	assert.Equal(t, []kbapi.Object{
+		{Type: "index-pattern", ID: "logs"},
+		{Type: "visualization", ID: "vis"},
+		{Type: "lens", ID: "lens"},
+		{Type: "dashboard", ID: "dash"},
+	}, closure)
+}
+
+func TestGraph_Dependents(t *testing.T) {
+	g := testGraph()
+
+	assert.ElementsMatch(t, []kbapi.Object{
+		{Type: "visualization", ID: "vis"},
+		{Type: "lens", ID: "lens"},
+		{Type: "dashboard", ID: "dash"},
+	}, g.Dependents(kbapi.Object{Type: "index-pattern", ID: "logs"}))
+	assert.Empty(t, g.Dependents(kbapi.Object{Type: "dashboard", ID: "dash"}))
+}
+
+func TestGraph_DanglingAndOrphans(t *testing.T) {
+	g := testGraph()
+
+	assert.Equal(t, []Edge{{
+		From: kbapi.Object{Type: "lens", ID: "lens"},
+		To:   kbapi.Object{Type: "tag", ID: "gone"},
+		Name: "tag-ref",
+	}}, g.Dangling())
+
+	assert.Equal(t, []kbapi.Object{{Type: "dashboard", ID: "dash"}, {Type: "visualization", ID: "unused"}}, g.Orphans())
+	assert.Equal(t, []kbapi.Object{{Type: "visualization", ID: "unused"}}, g.Orphans("visualization", "lens"))
+
+	// Adding the missing object resolves the dangling reference
+	g.Add(object("tag", "gone", "Team"))
+	assert.Empty(t, g.Dangling())
+	node, ok := g.Node(kbapi.Object{Type: "tag", ID: "gone"})
+	require.True(t, ok)
+	assert.Equal(t, Node{Type: "tag", ID: "gone", Title: "Team"}, node)
+}
+
+func TestGraph_Render(t *testing.T) {
+	g := FromObjects([]kbapi.RawSavedObject{
+		object("dashboard", "dash", `My "dash"`, ref("panel_0", "visualization", "vis")),
+	})
+
+	dot := g.DOT()
+	assert.True(t, strings.HasPrefix(dot, "digraph savedobjects {\n"))
+	assert.Contains(t, dot, `"dashboard:dash" [label="dashboard\nMy \"dash\""];`)
+	assert.Contains(t, dot, `"visualization:vis" [label="visualization\nvis", style=dashed, color=red];`)
+	assert.Contains(t, dot, `"dashboard:dash" -> "visualization:vis" [label="panel_0"];`)
+
+	b, err := json.Marshal(g)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"nodes": [
+			{"type": "dashboard", "id": "dash", "title": "My \"dash\""},
+			{"type": "visualization", "id": "vis", "missing": true}
+		],
+		"edges": [
+			{"from": {"type": "dashboard", "id": "dash"}, "to": {"type": "visualization", "id": "vis"}, "name": "panel_0"}
+		]
+	}`, string(b))
+}
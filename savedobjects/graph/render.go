@@ -0,0 +1,70 @@
+package graph
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tehbooom/go-kibana/kbapi"
+)
+
+// WriteDOT writes the graph in the Graphviz DOT language, e.g. to render it
+// with dot -Tsvg. Missing objects are drawn dashed and in red.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph savedobjects {")
+	fmt.Fprintln(bw, "\trankdir=LR;")
+	fmt.Fprintln(bw, "\tnode [shape=box];")
+
+	for _, node := range g.Nodes() {
+		label := node.Type + "\n" + node.ID
+		if node.Title != "" {
+			label = node.Type + "\n" + node.Title
+		}
+		attrs := "label=" + quoteDOT(label)
+		if node.Missing {
+			attrs += ", style=dashed, color=red"
+		}
+		fmt.Fprintf(bw, "\t%s [%s];\n", quoteDOT(nodeID(node.Object())), attrs)
+	}
+	for _, edge := range g.Edges() {
+		fmt.Fprintf(bw, "\t%s -> %s [label=%s];\n", quoteDOT(nodeID(edge.From)), quoteDOT(nodeID(edge.To)), quoteDOT(edge.Name))
+	}
+
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// DOT returns the graph in the Graphviz DOT language, see WriteDOT.
+func (g *Graph) DOT() string {
+	var b strings.Builder
+	_ = g.WriteDOT(&b)
+	return b.String()
+}
+
+// MarshalJSON encodes the graph as {"nodes": [...], "edges": [...]}.
+func (g *Graph) MarshalJSON() ([]byte, error) {
+	edges := g.Edges()
+	if edges == nil {
+		edges = []Edge{}
+	}
+	return json.Marshal(struct {
+		Nodes []Node `json:"nodes"`
+		Edges []Edge `json:"edges"`
+	}{Nodes: g.Nodes(), Edges: edges})
+}
+
+// nodeID returns the DOT identifier of obj.
+func nodeID(obj kbapi.Object) string {
+	return obj.Type + ":" + obj.ID
+}
+
+// quoteDOT returns s as a quoted DOT string.
+func quoteDOT(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
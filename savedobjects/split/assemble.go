@@ -0,0 +1,91 @@
+package split
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Assemble writes the objects split into dir to w as an NDJSON file for
+// SavedObjects.Import. Types are written in the order of their directory
+// names and objects in the order of their file names, which is not the order
+// of the export. Only the fields listed in the _embeddedJSON member of a file
+// are escaped. The lines hold the exported objects without their
+// VolatileFields.
+func Assemble(dir string, w io.Writer) error {
+	types, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, typ := range types {
+		if !typ.IsDir() {
+			continue
+		}
+		files, err := os.ReadDir(filepath.Join(dir, typ.Name()))
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+				continue
+			}
+			path := filepath.Join(dir, typ.Name(), file.Name())
+			line, err := assembleObject(path)
+			if err != nil {
+				return fmt.Errorf("failed to assemble %s: %w", path, err)
+			}
+			if _, err := w.Write(append(line, '\n')); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// AssembleBytes returns the NDJSON file of the objects split into dir, see Assemble.
+func AssembleBytes(dir string) ([]byte, error) {
+	var b bytes.Buffer
+	if err := Assemble(dir, &b); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// assembleObject returns the export line of the object file at path.
+func assembleObject(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, data); err != nil {
+		return nil, err
+	}
+	fields, ok, err := parseObject(compact.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("not a JSON object")
+	}
+
+	var embedded []string
+	kept := fields[:0]
+	for _, f := range fields {
+		if f.key != embeddedJSONKey {
+			kept = append(kept, f)
+			continue
+		}
+		if err := json.Unmarshal(f.value, &embedded); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", embeddedJSONKey, err)
+		}
+	}
+	line, _, err := transformEmbedded(encodeObject(kept), embedded, escapeJSON)
+	return line, err
+}
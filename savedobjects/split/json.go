@@ -0,0 +1,159 @@
+package split
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// field is a member of a JSON object. The value is kept as it was read so
+// that objects are written back byte for byte.
+type field struct {
+	key   string
+	value json.RawMessage
+}
+
+// parseObject returns the members of the JSON object data in order, and
+// false when data is not an object.
+func parseObject(data []byte) ([]field, bool, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, false, err
+	}
+	if tok != json.Delim('{') {
+		return nil, false, nil
+	}
+
+	var fields []field
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, false, err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, false, fmt.Errorf("unexpected object key %v", tok)
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, false, err
+		}
+		fields = append(fields, field{key: key, value: value})
+	}
+	return fields, true, nil
+}
+
+// encodeObject returns the compact JSON object of fields.
+func encodeObject(fields []field) []byte {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, f := range fields {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.Write(quote(f.key))
+		b.WriteByte(':')
+		b.Write(f.value)
+	}
+	b.WriteByte('}')
+	return b.Bytes()
+}
+
+// transform replaces the value at path in the JSON object data with the
+// result of fn. data is returned unchanged when path does not exist.
+func transform(data []byte, path []string, fn func(json.RawMessage) (json.RawMessage, error)) ([]byte, error) {
+	if len(path) == 0 {
+		return fn(data)
+	}
+
+	fields, ok, err := parseObject(data)
+	if err != nil || !ok {
+		return data, err
+	}
+	for i, f := range fields {
+		if f.key != path[0] {
+			continue
+		}
+		value, err := transform(f.value, path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(value, f.value) {
+			return data, nil
+		}
+		fields[i].value = value
+		return encodeObject(fields), nil
+	}
+	return data, nil
+}
+
+// unescapeJSON returns the JSON document embedded in the string value, or
+// value when it is not a string holding a compact JSON object or array that
+// escapeJSON restores byte for byte.
+func unescapeJSON(value json.RawMessage) (json.RawMessage, error) {
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return value, nil
+	}
+	if !strings.HasPrefix(s, "{") && !strings.HasPrefix(s, "[") {
+		return value, nil
+	}
+	if !json.Valid([]byte(s)) {
+		return value, nil
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, []byte(s)); err != nil || compact.String() != s {
+		return value, nil
+	}
+	if !bytes.Equal(quote(s), value) {
+		return value, nil
+	}
+	return json.RawMessage(s), nil
+}
+
+// escapeJSON returns the compact JSON object or array value as a string, and
+// leaves other values unchanged.
+func escapeJSON(value json.RawMessage) (json.RawMessage, error) {
+	if len(value) == 0 || (value[0] != '{' && value[0] != '[') {
+		return value, nil
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, value); err != nil {
+		return nil, err
+	}
+	return quote(compact.String()), nil
+}
+
+// quote returns s as a JSON string escaped like JavaScript's JSON.stringify,
+// which Kibana uses to write exports.
+func quote(s string) []byte {
+	const hex = "0123456789abcdef"
+	b := make([]byte, 0, len(s)+2)
+	b = append(b, '"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '"', '\\':
+			b = append(b, '\\', c)
+		case '\b':
+			b = append(b, '\\', 'b')
+		case '\f':
+			b = append(b, '\\', 'f')
+		case '\n':
+			b = append(b, '\\', 'n')
+		case '\r':
+			b = append(b, '\\', 'r')
+		case '\t':
+			b = append(b, '\\', 't')
+		default:
+			if c < 0x20 {
+				b = append(b, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+				continue
+			}
+			b = append(b, c)
+		}
+	}
+	return append(b, '"')
+}
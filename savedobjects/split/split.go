@@ -0,0 +1,237 @@
+// Package split converts saved object exports to a directory tree that can
+// be reviewed and versioned, and back. Every object is written to
+// <type>/<id>.json, pretty-printed, with the JSON embedded in string
+// attributes such as panelsJSON unescaped, and without the fields that
+// change on every save. The unescaped attributes are listed in the
+// _embeddedJSON member of the file. Assemble turns the tree back into an
+// NDJSON file for SavedObjects.Import. It does not reproduce the export
+// exactly: the objects are ordered by type directory and then file name
+// rather than in the order of the export, the VolatileFields are left out,
+// and the export details line is not written.
+//
+//	w := split.NewWriter("dashboards")
+//	_, err := client.SavedObjects.ExportTo(ctx, &kbapi.SavedObjectExportToRequest{..., Output: w})
+//	...
+//	err = w.Close()
+package split
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// EmbeddedJSONFields are the attributes holding JSON documents as strings,
+// keyed by saved object type, the fields of the "" key apply to every type.
+// They are unescaped by Split and escaped again by Assemble. A string is
+// only unescaped when Assemble restores it byte for byte, and Assemble only
+// escapes the fields that Split unescaped.
+var EmbeddedJSONFields = map[string][]string{
+	"": {
+		"attributes.kibanaSavedObjectMeta.searchSourceJSON",
+		"attributes.layerListJSON",
+		"attributes.mapStateJSON",
+		"attributes.optionsJSON",
+		"attributes.panelsJSON",
+		"attributes.uiStateJSON",
+	},
+	"index-pattern": {
+		"attributes.fieldAttrs",
+		"attributes.fieldFormatMap",
+		"attributes.fields",
+		"attributes.runtimeFieldMap",
+	},
+	"visualization": {
+		"attributes.visState",
+	},
+}
+
+// VolatileFields are the top-level fields removed by Split because they
+// change every time an object is saved. Kibana sets them again on import.
+var VolatileFields = []string{
+	"created_at",
+	"created_by",
+	"updated_at",
+	"updated_by",
+	"version",
+}
+
+// exportDetailsKey is the field of the summary Kibana appends to exports.
+const exportDetailsKey = "exportedCount"
+
+// embeddedJSONKey is the field of the object files listing the paths of the
+// EmbeddedJSONFields that Split unescaped, so that Assemble escapes them again.
+const embeddedJSONKey = "_embeddedJSON"
+
+// Writer splits the NDJSON export written to it into dir. Objects are
+// written as soon as their line is complete. The export details line is
+// skipped. Close must be called to write the last object.
+type Writer struct {
+	dir  string
+	line []byte
+	err  error
+}
+
+// NewWriter returns a Writer splitting an export into dir. Files of objects
+// that are not part of the export are left in dir, split into an empty
+// directory to mirror an export exactly.
+func NewWriter(dir string) *Writer {
+	return &Writer{dir: dir}
+}
+
+// Write splits the complete lines of p, and keeps the last incomplete line
+// for the next write.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	w.line = append(w.line, p...)
+	for {
+		i := bytes.IndexByte(w.line, '\n')
+		if i < 0 {
+			break
+		}
+		if err := w.writeObject(w.line[:i]); err != nil {
+			w.err = err
+			return 0, err
+		}
+		w.line = w.line[i+1:]
+	}
+	return len(p), nil
+}
+
+// Close splits the last line of the export.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	line := w.line
+	w.line = nil
+	return w.writeObject(line)
+}
+
+func (w *Writer) writeObject(line []byte) error {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return nil
+	}
+
+	path, data, err := splitObject(line)
+	if err != nil || data == nil {
+		return err
+	}
+	path = filepath.Join(w.dir, path)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Split writes the objects of the NDJSON export r to dir, see Writer.
+func Split(dir string, r io.Reader) error {
+	w := NewWriter(dir)
+	if _, err := io.Copy(w, r); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// splitObject returns the file path relative to the split directory and the
+// content of the exported object line, or nil data for the export details.
+func splitObject(line []byte) (string, []byte, error) {
+	fields, ok, err := parseObject(line)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to decode exported object: %w", err)
+	}
+	if !ok {
+		return "", nil, fmt.Errorf("exported object is not a JSON object: %.50s", line)
+	}
+
+	var id, typ string
+	kept := fields[:0]
+	for _, f := range fields {
+		switch f.key {
+		case exportDetailsKey:
+			return "", nil, nil
+		case "id":
+			err = json.Unmarshal(f.value, &id)
+		case "type":
+			err = json.Unmarshal(f.value, &typ)
+		case embeddedJSONKey:
+			return "", nil, fmt.Errorf("exported object has a %s field: %.50s", embeddedJSONKey, line)
+		}
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to decode the %s of exported object: %w", f.key, err)
+		}
+		if !slices.Contains(VolatileFields, f.key) {
+			kept = append(kept, f)
+		}
+	}
+	if id == "" || typ == "" {
+		return "", nil, fmt.Errorf("exported object has no id or type: %.50s", line)
+	}
+
+	data, unescaped, err := transformEmbedded(encodeObject(kept), slices.Concat(EmbeddedJSONFields[""], EmbeddedJSONFields[typ]), unescapeJSON)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(unescaped) > 0 {
+		paths, err := json.Marshal(unescaped)
+		if err != nil {
+			return "", nil, err
+		}
+		fields, _, err := parseObject(data)
+		if err != nil {
+			return "", nil, err
+		}
+		data = encodeObject(append(fields, field{key: embeddedJSONKey, value: paths}))
+	}
+
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, data, "", "  "); err != nil {
+		return "", nil, err
+	}
+	pretty.WriteByte('\n')
+	return filepath.Join(escapeName(typ), escapeName(id)+".json"), pretty.Bytes(), nil
+}
+
+// transformEmbedded applies fn to the fields at paths of the object data,
+// and returns the paths of the fields that fn changed.
+func transformEmbedded(data []byte, paths []string, fn func(json.RawMessage) (json.RawMessage, error)) ([]byte, []string, error) {
+	var changed []string
+	for _, path := range paths {
+		transformed, err := transform(data, strings.Split(path, "."), fn)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !bytes.Equal(transformed, data) {
+			changed = append(changed, path)
+		}
+		data = transformed
+	}
+	return data, changed, nil
+}
+
+// escapeName returns name with the characters that are not allowed in file
+// names on common file systems percent-encoded.
+func escapeName(name string) string {
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c < 0x20 || strings.IndexByte(`%/\:*?"<>|`, c) >= 0 {
+			fmt.Fprintf(&b, "%%%02X", c)
+			continue
+		}
+		b.WriteByte(c)
+	}
+	if s := b.String(); s != "." && s != ".." {
+		return s
+	}
+	return strings.ReplaceAll(name, ".", "%2E")
+}
@@ -0,0 +1,177 @@
+package split
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	dashboardLine = `{"attributes":{"title":"Overview","panelsJSON":"[{\"type\":\"lens\",\"title\":\"<b>Latency</b> \\\"p99\\\"\",\"panelIndex\":\"1\"}]","optionsJSON":"{\"useMargins\":true}","kibanaSavedObjectMeta":{"searchSourceJSON":"{\"query\":{\"query\":\"\",\"language\":\"kuery\"},\"filter\":[]}"}},"coreMigrationVersion":"8.8.0","created_at":"2024-01-01T00:00:00.000Z","id":"dash-1","managed":false,"references":[{"id":"lens-1","name":"1:panel_1","type":"lens"}],"type":"dashboard","typeMigrationVersion":"10.2.0","updated_at":"2024-06-01T00:00:00.000Z","version":"WzEsMV0="}`
+	dataViewLine  = `{"attributes":{"title":"logs-*","fields":"[]","fieldFormatMap":"{ \"bytes\": {} }","timeFieldName":"@timestamp"},"id":"logs-*","references":[],"type":"index-pattern","updated_at":"2024-06-01T00:00:00.000Z","version":"WzIsMV0="}`
+	detailsLine   = `{"excludedObjects":[],"excludedObjectsCount":0,"exportedCount":2,"missingRefCount":0,"missingReferences":[]}`
+)
+
+func TestSplit(t *testing.T) {
+	dir := t.TempDir()
+	w := NewWriter(dir)
+	// Write in chunks that split lines to exercise line buffering
+	export := dashboardLine + "\n" + dataViewLine + "\n" + detailsLine + "\n"
+	for len(export) > 0 {
+		n := min(len(export), 97)
+		_, err := w.Write([]byte(export[:n]))
+		require.NoError(t, err)
+		export = export[n:]
+	}
+	require.NoError(t, w.Close())
+
+	dashboard, err := os.ReadFile(filepath.Join(dir, "dashboard", "dash-1.json"))
+	require.NoError(t, err)
+	assert.Equal(t, `{
+  "attributes": {
+    "title": "Overview",
+    "panelsJSON": [
+      {
+        "type": "lens",
+        "title": "<b>Latency</b> \"p99\"",
+        "panelIndex": "1"
+      }
+    ],
+    "optionsJSON": {
+      "useMargins": true
+    },
+    "kibanaSavedObjectMeta": {
+      "searchSourceJSON": {
+        "query": {
+          "query": "",
+          "language": "kuery"
+        },
+        "filter": []
+      }
+    }
+  },
+  "coreMigrationVersion": "8.8.0",
+  "id": "dash-1",
+  "managed": false,
+  "references": [
+    {
+      "id": "lens-1",
+      "name": "1:panel_1",
+      "type": "lens"
+    }
+  ],
+  "type": "dashboard",
+  "typeMigrationVersion": "10.2.0",
+  "_embeddedJSON": [
+    "attributes.kibanaSavedObjectMeta.searchSourceJSON",
+    "attributes.optionsJSON",
+    "attributes.panelsJSON"
+  ]
+}
+`, string(dashboard))
+
+	// fieldFormatMap is not compact, it is kept as a string so that it round-trips
+	dataView, err := os.ReadFile(filepath.Join(dir, "index-pattern", "logs-%2A.json"))
+	require.NoError(t, err)
+	assert.Contains(t, string(dataView), `"fields": [],`)
+	assert.Contains(t, string(dataView), `"fieldFormatMap": "{ \"bytes\": {} }",`)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
+
+func TestAssemble(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, Split(dir, strings.NewReader(dashboardLine+"\n"+dataViewLine+"\n"+detailsLine)))
+
+	assembled, err := AssembleBytes(dir)
+	require.NoError(t, err)
+
+	// Lines match the export apart from the volatile fields
+	assert.Equal(t,
+		`{"attributes":{"title":"Overview","panelsJSON":"[{\"type\":\"lens\",\"title\":\"<b>Latency</b> \\\"p99\\\"\",\"panelIndex\":\"1\"}]","optionsJSON":"{\"useMargins\":true}","kibanaSavedObjectMeta":{"searchSourceJSON":"{\"query\":{\"query\":\"\",\"language\":\"kuery\"},\"filter\":[]}"}},"coreMigrationVersion":"8.8.0","id":"dash-1","managed":false,"references":[{"id":"lens-1","name":"1:panel_1","type":"lens"}],"type":"dashboard","typeMigrationVersion":"10.2.0"}`+"\n"+
+			`{"attributes":{"title":"logs-*","fields":"[]","fieldFormatMap":"{ \"bytes\": {} }","timeFieldName":"@timestamp"},"id":"logs-*","references":[],"type":"index-pattern"}`+"\n",
+		string(assembled))
+
+	// Splitting the assembled file again gives the same tree
+	again := t.TempDir()
+	require.NoError(t, Split(again, strings.NewReader(string(assembled))))
+	reassembled, err := AssembleBytes(again)
+	require.NoError(t, err)
+	assert.Equal(t, string(assembled), string(reassembled))
+}
+
+func TestAssembleEmbeddedObjects(t *testing.T) {
+	// optionsJSON holds a JSON object rather than a string, and searchSourceJSON
+	// a string that is not compact, neither is escaped by Assemble
+	line := `{"attributes":{"title":"Map","optionsJSON":{"useMargins":true},"layerListJSON":"[{\"id\":\"1\"}]","kibanaSavedObjectMeta":{"searchSourceJSON":"{ }"}},"id":"map-1","references":[],"type":"map"}`
+
+	dir := t.TempDir()
+	require.NoError(t, Split(dir, strings.NewReader(line+"\n")))
+	split, err := os.ReadFile(filepath.Join(dir, "map", "map-1.json"))
+	require.NoError(t, err)
+	assert.Contains(t, string(split), `"_embeddedJSON": [
+    "attributes.layerListJSON"
+  ]`)
+
+	assembled, err := AssembleBytes(dir)
+	require.NoError(t, err)
+	assert.Equal(t, line+"\n", string(assembled))
+}
+
+func TestAssembleExport(t *testing.T) {
+	export, err := os.ReadFile(filepath.Join("testdata", "export.ndjson"))
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, Split(dir, strings.NewReader(string(export))))
+	assembled, err := AssembleBytes(dir)
+	require.NoError(t, err)
+
+	// Lines are ordered by type directory and file name, not as exported
+	var types, got []string
+	for _, line := range strings.Split(strings.TrimSuffix(string(assembled), "\n"), "\n") {
+		object := decodeLine(t, line)
+		types = append(types, string(object["type"]))
+		got = append(got, encodeLine(t, object))
+	}
+	assert.Equal(t, []string{`"dashboard"`, `"index-pattern"`, `"lens"`, `"search"`, `"tag"`, `"visualization"`}, types)
+
+	// Apart from their order and the volatile fields, the lines are the exported ones
+	var want []string
+	for _, line := range strings.Split(strings.TrimSuffix(string(export), "\n"), "\n") {
+		object := decodeLine(t, line)
+		if _, ok := object["type"]; !ok {
+			// Export details
+			continue
+		}
+		for _, field := range VolatileFields {
+			delete(object, field)
+		}
+		want = append(want, encodeLine(t, object))
+	}
+	assert.ElementsMatch(t, want, got)
+}
+
+// decodeLine decodes the top-level fields of an NDJSON line, leaving their
+// values as they are.
+func decodeLine(t *testing.T, line string) map[string]json.RawMessage {
+	t.Helper()
+	var object map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal([]byte(line), &object))
+	return object
+}
+
+// encodeLine encodes the fields of object in a stable order.
+func encodeLine(t *testing.T, object map[string]json.RawMessage) string {
+	t.Helper()
+	b, err := json.Marshal(object)
+	require.NoError(t, err)
+	return string(b)
+}